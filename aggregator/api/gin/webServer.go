@@ -22,12 +22,14 @@ type webServer struct {
 	httpServer   mxChainShared.HttpServerCloser
 	apiInterface string
 	cancelFunc   func()
+	getHandlers  map[string]http.Handler
 }
 
 // NewWebServerHandler returns a new instance of webServer
 func NewWebServerHandler(apiInterface string) (*webServer, error) {
 	gws := &webServer{
 		apiInterface: apiInterface,
+		getHandlers:  make(map[string]http.Handler),
 	}
 
 	return gws, nil
@@ -72,6 +74,18 @@ func (ws *webServer) StartHttpServer() error {
 func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 	registerLoggerWsRoute(ginRouter, marshalizerForLogs)
+
+	for path, handler := range ws.getHandlers {
+		ginRouter.GET(path, gin.WrapH(handler))
+	}
+}
+
+// RegisterGetHandler mounts the handler on the GET requests of the provided path, such as the Prometheus metrics
+// exporter on "/metrics". It should be called before StartHttpServer
+func (ws *webServer) RegisterGetHandler(path string, handler http.Handler) {
+	ws.Lock()
+	ws.getHandlers[path] = handler
+	ws.Unlock()
 }
 
 // registerLoggerWsRoute will register the log route
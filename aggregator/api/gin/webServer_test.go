@@ -1,6 +1,7 @@
 package gin
 
 import (
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -68,3 +69,25 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestWebServer_RegisterGetHandler(t *testing.T) {
+	ws, _ := NewWebServerHandler("127.0.0.1:8081")
+	ws.RegisterGetHandler("/metrics", http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = writer.Write([]byte("metrics"))
+	}))
+
+	err := ws.StartHttpServer()
+	assert.Nil(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	resp, err := http.Get("http://127.0.0.1:8081/metrics")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, _ := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	assert.Equal(t, "metrics", string(body))
+
+	err = ws.Close()
+	assert.Nil(t, err)
+}
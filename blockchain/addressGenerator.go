@@ -38,23 +38,24 @@ func NewAddressGenerator(coordinator *shardCoordinator) (*addressGenerator, erro
 	builtInFuncs := &disabled.BuiltInFunctionContainer{}
 
 	var argsHook = hooks.ArgBlockChainHook{
-		Accounts:              &disabled.Accounts{},
-		PubkeyConv:            core.AddressPublicKeyConverter,
-		StorageService:        &disabled.StorageService{},
-		BlockChain:            &disabled.Blockchain{},
-		ShardCoordinator:      &disabled.ShardCoordinator{},
-		Marshalizer:           &marshal.JsonMarshalizer{},
-		Uint64Converter:       uint64ByteSlice.NewBigEndianConverter(),
-		BuiltInFunctions:      builtInFuncs,
-		DataPool:              &disabled.DataPool{},
-		CompiledSCPool:        storage.NewMapCacher(),
-		NilCompiledSCStore:    true,
-		NFTStorageHandler:     &disabled.SimpleESDTNFTStorageHandler{},
-		EpochNotifier:         &disabled.EpochNotifier{},
-		GlobalSettingsHandler: &disabled.GlobalSettingsHandler{},
-		EnableEpochsHandler:   &disabled.EnableEpochsHandler{},
-		GasSchedule:           &disabled.GasScheduleNotifier{},
-		Counter:               &disabled.BlockChainHookCounter{},
+		Accounts:                 &disabled.Accounts{},
+		PubkeyConv:               core.AddressPublicKeyConverter,
+		StorageService:           &disabled.StorageService{},
+		BlockChain:               &disabled.Blockchain{},
+		ShardCoordinator:         &disabled.ShardCoordinator{},
+		Marshalizer:              &marshal.JsonMarshalizer{},
+		Uint64Converter:          uint64ByteSlice.NewBigEndianConverter(),
+		BuiltInFunctions:         builtInFuncs,
+		DataPool:                 &disabled.DataPool{},
+		CompiledSCPool:           storage.NewMapCacher(),
+		NilCompiledSCStore:       true,
+		NFTStorageHandler:        &disabled.SimpleESDTNFTStorageHandler{},
+		EpochNotifier:            &disabled.EpochNotifier{},
+		GlobalSettingsHandler:    &disabled.GlobalSettingsHandler{},
+		EnableEpochsHandler:      &disabled.EnableEpochsHandler{},
+		GasSchedule:              &disabled.GasScheduleNotifier{},
+		Counter:                  &disabled.BlockChainHookCounter{},
+		MissingTrieNodesNotifier: &disabled.MissingTrieNodesNotifier{},
 	}
 	blockchainHook, err := hooks.NewBlockChainHookImpl(argsHook)
 	if err != nil {
@@ -103,3 +104,8 @@ func (ag *addressGenerator) ComputeArwenScAddress(address core.AddressHandler, n
 
 	return data.NewAddressFromBytes(scAddressBytes), nil
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *addressGenerator) IsInterfaceNil() bool {
+	return ag == nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,3 +68,31 @@ func TestAddressGenerator_ComputeArwenScAddress(t *testing.T) {
 
 	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqxcy5fma93yhw44xcmt3zwrl0tlhaqmxrdwpsr2vh8p", scAddress.AddressAsBech32String())
 }
+
+func TestAddressGenerator_ShouldPredictTheDeployedContractAddress(t *testing.T) {
+	t.Parallel()
+
+	coord, err := NewShardCoordinator(3, 0)
+	require.Nil(t, err)
+
+	ag, err := NewAddressGenerator(coord)
+	require.Nil(t, err)
+	owner, err := data.NewAddressFromBech32String("erd1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpsk46qrs")
+	require.Nil(t, err)
+
+	builder, err := builders.NewContractDeployBuilder(ag)
+	require.Nil(t, err)
+	tx, err := builder.
+		SetSender(owner).
+		SetCode([]byte("contract code")).
+		SetGasLimit(10000000).
+		SetNetworkConfig(&data.NetworkConfig{MinGasLimit: 50000, GasPerDataByte: 1500}).
+		Build()
+	require.Nil(t, err)
+	tx.Nonce = 10
+
+	scAddress, err := builder.ComputeContractAddress(tx)
+	require.Nil(t, err)
+
+	assert.Equal(t, "erd1qqqqqqqqqqqqqpgqxcy5fma93yhw44xcmt3zwrl0tlhaqmxrdwpsr2vh8p", scAddress.AddressAsBech32String())
+}
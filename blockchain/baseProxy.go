@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
 	"github.com/multiversx/mx-sdk-go/data"
 )
 
@@ -20,12 +21,14 @@ var log = logger.GetOrCreate("mx-sdk-go/blockchain")
 
 const (
 	minimumCachingInterval = time.Second
+	networkConfigCacheName = "networkConfig"
 )
 
 type argsBaseProxy struct {
 	expirationTime    time.Duration
 	httpClientWrapper httpClientWrapper
 	endpointProvider  EndpointProvider
+	metricsHandler    core.MetricsHandler
 }
 
 type baseProxy struct {
@@ -36,6 +39,7 @@ type baseProxy struct {
 	cacheExpiryDuration time.Duration
 	sinceTimeHandler    func(t time.Time) time.Duration
 	endpointProvider    EndpointProvider
+	metricsHandler      core.MetricsHandler
 }
 
 // newBaseProxy will create a base multiversx proxy with cache instance
@@ -50,6 +54,7 @@ func newBaseProxy(args argsBaseProxy) (*baseProxy, error) {
 		cacheExpiryDuration: args.expirationTime,
 		endpointProvider:    args.endpointProvider,
 		sinceTimeHandler:    since,
+		metricsHandler:      args.metricsHandler,
 	}, nil
 }
 
@@ -63,6 +68,9 @@ func checkArgsBaseProxy(args argsBaseProxy) error {
 	if check.IfNil(args.endpointProvider) {
 		return ErrNilEndpointProvider
 	}
+	if check.IfNil(args.metricsHandler) {
+		return ErrNilMetricsHandler
+	}
 
 	return nil
 }
@@ -78,6 +86,7 @@ func (proxy *baseProxy) GetNetworkConfig(ctx context.Context) (*data.NetworkConf
 	proxy.mut.RUnlock()
 
 	if cachedConfigs != nil {
+		proxy.metricsHandler.IncrementCacheAccess(networkConfigCacheName, true)
 		return cachedConfigs, nil
 	}
 
@@ -99,9 +108,11 @@ func (proxy *baseProxy) cacheConfigs(ctx context.Context) (*data.NetworkConfig,
 	// maybe another parallel running go routine already did the fetching
 	cachedConfig := proxy.getCachedConfigs()
 	if cachedConfig != nil {
+		proxy.metricsHandler.IncrementCacheAccess(networkConfigCacheName, true)
 		return cachedConfig, nil
 	}
 
+	proxy.metricsHandler.IncrementCacheAccess(networkConfigCacheName, false)
 	log.Debug("Network config not cached. caching...")
 	configs, err := proxy.getNetworkConfigFromSource(ctx)
 	if err != nil {
@@ -157,7 +168,7 @@ func (proxy *baseProxy) GetShardOfAddress(ctx context.Context, bech32Address str
 // GetNetworkStatus will return the network status of a provided shard
 func (proxy *baseProxy) GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
 	endpoint := proxy.endpointProvider.GetNodeStatus(shardID)
-	buff, code, err := proxy.GetHTTP(ctx, endpoint)
+	buff, code, err := proxy.GetHTTP(sdkHttp.WithShardID(ctx, shardID), endpoint)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -24,6 +24,7 @@ func createMockArgsBaseProxy() argsBaseProxy {
 		httpClientWrapper: &testsCommon.HTTPClientWrapperStub{},
 		expirationTime:    time.Second,
 		endpointProvider:  endpointProviders.NewNodeEndpointProvider(),
+		metricsHandler:    &testsCommon.MetricsHandlerStub{},
 	}
 }
 
@@ -60,6 +61,16 @@ func TestNewBaseProxy(t *testing.T) {
 		assert.True(t, check.IfNil(baseProxyInstance))
 		assert.True(t, errors.Is(err, ErrNilEndpointProvider))
 	})
+	t.Run("nil metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBaseProxy()
+		args.metricsHandler = nil
+		baseProxyInstance, err := newBaseProxy(args)
+
+		assert.True(t, check.IfNil(baseProxyInstance))
+		assert.True(t, errors.Is(err, ErrNilMetricsHandler))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -248,6 +259,30 @@ func TestBaseProxy_GetNetworkConfig(t *testing.T) {
 		assert.False(t, wasCalled)
 		assert.Equal(t, expectedReturnedNetworkConfig, configs)
 	})
+	t.Run("should report the cache hits and misses", func(t *testing.T) {
+		t.Parallel()
+
+		hits := make([]bool, 0)
+		args := createMockArgsBaseProxy()
+		args.httpClientWrapper = &testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return networkConfigBytes, http.StatusOK, nil
+			},
+		}
+		args.metricsHandler = &testsCommon.MetricsHandlerStub{
+			IncrementCacheAccessCalled: func(cacheName string, hit bool) {
+				assert.Equal(t, networkConfigCacheName, cacheName)
+				hits = append(hits, hit)
+			},
+		}
+		baseProxyInstance, _ := newBaseProxy(args)
+
+		_, err := baseProxyInstance.GetNetworkConfig(context.Background())
+		require.Nil(t, err)
+		_, err = baseProxyInstance.GetNetworkConfig(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, []bool{false, true}, hits)
+	})
 }
 
 func TestBaseProxy_GetNetworkStatus(t *testing.T) {
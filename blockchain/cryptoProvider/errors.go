@@ -4,3 +4,21 @@ import "errors"
 
 // ErrTxAlreadySigned signals that the provided transaction is already signed
 var ErrTxAlreadySigned = errors.New("tx already signed")
+
+// ErrNilSigningBackend signals that a nil signing backend was provided
+var ErrNilSigningBackend = errors.New("nil signing backend")
+
+// ErrPrivateKeyNotAvailable signals that the private key is held outside the process and can not be exported
+var ErrPrivateKeyNotAvailable = errors.New("private key not available in process")
+
+// ErrRemoteSigning signals that the remote signing backend failed to produce a signature
+var ErrRemoteSigning = errors.New("remote signing error")
+
+// ErrUnknownSigningKey signals that the signing server does not hold the requested key
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,88 @@
+package cryptoProvider
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// SignEndpoint is the endpoint exposed by the remote signing backends
+const SignEndpoint = "sign"
+
+const minimumSigningTimeout = time.Millisecond
+
+// ArgsHttpSigningBackend is the DTO used in the HTTP signing backend constructor
+type ArgsHttpSigningBackend struct {
+	URL    string
+	Client sdkHttp.Client
+	// Timeout bounds each signing request
+	Timeout time.Duration
+}
+
+type httpSigningBackend struct {
+	httpClientWrapper httpClientWrapper
+	timeout           time.Duration
+}
+
+// NewHttpSigningBackend creates a signing backend delegating the signing to an external process over HTTP.
+// The external process should answer the POST requests on the sign endpoint with a data.SignResponse
+func NewHttpSigningBackend(args ArgsHttpSigningBackend) (*httpSigningBackend, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if args.Timeout < minimumSigningTimeout {
+		return nil, fmt.Errorf("%w for Timeout, minimum %v, provided %v", ErrInvalidValue, minimumSigningTimeout, args.Timeout)
+	}
+
+	return &httpSigningBackend{
+		httpClientWrapper: sdkHttp.NewHttpClientWrapper(args.Client, args.URL),
+		timeout:           args.Timeout,
+	}, nil
+}
+
+// Sign requests the signature of the message from the remote backend holding the private key
+func (backend *httpSigningBackend) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	request := &data.SignRequest{
+		PublicKey: hex.EncodeToString(publicKey),
+		Message:   hex.EncodeToString(message),
+	}
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), backend.timeout)
+	defer cancel()
+
+	buff, code, err := backend.httpClientWrapper.PostHTTP(ctx, SignEndpoint, requestBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigning, err.Error())
+	}
+
+	response := &data.SignResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, status code %d", ErrRemoteSigning, err.Error(), code)
+	}
+	if code != http.StatusOK || len(response.Error) > 0 {
+		return nil, fmt.Errorf("%w: %s, status code %d", ErrRemoteSigning, response.Error, code)
+	}
+
+	signature, err := hex.DecodeString(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigning, err.Error())
+	}
+
+	return signature, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (backend *httpSigningBackend) IsInterfaceNil() bool {
+	return backend == nil
+}
@@ -0,0 +1,98 @@
+package cryptoProvider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHttpSigningBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewHttpSigningBackend(ArgsHttpSigningBackend{Timeout: time.Second})
+		assert.True(t, check.IfNil(backend))
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: "http://localhost"})
+		assert.True(t, check.IfNil(backend))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: "http://localhost", Timeout: time.Second})
+		assert.False(t, check.IfNil(backend))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHttpSigningBackend_Sign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown key should error", func(t *testing.T) {
+		t.Parallel()
+
+		localServer, _ := NewLocalSigningServer(keyGen)
+		server := httptest.NewServer(localServer)
+		defer server.Close()
+
+		backend, _ := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: server.URL, Timeout: time.Second})
+		signature, err := backend.Sign([]byte("public key"), []byte("msg"))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrRemoteSigning))
+		assert.True(t, strings.Contains(err.Error(), ErrUnknownSigningKey.Error()))
+	})
+	t.Run("invalid response should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			_, _ = writer.Write([]byte("not a json"))
+		}))
+		defer server.Close()
+
+		backend, _ := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: server.URL, Timeout: time.Second})
+		signature, err := backend.Sign([]byte("public key"), []byte("msg"))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrRemoteSigning))
+	})
+	t.Run("unreachable backend should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		backend, _ := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: server.URL, Timeout: time.Second})
+		signature, err := backend.Sign([]byte("public key"), []byte("msg"))
+		assert.Nil(t, signature)
+		assert.True(t, errors.Is(err, ErrRemoteSigning))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		localServer, err := NewLocalSigningServer(keyGen, []byte(strings.Repeat("a", 32)))
+		require.Nil(t, err)
+		server := httptest.NewServer(localServer)
+		defer server.Close()
+
+		privateKey, _ := keyGen.PrivateKeyFromByteArray([]byte(strings.Repeat("a", 32)))
+		publicKeyBytes, _ := privateKey.GeneratePublic().ToByteArray()
+
+		backend, _ := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: server.URL, Timeout: time.Second})
+		signature, err := backend.Sign(publicKeyBytes, []byte("msg"))
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(privateKey.GeneratePublic(), []byte("msg"), signature))
+	})
+}
@@ -0,0 +1,24 @@
+package cryptoProvider
+
+import (
+	"context"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// SigningBackend defines the component able to sign messages with a key held outside the process
+type SigningBackend interface {
+	Sign(publicKey []byte, message []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ExternalPrivateKey defines a private key handle able to sign without exposing the key material
+type ExternalPrivateKey interface {
+	crypto.PrivateKey
+	Sign(message []byte) ([]byte, error)
+}
+
+type httpClientWrapper interface {
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
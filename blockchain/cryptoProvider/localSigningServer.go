@@ -0,0 +1,86 @@
+package cryptoProvider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+type localSigningServer struct {
+	keys map[string]crypto.PrivateKey
+}
+
+// NewLocalSigningServer creates an HTTP handler answering the signing requests with the provided in-memory keys.
+// It is a stand-in for a remote signing backend, to be used in tests and local setups together with an
+// httpSigningBackend
+func NewLocalSigningServer(keyGen crypto.KeyGenerator, privateKeys ...[]byte) (*localSigningServer, error) {
+	server := &localSigningServer{
+		keys: make(map[string]crypto.PrivateKey, len(privateKeys)),
+	}
+	for _, skBytes := range privateKeys {
+		privateKey, err := keyGen.PrivateKeyFromByteArray(skBytes)
+		if err != nil {
+			return nil, err
+		}
+		publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+
+		server.keys[hex.EncodeToString(publicKeyBytes)] = privateKey
+	}
+
+	return server, nil
+}
+
+// ServeHTTP answers the POST requests on the sign endpoint
+func (server *localSigningServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost || request.URL.Path != "/"+SignEndpoint {
+		writeSignResponse(writer, http.StatusNotFound, &data.SignResponse{Error: "unknown endpoint"})
+		return
+	}
+
+	signRequest := &data.SignRequest{}
+	err := json.NewDecoder(request.Body).Decode(signRequest)
+	if err != nil {
+		writeSignResponse(writer, http.StatusBadRequest, &data.SignResponse{Error: err.Error()})
+		return
+	}
+
+	signature, err := server.sign(signRequest)
+	if err != nil {
+		writeSignResponse(writer, http.StatusBadRequest, &data.SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeSignResponse(writer, http.StatusOK, &data.SignResponse{Signature: hex.EncodeToString(signature)})
+}
+
+func (server *localSigningServer) sign(request *data.SignRequest) ([]byte, error) {
+	privateKey, found := server.keys[request.PublicKey]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSigningKey, request.PublicKey)
+	}
+
+	message, err := hex.DecodeString(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleSigner.Sign(privateKey, message)
+}
+
+func writeSignResponse(writer http.ResponseWriter, statusCode int, response *data.SignResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *localSigningServer) IsInterfaceNil() bool {
+	return server == nil
+}
@@ -0,0 +1,102 @@
+package cryptoProvider
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+type remoteCryptoComponentsHolder struct {
+	publicKey      crypto.PublicKey
+	privateKey     *remotePrivateKey
+	addressHandler core.AddressHandler
+	bech32Address  string
+}
+
+// NewRemoteCryptoComponentsHolder returns a crypto components holder for a key held by the provided signing backend.
+// The returned private key is only a handle: the signer delegates the signing to the backend and the key material
+// never reaches the process
+func NewRemoteCryptoComponentsHolder(keyGen crypto.KeyGenerator, publicKeyBytes []byte, backend SigningBackend) (*remoteCryptoComponentsHolder, error) {
+	if check.IfNil(backend) {
+		return nil, ErrNilSigningBackend
+	}
+
+	publicKey, err := keyGen.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	addressHandler := data.NewAddressFromBytes(publicKeyBytes)
+
+	return &remoteCryptoComponentsHolder{
+		publicKey: publicKey,
+		privateKey: &remotePrivateKey{
+			publicKey:      publicKey,
+			publicKeyBytes: publicKeyBytes,
+			backend:        backend,
+		},
+		addressHandler: addressHandler,
+		bech32Address:  addressHandler.AddressAsBech32String(),
+	}, nil
+}
+
+// GetPublicKey returns the held publicKey
+func (holder *remoteCryptoComponentsHolder) GetPublicKey() crypto.PublicKey {
+	return holder.publicKey
+}
+
+// GetPrivateKey returns the handle of the private key held by the signing backend
+func (holder *remoteCryptoComponentsHolder) GetPrivateKey() crypto.PrivateKey {
+	return holder.privateKey
+}
+
+// GetBech32 returns the held bech32 address
+func (holder *remoteCryptoComponentsHolder) GetBech32() string {
+	return holder.bech32Address
+}
+
+// GetAddressHandler returns the held address handler
+func (holder *remoteCryptoComponentsHolder) GetAddressHandler() core.AddressHandler {
+	return holder.addressHandler
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *remoteCryptoComponentsHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
+
+type remotePrivateKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+	backend        SigningBackend
+}
+
+// Sign delegates the signing of the message to the backend
+func (key *remotePrivateKey) Sign(message []byte) ([]byte, error) {
+	return key.backend.Sign(key.publicKeyBytes, message)
+}
+
+// ToByteArray returns an error as the key material is not available in process
+func (key *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return nil, ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key corresponding to the remote private key
+func (key *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return key.publicKey
+}
+
+// Suite returns the suite of the public key
+func (key *remotePrivateKey) Suite() crypto.Suite {
+	return key.publicKey.Suite()
+}
+
+// Scalar returns nil as the key material is not available in process
+func (key *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (key *remotePrivateKey) IsInterfaceNil() bool {
+	return key == nil
+}
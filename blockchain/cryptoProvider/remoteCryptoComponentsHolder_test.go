@@ -0,0 +1,100 @@
+package cryptoProvider
+
+import (
+	"encoding/hex"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRemoteSk = "45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6"
+
+func createRemoteHolder(t *testing.T, url string) (*cryptoComponentsHolder, *remoteCryptoComponentsHolder) {
+	sk, _ := hex.DecodeString(testRemoteSk)
+	localHolder, err := NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	backend, err := NewHttpSigningBackend(ArgsHttpSigningBackend{
+		URL:     url,
+		Timeout: time.Second,
+	})
+	require.Nil(t, err)
+
+	remoteHolder, err := NewRemoteCryptoComponentsHolder(keyGen, localHolder.GetAddressHandler().AddressBytes(), backend)
+	require.Nil(t, err)
+
+	return localHolder, remoteHolder
+}
+
+func TestNewRemoteCryptoComponentsHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil backend should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, err := NewRemoteCryptoComponentsHolder(keyGen, make([]byte, 32), nil)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilSigningBackend, err)
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		backend, _ := NewHttpSigningBackend(ArgsHttpSigningBackend{URL: "http://localhost", Timeout: time.Second})
+		holder, err := NewRemoteCryptoComponentsHolder(keyGen, []byte("invalid"), backend)
+		assert.True(t, check.IfNil(holder))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		localHolder, remoteHolder := createRemoteHolder(t, "http://localhost")
+		assert.False(t, check.IfNil(remoteHolder))
+		assert.Equal(t, localHolder.GetBech32(), remoteHolder.GetBech32())
+		assert.Equal(t, localHolder.GetAddressHandler().AddressBytes(), remoteHolder.GetAddressHandler().AddressBytes())
+		assert.Equal(t, remoteHolder.GetPublicKey(), remoteHolder.GetPrivateKey().GeneratePublic())
+
+		skBytes, err := remoteHolder.GetPrivateKey().ToByteArray()
+		assert.Nil(t, skBytes)
+		assert.Equal(t, ErrPrivateKeyNotAvailable, err)
+	})
+}
+
+func TestRemoteCryptoComponentsHolder_SignerShouldDelegateToTheBackend(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := hex.DecodeString(testRemoteSk)
+	localServer, err := NewLocalSigningServer(keyGen, sk)
+	require.Nil(t, err)
+	server := httptest.NewServer(localServer)
+	defer server.Close()
+
+	localHolder, remoteHolder := createRemoteHolder(t, server.URL)
+	signerInstance := NewSigner()
+
+	localSignature, err := signerInstance.SignMessage([]byte("msg"), localHolder.GetPrivateKey())
+	require.Nil(t, err)
+	remoteSignature, err := signerInstance.SignMessage([]byte("msg"), remoteHolder.GetPrivateKey())
+	require.Nil(t, err)
+	assert.Equal(t, localSignature, remoteSignature)
+	assert.Nil(t, signerInstance.VerifyMessage([]byte("msg"), remoteHolder.GetPublicKey(), remoteSignature))
+
+	tx := &transaction.FrontendTransaction{
+		Value:    "1",
+		Receiver: "erd1l20m7kzfht5rhdnd4zvqr82egk7m4nvv3zk06yw82zqmrt9kf0zsf9esqq",
+		Sender:   remoteHolder.GetBech32(),
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+		ChainID:  "T",
+		Version:  1,
+	}
+	localSignature, err = signerInstance.SignTransaction(tx, localHolder.GetPrivateKey())
+	require.Nil(t, err)
+	remoteSignature, err = signerInstance.SignTransaction(tx, remoteHolder.GetPrivateKey())
+	require.Nil(t, err)
+	assert.Equal(t, localSignature, remoteSignature)
+}
@@ -41,8 +41,14 @@ func (s *signer) VerifyMessage(msg []byte, publicKey crypto.PublicKey, sig []byt
 	return singleSigner.Verify(publicKey, serializedMessage, sig)
 }
 
-// SignByteSlice will generate the signature providing the private key bytes and some arbitrary message
+// SignByteSlice will generate the signature providing the private key bytes and some arbitrary message.
+// If the private key is held outside the process, the signing is delegated to the key's backend
 func (s *signer) SignByteSlice(msg []byte, privateKey crypto.PrivateKey) ([]byte, error) {
+	externalKey, isExternal := privateKey.(ExternalPrivateKey)
+	if isExternal {
+		return externalKey.Sign(msg)
+	}
+
 	return singleSigner.Sign(privateKey, msg)
 }
 
@@ -11,6 +11,7 @@ const (
 	costTransaction            = "transaction/cost"
 	sendTransaction            = "transaction/send"
 	sendMultipleTransactions   = "transaction/send-multiple"
+	simulateTransaction        = "transaction/simulate"
 	transactionStatus          = "transaction/%s/status"
 	processedTransactionStatus = "transaction/%s/process-status"
 	transactionInfo            = "transaction/%s"
@@ -22,6 +23,13 @@ const (
 	rawStartOfEpochValidators  = "internal/json/startofepoch/validators/by-epoch/%d"
 	esdt                       = "address/%s/esdt/%s"
 	nft                        = "address/%s/nft/%s/nonce/%d"
+	accountStorageValue        = "address/%s/key/%s"
+	accountKeys                = "address/%s/keys"
+	iterateAccountKeys         = "address/iterate-keys"
+	allESDTTokens              = "address/%s/esdt"
+	esdtRoles                  = "address/%s/esdts/roles"
+	esdtsWithRole              = "address/%s/esdts-with-role/%s"
+	registeredNFTs             = "address/%s/registered-nfts"
 )
 
 type baseEndpointProvider struct{}
@@ -61,6 +69,41 @@ func (base *baseEndpointProvider) GetNFTTokenData(addressAsBech32 string, tokenI
 	return fmt.Sprintf(nft, addressAsBech32, tokenIdentifier, nonce)
 }
 
+// GetAllESDTTokens returns the endpoint of all the tokens held by an address
+func (base *baseEndpointProvider) GetAllESDTTokens(addressAsBech32 string) string {
+	return fmt.Sprintf(allESDTTokens, addressAsBech32)
+}
+
+// GetESDTRoles returns the endpoint of the token roles an address has
+func (base *baseEndpointProvider) GetESDTRoles(addressAsBech32 string) string {
+	return fmt.Sprintf(esdtRoles, addressAsBech32)
+}
+
+// GetESDTsWithRole returns the endpoint of the tokens an address has the provided role for
+func (base *baseEndpointProvider) GetESDTsWithRole(addressAsBech32 string, role string) string {
+	return fmt.Sprintf(esdtsWithRole, addressAsBech32, role)
+}
+
+// GetRegisteredNFTs returns the endpoint of the NFT, SFT and Meta-ESDT collections registered by an address
+func (base *baseEndpointProvider) GetRegisteredNFTs(addressAsBech32 string) string {
+	return fmt.Sprintf(registeredNFTs, addressAsBech32)
+}
+
+// GetAccountStorageValue returns the account storage value endpoint
+func (base *baseEndpointProvider) GetAccountStorageValue(addressAsBech32 string, hexKey string) string {
+	return fmt.Sprintf(accountStorageValue, addressAsBech32, hexKey)
+}
+
+// GetAccountKeys returns the account keys endpoint
+func (base *baseEndpointProvider) GetAccountKeys(addressAsBech32 string) string {
+	return fmt.Sprintf(accountKeys, addressAsBech32)
+}
+
+// GetIterateAccountKeys returns the paged account keys endpoint
+func (base *baseEndpointProvider) GetIterateAccountKeys() string {
+	return iterateAccountKeys
+}
+
 // GetCostTransaction returns the transaction cost endpoint
 func (base *baseEndpointProvider) GetCostTransaction() string {
 	return costTransaction
@@ -76,6 +119,11 @@ func (base *baseEndpointProvider) GetSendMultipleTransactions() string {
 	return sendMultipleTransactions
 }
 
+// GetSimulateTransaction returns the transaction simulation endpoint
+func (base *baseEndpointProvider) GetSimulateTransaction() string {
+	return simulateTransaction
+}
+
 // GetTransactionStatus returns the transaction status endpoint
 func (base *baseEndpointProvider) GetTransactionStatus(hexHash string) string {
 	return fmt.Sprintf(transactionStatus, hexHash)
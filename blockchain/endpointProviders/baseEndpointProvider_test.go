@@ -18,6 +18,7 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, costTransaction, base.GetCostTransaction())
 	assert.Equal(t, sendTransaction, base.GetSendTransaction())
 	assert.Equal(t, sendMultipleTransactions, base.GetSendMultipleTransactions())
+	assert.Equal(t, simulateTransaction, base.GetSimulateTransaction())
 	assert.Equal(t, "transaction/hex/status", base.GetTransactionStatus("hex"))
 	assert.Equal(t, "transaction/hex", base.GetTransactionInfo("hex"))
 	assert.Equal(t, "hyperblock/by-nonce/4", base.GetHyperBlockByNonce(4))
@@ -27,4 +28,11 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, "internal/raw/startofepoch/metablock/by-epoch/5", base.GetRawStartOfEpochMetaBlock(5))
 	assert.Equal(t, "address/erd1address/esdt/TKN-001122", base.GetESDTTokenData("erd1address", "TKN-001122"))
 	assert.Equal(t, "address/erd1address/nft/TKN-001122/nonce/37", base.GetNFTTokenData("erd1address", "TKN-001122", 37))
+	assert.Equal(t, "address/erd1address/key/6b6579", base.GetAccountStorageValue("erd1address", "6b6579"))
+	assert.Equal(t, "address/erd1address/keys", base.GetAccountKeys("erd1address"))
+	assert.Equal(t, iterateAccountKeys, base.GetIterateAccountKeys())
+	assert.Equal(t, "address/erd1address/esdt", base.GetAllESDTTokens("erd1address"))
+	assert.Equal(t, "address/erd1address/esdts/roles", base.GetESDTRoles("erd1address"))
+	assert.Equal(t, "address/erd1address/esdts-with-role/ESDTRoleNFTCreate", base.GetESDTsWithRole("erd1address", "ESDTRoleNFTCreate"))
+	assert.Equal(t, "address/erd1address/registered-nfts", base.GetRegisteredNFTs("erd1address"))
 }
@@ -59,3 +59,18 @@ func createHTTPStatusError(httpStatusCode int, err error) error {
 	return fmt.Errorf("%w, returned http status: %d, %s",
 		err, httpStatusCode, http.StatusText(httpStatusCode))
 }
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrEmptyStorageKey signals that an empty account storage key was provided
+var ErrEmptyStorageKey = errors.New("empty storage key")
+
+// ErrInvalidNumKeys signals that an invalid number of keys per page was provided
+var ErrInvalidNumKeys = errors.New("invalid number of keys")
+
+// ErrEmptyRole signals that an empty token role was provided
+var ErrEmptyRole = errors.New("empty role")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
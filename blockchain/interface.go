@@ -34,6 +34,7 @@ type EndpointProvider interface {
 	GetCostTransaction() string
 	GetSendTransaction() string
 	GetSendMultipleTransactions() string
+	GetSimulateTransaction() string
 	GetTransactionStatus(hexHash string) string
 	GetTransactionInfo(hexHash string) string
 	GetHyperBlockByNonce(nonce uint64) string
@@ -51,6 +52,13 @@ type EndpointProvider interface {
 	GetProcessedTransactionStatus(hexHash string) string
 	GetESDTTokenData(addressAsBech32 string, tokenIdentifier string) string
 	GetNFTTokenData(addressAsBech32 string, tokenIdentifier string, nonce uint64) string
+	GetAccountStorageValue(addressAsBech32 string, hexKey string) string
+	GetAccountKeys(addressAsBech32 string) string
+	GetIterateAccountKeys() string
+	GetAllESDTTokens(addressAsBech32 string) string
+	GetESDTRoles(addressAsBech32 string) string
+	GetESDTsWithRole(addressAsBech32 string, role string) string
+	GetRegisteredNFTs(addressAsBech32 string) string
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,101 @@
+package blockchain
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/blockchain/factory"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+)
+
+// ArgsMultiEndpointProxy is the DTO used in the multi-endpoint proxy constructor
+type ArgsMultiEndpointProxy struct {
+	Endpoints           []sdkHttp.EndpointConfig
+	Client              sdkHttp.Client
+	Strategy            sdkHttp.SelectionStrategy
+	RequestTimeout      time.Duration
+	HealthCheckInterval time.Duration
+	SameScState         bool
+	ShouldBeSynced      bool
+	FinalityCheck       bool
+	AllowedDeltaToFinal int
+	CacheExpirationTime time.Duration
+	EntityType          sdkCore.RestAPIEntityType
+	// Middlewares are applied on each endpoint, so the rate limits and circuit breakers are kept per endpoint
+	Middlewares []sdkHttp.Middleware
+	// MetricsHandler is optional, receiving the requests and cache metrics when provided
+	MetricsHandler sdkCore.MetricsHandler
+}
+
+type multiEndpointProxy struct {
+	*proxy
+	closer interface {
+		Close() error
+	}
+}
+
+// NewMultiEndpointProxy initializes a proxy spreading the requests over multiple gateways or observers, with health
+// checks and automatic failover. The requests bound to an account, such as the account, token, storage and VM queries
+// and the transaction sends, are pinned to the shard of the account and routed to the observers configured for that
+// shard
+func NewMultiEndpointProxy(args ArgsMultiEndpointProxy) (*multiEndpointProxy, error) {
+	proxyArgs := ArgsProxy{
+		Client:              args.Client,
+		SameScState:         args.SameScState,
+		ShouldBeSynced:      args.ShouldBeSynced,
+		FinalityCheck:       args.FinalityCheck,
+		AllowedDeltaToFinal: args.AllowedDeltaToFinal,
+		CacheExpirationTime: args.CacheExpirationTime,
+		EntityType:          args.EntityType,
+		MetricsHandler:      args.MetricsHandler,
+	}
+	err := checkArgsProxy(proxyArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointProvider, err := factory.CreateEndpointProvider(args.EntityType)
+	if err != nil {
+		return nil, err
+	}
+
+	middlewares, err := appendMetricsMiddleware(args.Middlewares, args.MetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	clientWrapper, err := sdkHttp.NewMultiEndpointClientWrapper(sdkHttp.ArgsMultiEndpointClientWrapper{
+		Client:              args.Client,
+		Endpoints:           args.Endpoints,
+		Strategy:            args.Strategy,
+		RequestTimeout:      args.RequestTimeout,
+		HealthCheckInterval: args.HealthCheckInterval,
+		HealthCheckEndpoint: endpointProvider.GetNetworkConfig(),
+		Middlewares:         middlewares,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proxyInstance, err := newProxyWithClientWrapper(proxyArgs, clientWrapper, endpointProvider)
+	if err != nil {
+		_ = clientWrapper.Close()
+		return nil, err
+	}
+	proxyInstance.isShardAware = true
+
+	return &multiEndpointProxy{
+		proxy:  proxyInstance,
+		closer: clientWrapper,
+	}, nil
+}
+
+// Close stops the health checks of the endpoints
+func (ep *multiEndpointProxy) Close() error {
+	return ep.closer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *multiEndpointProxy) IsInterfaceNil() bool {
+	return ep == nil
+}
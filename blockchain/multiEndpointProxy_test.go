@@ -0,0 +1,152 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createObserverServer(shardID uint32, nonce uint64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		response := &data.NodeStatusResponse{}
+		response.Data.Status = &data.NetworkStatus{
+			Nonce:   nonce,
+			ShardID: shardID,
+		}
+		responseBytes, _ := json.Marshal(response)
+		_, _ = rw.Write(responseBytes)
+	}))
+}
+
+func createShardObserverServer(shardID uint32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var response interface{}
+		switch {
+		case strings.HasPrefix(req.URL.Path, "/network/config"):
+			networkConfigResponse := &data.NetworkConfigResponse{}
+			networkConfigResponse.Data.Config = &data.NetworkConfig{NumShardsWithoutMeta: 2}
+			response = networkConfigResponse
+		case strings.HasPrefix(req.URL.Path, "/address/"):
+			accountResponse := &data.AccountResponse{}
+			accountResponse.Data.Account = &data.Account{
+				Address: strings.TrimPrefix(req.URL.Path, "/address/"),
+				Nonce:   uint64(shardID),
+			}
+			response = accountResponse
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		responseBytes, _ := json.Marshal(response)
+		_, _ = rw.Write(responseBytes)
+	}))
+}
+
+func createMockArgsMultiEndpointProxy(endpoints ...sdkHttp.EndpointConfig) ArgsMultiEndpointProxy {
+	return ArgsMultiEndpointProxy{
+		Endpoints:           endpoints,
+		Strategy:            sdkHttp.RoundRobinSelection,
+		RequestTimeout:      time.Second,
+		CacheExpirationTime: time.Minute,
+		EntityType:          sdkCore.ObserverNode,
+	}
+}
+
+func TestNewMultiEndpointProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid allowed delta to final should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointProxy(sdkHttp.EndpointConfig{URL: testHttpURL})
+		args.FinalityCheck = true
+		ep, err := NewMultiEndpointProxy(args)
+		assert.True(t, check.IfNil(ep))
+		assert.True(t, errors.Is(err, ErrInvalidAllowedDeltaToFinal))
+	})
+	t.Run("no endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, err := NewMultiEndpointProxy(createMockArgsMultiEndpointProxy())
+		assert.True(t, check.IfNil(ep))
+		assert.True(t, errors.Is(err, sdkHttp.ErrNoEndpoints))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ep, err := NewMultiEndpointProxy(createMockArgsMultiEndpointProxy(sdkHttp.EndpointConfig{URL: testHttpURL}))
+		assert.False(t, check.IfNil(ep))
+		assert.Nil(t, err)
+		assert.Nil(t, ep.Close())
+	})
+}
+
+func TestMultiEndpointProxy_GetNetworkStatusShouldRouteToTheShardObserver(t *testing.T) {
+	t.Parallel()
+
+	observerShard0 := createObserverServer(0, 10)
+	defer observerShard0.Close()
+	observerShard1 := createObserverServer(1, 20)
+	defer observerShard1.Close()
+
+	ep, err := NewMultiEndpointProxy(createMockArgsMultiEndpointProxy(
+		sdkHttp.EndpointConfig{URL: observerShard0.URL, ShardIDs: []uint32{0}},
+		sdkHttp.EndpointConfig{URL: observerShard1.URL, ShardIDs: []uint32{1}},
+	))
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		status, errGet := ep.GetNetworkStatus(context.Background(), 1)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(20), status.Nonce)
+
+		status, errGet = ep.GetNetworkStatus(context.Background(), 0)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(10), status.Nonce)
+	}
+}
+
+func TestMultiEndpointProxy_GetAccountShouldRouteToTheShardOfTheAddress(t *testing.T) {
+	t.Parallel()
+
+	observerShard0 := createShardObserverServer(0)
+	defer observerShard0.Close()
+	observerShard1 := createShardObserverServer(1)
+	defer observerShard1.Close()
+
+	ep, err := NewMultiEndpointProxy(createMockArgsMultiEndpointProxy(
+		sdkHttp.EndpointConfig{URL: observerShard0.URL, ShardIDs: []uint32{0}},
+		sdkHttp.EndpointConfig{URL: observerShard1.URL, ShardIDs: []uint32{1}},
+	))
+	require.Nil(t, err)
+
+	addressBytes := make([]byte, 32)
+	addressShard0 := data.NewAddressFromBytes(addressBytes)
+	addressBytes = make([]byte, 32)
+	addressBytes[31] = 1
+	addressShard1 := data.NewAddressFromBytes(addressBytes)
+
+	for i := 0; i < 3; i++ {
+		account, errGet := ep.GetAccount(context.Background(), addressShard1)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(1), account.Nonce)
+		assert.Equal(t, addressShard1.AddressAsBech32String(), account.Address)
+
+		account, errGet = ep.GetAccount(context.Background(), addressShard0)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(0), account.Nonce)
+	}
+}
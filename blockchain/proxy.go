@@ -2,6 +2,7 @@ package blockchain
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,10 +19,12 @@ import (
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
 	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/disabled"
 )
 
 const (
-	withResultsQueryParam = "?withResults=true"
+	withResultsQueryParam            = "?withResults=true"
+	checkSignatureDisabledQueryParam = "?checkSignature=false"
 )
 
 // ArgsProxy is the DTO used in the multiversx proxy constructor
@@ -34,6 +37,11 @@ type ArgsProxy struct {
 	AllowedDeltaToFinal int
 	CacheExpirationTime time.Duration
 	EntityType          sdkCore.RestAPIEntityType
+	// Middlewares are optional and decorate all the HTTP requests done by the proxy. None is applied by default, the
+	// retry and circuit breaker policy being enabled by providing sdkHttp.DefaultMiddlewares()
+	Middlewares []sdkHttp.Middleware
+	// MetricsHandler is optional, receiving the requests and cache metrics when provided
+	MetricsHandler sdkCore.MetricsHandler
 }
 
 // proxy implements basic functions for interacting with a multiversx Proxy
@@ -44,6 +52,9 @@ type proxy struct {
 	finalityCheck       bool
 	allowedDeltaToFinal int
 	finalityProvider    FinalityProvider
+	// isShardAware is set when the requests can be served by observers of different shards, so the requests bound
+	// to an account are pinned to the shard of that account
+	isShardAware bool
 }
 
 // NewProxy initializes and returns a proxy object
@@ -58,11 +69,42 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		return nil, err
 	}
 
-	clientWrapper := sdkHttp.NewHttpClientWrapper(args.Client, args.ProxyURL)
+	middlewares, err := appendMetricsMiddleware(args.Middlewares, args.MetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	clientWrapper := sdkHttp.ApplyMiddlewares(sdkHttp.NewHttpClientWrapper(args.Client, args.ProxyURL), middlewares...)
+
+	return newProxyWithClientWrapper(args, clientWrapper, endpointProvider)
+}
+
+// appendMetricsMiddleware adds the metrics middleware as the innermost one, so each retry is reported
+func appendMetricsMiddleware(middlewares []sdkHttp.Middleware, metricsHandler sdkCore.MetricsHandler) ([]sdkHttp.Middleware, error) {
+	if check.IfNil(metricsHandler) {
+		return middlewares, nil
+	}
+
+	metricsMiddleware, err := sdkHttp.NewMetricsMiddleware(metricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]sdkHttp.Middleware, 0, len(middlewares)+1)
+	result = append(result, middlewares...)
+
+	return append(result, metricsMiddleware), nil
+}
+
+func newProxyWithClientWrapper(args ArgsProxy, clientWrapper httpClientWrapper, endpointProvider EndpointProvider) (*proxy, error) {
 	baseArgs := argsBaseProxy{
 		httpClientWrapper: clientWrapper,
 		expirationTime:    args.CacheExpirationTime,
 		endpointProvider:  endpointProvider,
+		metricsHandler:    args.MetricsHandler,
+	}
+	if check.IfNil(baseArgs.metricsHandler) {
+		baseArgs.metricsHandler = &disabled.MetricsHandler{}
 	}
 	baseProxyInstance, err := newBaseProxy(baseArgs)
 	if err != nil {
@@ -99,7 +141,26 @@ func checkArgsProxy(args ArgsProxy) error {
 
 // ExecuteVMQuery retrieves data from existing SC trie through the use of a VM
 func (ep *proxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-	err := ep.checkFinalState(ctx, vmRequest.Address)
+	return ep.ExecuteVMQueryWithQueryOptions(ctx, vmRequest, api.AccountQueryOptions{})
+}
+
+// ExecuteVMQueryWithQueryOptions retrieves data from the SC trie through the use of a VM, on the state selected by the
+// query options, such as the state at a past block. The response holds the block the query was executed on
+func (ep *proxy) ExecuteVMQueryWithQueryOptions(
+	ctx context.Context,
+	vmRequest *data.VmValueRequest,
+	queryOptions api.AccountQueryOptions,
+) (*data.VmValuesResponseData, error) {
+	if vmRequest == nil {
+		return nil, ErrNilRequest
+	}
+
+	ctx, err := ep.pinToShardOfAddress(ctx, vmRequest.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ep.checkFinalStateForQuery(ctx, vmRequest.Address, queryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +175,8 @@ func (ep *proxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequ
 		return nil, err
 	}
 
-	buff, code, err := ep.PostHTTP(ctx, ep.endpointProvider.GetVmValues(), jsonVMRequest)
+	endpoint := sdkCore.BuildUrlWithAccountQueryOptions(ep.endpointProvider.GetVmValues(), queryOptions)
+	buff, code, err := ep.PostHTTP(ctx, endpoint, jsonVMRequest)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -144,6 +206,16 @@ func (ep *proxy) checkFinalState(ctx context.Context, address string) error {
 	return ep.finalityProvider.CheckShardFinalization(ctx, targetShardID, uint64(ep.allowedDeltaToFinal))
 }
 
+// checkFinalStateForQuery checks the finality of the latest state only, as the state of a past block is not affected
+// by the finalization delay
+func (ep *proxy) checkFinalStateForQuery(ctx context.Context, address string, queryOptions api.AccountQueryOptions) error {
+	if sdkCore.HasBlockCoordinates(queryOptions) {
+		return nil
+	}
+
+	return ep.checkFinalState(ctx, address)
+}
+
 // GetNetworkEconomics retrieves the network economics from the proxy
 func (ep *proxy) GetNetworkEconomics(ctx context.Context) (*data.NetworkEconomics, error) {
 	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetNetworkEconomics())
@@ -198,18 +270,33 @@ func (ep *proxy) GetDefaultTransactionArguments(
 
 // GetAccount retrieves an account info from the network (nonce, balance)
 func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler) (*data.Account, error) {
-	err := ep.checkFinalState(ctx, address.AddressAsBech32String())
+	return ep.GetAccountWithQueryOptions(ctx, address, api.AccountQueryOptions{})
+}
+
+// GetAccountWithQueryOptions retrieves an account info from the network (nonce, balance) on the state selected by the
+// query options, such as the state at a past block. The account holds the block its state was read on
+func (ep *proxy) GetAccountWithQueryOptions(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.Account, error) {
+	err := checkAccountAddress(address)
 	if err != nil {
 		return nil, err
 	}
 
-	if check.IfNil(address) {
-		return nil, ErrNilAddress
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
 	}
-	if !address.IsValid() {
-		return nil, ErrInvalidAddress
+
+	err = ep.checkFinalStateForQuery(ctx, address.AddressAsBech32String(), queryOptions)
+	if err != nil {
+		return nil, err
 	}
+
 	endpoint := ep.endpointProvider.GetAccount(address.AddressAsBech32String())
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
 
 	buff, code, err := ep.GetHTTP(ctx, endpoint)
 	if err != nil || code != http.StatusOK {
@@ -224,12 +311,24 @@ func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler)
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.Account != nil {
+		response.Data.Account.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.Account, nil
 }
 
 // SendTransaction broadcasts a transaction to the network and returns the txhash if successful
 func (ep *proxy) SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransaction
+	}
+
+	ctx, err := ep.pinToShardOfAddress(ctx, tx.Sender)
+	if err != nil {
+		return "", err
+	}
+
 	jsonTx, err := json.Marshal(tx)
 	if err != nil {
 		return "", err
@@ -253,6 +352,11 @@ func (ep *proxy) SendTransaction(ctx context.Context, tx *transaction.FrontendTr
 
 // SendTransactions broadcasts the provided transactions to the network and returns the txhashes if successful
 func (ep *proxy) SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+	ctx, err := ep.pinToShardOfSenders(ctx, txs)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonTx, err := json.Marshal(txs)
 	if err != nil {
 		return nil, err
@@ -347,6 +451,15 @@ func (ep *proxy) getTransactionInfo(ctx context.Context, hash string, withResult
 
 // RequestTransactionCost retrieves how many gas a transaction will consume
 func (ep *proxy) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	ctx, err := ep.pinToShardOfAddress(ctx, tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonTx, err := json.Marshal(tx)
 	if err != nil {
 		return nil, err
@@ -368,6 +481,48 @@ func (ep *proxy) RequestTransactionCost(ctx context.Context, tx *transaction.Fro
 	return &response.Data, nil
 }
 
+// SimulateTransaction executes the transaction on the current state without broadcasting it, returning the generated
+// smart contract results, receipts and logs. The unsigned transactions should be simulated without checking the signature
+func (ep *proxy) SimulateTransaction(
+	ctx context.Context,
+	tx *transaction.FrontendTransaction,
+	checkSignature bool,
+) (*data.TransactionSimulationResults, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	ctx, err := ep.pinToShardOfAddress(ctx, tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonTx, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetSimulateTransaction()
+	if !checkSignature {
+		endpoint += checkSignatureDisabledQueryParam
+	}
+	buff, code, err := ep.PostHTTP(ctx, endpoint, jsonTx)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.ResponseTransactionSimulation{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response.Data.Result, nil
+}
+
 // GetLatestHyperBlockNonce retrieves the latest hyper block (metachain) nonce from the network
 func (ep *proxy) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
 	response, err := ep.GetNetworkStatus(ctx, core.MetachainShardId)
@@ -414,21 +569,21 @@ func (ep *proxy) getHyperBlock(ctx context.Context, endpoint string) (*data.Hype
 func (ep *proxy) GetRawBlockByHash(ctx context.Context, shardId uint32, hash string) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawBlockByHash(shardId, hash)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(sdkHttp.WithShardID(ctx, shardId), endpoint)
 }
 
 // GetRawBlockByNonce retrieves a raw block by hash from the network
 func (ep *proxy) GetRawBlockByNonce(ctx context.Context, shardId uint32, nonce uint64) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawBlockByNonce(shardId, nonce)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(sdkHttp.WithShardID(ctx, shardId), endpoint)
 }
 
 // GetRawStartOfEpochMetaBlock retrieves a raw block by hash from the network
 func (ep *proxy) GetRawStartOfEpochMetaBlock(ctx context.Context, epoch uint32) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawStartOfEpochMetaBlock(epoch)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(sdkHttp.WithShardID(ctx, core.MetachainShardId), endpoint)
 }
 
 func (ep *proxy) getRawBlock(ctx context.Context, endpoint string) ([]byte, error) {
@@ -453,7 +608,7 @@ func (ep *proxy) getRawBlock(ctx context.Context, endpoint string) ([]byte, erro
 func (ep *proxy) GetRawMiniBlockByHash(ctx context.Context, shardId uint32, hash string, epoch uint32) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawMiniBlockByHash(shardId, hash, epoch)
 
-	return ep.getRawMiniBlock(ctx, endpoint)
+	return ep.getRawMiniBlock(sdkHttp.WithShardID(ctx, shardId), endpoint)
 }
 
 func (ep *proxy) getRawMiniBlock(ctx context.Context, endpoint string) ([]byte, error) {
@@ -565,7 +720,7 @@ func (ep *proxy) GetESDTTokenData(
 	ctx context.Context,
 	address sdkCore.AddressHandler,
 	tokenIdentifier string,
-	queryOptions api.AccountQueryOptions, // TODO: provide AccountQueryOptions on all accounts-related getters
+	queryOptions api.AccountQueryOptions,
 ) (*data.ESDTFungibleTokenData, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
@@ -574,6 +729,11 @@ func (ep *proxy) GetESDTTokenData(
 		return nil, ErrInvalidAddress
 	}
 
+	ctx, err := ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := ep.endpointProvider.GetESDTTokenData(address.AddressAsBech32String(), tokenIdentifier)
 	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
 	buff, code, err := ep.GetHTTP(ctx, endpoint)
@@ -589,6 +749,9 @@ func (ep *proxy) GetESDTTokenData(
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.TokenData != nil {
+		response.Data.TokenData.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.TokenData, nil
 }
@@ -599,7 +762,7 @@ func (ep *proxy) GetNFTTokenData(
 	address sdkCore.AddressHandler,
 	tokenIdentifier string,
 	nonce uint64,
-	queryOptions api.AccountQueryOptions, // TODO: provide AccountQueryOptions on all accounts-related getters
+	queryOptions api.AccountQueryOptions,
 ) (*data.ESDTNFTTokenData, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
@@ -608,6 +771,11 @@ func (ep *proxy) GetNFTTokenData(
 		return nil, ErrInvalidAddress
 	}
 
+	ctx, err := ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
 	endpoint := ep.endpointProvider.GetNFTTokenData(address.AddressAsBech32String(), tokenIdentifier, nonce)
 	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
 	buff, code, err := ep.GetHTTP(ctx, endpoint)
@@ -623,10 +791,406 @@ func (ep *proxy) GetNFTTokenData(
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.TokenData != nil {
+		response.Data.TokenData.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.TokenData, nil
 }
 
+// GetAllESDTTokens returns the fungible tokens held by the address, keyed by token identifier
+func (ep *proxy) GetAllESDTTokens(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (map[string]*data.ESDTNFTTokenData, error) {
+	return ep.getAllTokens(ctx, address, queryOptions, func(tokenData *data.ESDTNFTTokenData) bool {
+		return tokenData.Nonce == 0
+	})
+}
+
+// GetAllNFTs returns the NFTs, SFTs and Meta-ESDTs held by the address, keyed by token identifier, the identifiers
+// containing the hex encoded nonce
+func (ep *proxy) GetAllNFTs(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (map[string]*data.ESDTNFTTokenData, error) {
+	return ep.getAllTokens(ctx, address, queryOptions, func(tokenData *data.ESDTNFTTokenData) bool {
+		return tokenData.Nonce > 0
+	})
+}
+
+func (ep *proxy) getAllTokens(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+	filter func(tokenData *data.ESDTNFTTokenData) bool,
+) (map[string]*data.ESDTNFTTokenData, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetAllESDTTokens(address.AddressAsBech32String())
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AllESDTTokensResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	tokens := make(map[string]*data.ESDTNFTTokenData)
+	for identifier, tokenData := range response.Data.Tokens {
+		if tokenData != nil && filter(tokenData) {
+			tokenData.BlockInfo = response.Data.BlockInfo
+			tokens[identifier] = tokenData
+		}
+	}
+
+	return tokens, nil
+}
+
+// GetESDTRoles returns the roles the address has, keyed by token identifier
+func (ep *proxy) GetESDTRoles(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.ESDTRoles, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetESDTRoles(address.AddressAsBech32String())
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.ESDTRolesResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	roles := response.Data.Roles
+	if roles == nil {
+		roles = make(map[string][]string)
+	}
+
+	return &data.ESDTRoles{
+		Roles:     roles,
+		BlockInfo: response.Data.BlockInfo,
+	}, nil
+}
+
+// GetESDTsWithRole returns the identifiers of the tokens the address has the role for, such as ESDTRoleNFTCreate
+func (ep *proxy) GetESDTsWithRole(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	role string,
+	queryOptions api.AccountQueryOptions,
+) (*data.ESDTTokensList, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(role) == 0 {
+		return nil, ErrEmptyRole
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetESDTsWithRole(address.AddressAsBech32String(), role)
+
+	return ep.getTokensList(ctx, sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions))
+}
+
+// GetRegisteredNFTs returns the identifiers of the NFT, SFT and Meta-ESDT collections owned by the address, as the
+// account that registered them or received their ownership
+func (ep *proxy) GetRegisteredNFTs(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.ESDTTokensList, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetRegisteredNFTs(address.AddressAsBech32String())
+
+	return ep.getTokensList(ctx, sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions))
+}
+
+func (ep *proxy) getTokensList(ctx context.Context, endpoint string) (*data.ESDTTokensList, error) {
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.ESDTTokensListResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	tokens := response.Data.Tokens
+	if tokens == nil {
+		tokens = make([]string, 0)
+	}
+
+	return &data.ESDTTokensList{
+		Tokens:    tokens,
+		BlockInfo: response.Data.BlockInfo,
+	}, nil
+}
+
+// GetAccountStorageValue returns the value stored under the key in the account storage. A missing key returns an
+// empty value
+func (ep *proxy) GetAccountStorageValue(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	key []byte,
+	queryOptions api.AccountQueryOptions,
+) (*data.AccountStorageValue, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptyStorageKey
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetAccountStorageValue(address.AddressAsBech32String(), hex.EncodeToString(key))
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AccountStorageValueResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	value, err := hex.DecodeString(response.Data.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AccountStorageValue{
+		Value:     value,
+		BlockInfo: response.Data.BlockInfo,
+	}, nil
+}
+
+// GetAccountKeys returns all the keys and values of the account storage, sorted by key. The storage of large contracts
+// should be fetched in pages, with IterateAccountKeys
+func (ep *proxy) GetAccountKeys(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.AccountKeys, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetAccountKeys(address.AddressAsBech32String())
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AccountKeysResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	pairs, err := data.NewStorageKeyValuePairs(response.Data.Pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AccountKeys{
+		Pairs:     pairs,
+		BlockInfo: response.Data.BlockInfo,
+	}, nil
+}
+
+// IterateAccountKeys returns a page of at most numKeys keys and values of the account storage, sorted by key. The
+// first page is requested with an empty iterator state, the next ones with the iterator state of the previous page,
+// until an empty iterator state is returned. The gateways and the observers not supporting the paged requests will
+// return an HTTP status error
+func (ep *proxy) IterateAccountKeys(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	numKeys uint,
+	iteratorState [][]byte,
+	queryOptions api.AccountQueryOptions,
+) (*data.AccountKeysPage, error) {
+	err := checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if numKeys == 0 {
+		return nil, ErrInvalidNumKeys
+	}
+
+	ctx, err = ep.pinToShardOfAddress(ctx, address.AddressAsBech32String())
+	if err != nil {
+		return nil, err
+	}
+
+	request := &data.IterateAccountKeysRequest{
+		Address:       address.AddressAsBech32String(),
+		NumKeys:       numKeys,
+		IteratorState: iteratorState,
+	}
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := sdkCore.BuildUrlWithAccountQueryOptions(ep.endpointProvider.GetIterateAccountKeys(), queryOptions)
+	buff, code, err := ep.PostHTTP(ctx, endpoint, jsonRequest)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.IterateAccountKeysResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	pairs, err := data.NewStorageKeyValuePairs(response.Data.Pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AccountKeysPage{
+		Pairs:         pairs,
+		IteratorState: response.Data.NewIteratorState,
+		BlockInfo:     response.Data.BlockInfo,
+	}, nil
+}
+
+// pinToShardOfAddress pins the request to the shard of the provided address, if not already pinned, so it is routed to
+// the observers holding the account
+func (ep *proxy) pinToShardOfAddress(ctx context.Context, bech32Address string) (context.Context, error) {
+	if !ep.isShardAware {
+		return ctx, nil
+	}
+	_, isPinned := sdkHttp.ShardIDFromContext(ctx)
+	if isPinned {
+		return ctx, nil
+	}
+
+	shardID, err := ep.GetShardOfAddress(ctx, bech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkHttp.WithShardID(ctx, shardID), nil
+}
+
+// pinToShardOfSenders pins the request to the shard of the senders when all of them are in the same shard, the
+// transactions of different shards being left to the endpoints serving all the shards
+func (ep *proxy) pinToShardOfSenders(ctx context.Context, txs []*transaction.FrontendTransaction) (context.Context, error) {
+	if !ep.isShardAware || len(txs) == 0 {
+		return ctx, nil
+	}
+	_, isPinned := sdkHttp.ShardIDFromContext(ctx)
+	if isPinned {
+		return ctx, nil
+	}
+
+	shardID := uint32(0)
+	for idx, tx := range txs {
+		if tx == nil {
+			return nil, fmt.Errorf("%w at index %d", ErrNilTransaction, idx)
+		}
+
+		senderShardID, err := ep.GetShardOfAddress(ctx, tx.Sender)
+		if err != nil {
+			return nil, err
+		}
+		if idx > 0 && senderShardID != shardID {
+			return ctx, nil
+		}
+		shardID = senderShardID
+	}
+
+	return sdkHttp.WithShardID(ctx, shardID), nil
+}
+
+func checkAccountAddress(address sdkCore.AddressHandler) error {
+	if check.IfNil(address) {
+		return ErrNilAddress
+	}
+	if !address.IsValid() {
+		return ErrInvalidAddress
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ep *proxy) IsInterfaceNil() bool {
 	return ep == nil
@@ -237,7 +237,8 @@ func TestGetAccount(t *testing.T) {
 
 			account := data.AccountResponse{
 				Data: struct {
-					Account *data.Account `json:"account"`
+					Account   *data.Account  `json:"account"`
+					BlockInfo *api.BlockInfo `json:"blockInfo"`
 				}{
 					Account: &data.Account{
 						Nonce:   37,
@@ -314,6 +315,42 @@ func TestProxy_GetNetworkEconomics(t *testing.T) {
 	}, networkEconomics)
 }
 
+func TestProxy_MiddlewaresShouldApplyOnAllRequests(t *testing.T) {
+	t.Parallel()
+
+	responseBytes := []byte(`{"data":{"metrics":{"erd_total_supply":"21556417261819025351089574"}},"code":"successful"}`)
+	numCalls := int32(0)
+	httpClient := &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			status := http.StatusOK
+			if atomic.AddInt32(&numCalls, 1) == 1 {
+				status = http.StatusTooManyRequests
+			}
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+				StatusCode: status,
+			}, nil
+		},
+	}
+	retryMiddleware, err := sdkHttp.NewRetryMiddleware(sdkHttp.ArgsRetryPolicy{
+		MaxRetries:     1,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Multiplier:     1,
+	})
+	require.Nil(t, err)
+
+	args := createMockArgsProxy(httpClient)
+	args.Middlewares = []sdkHttp.Middleware{retryMiddleware}
+	ep, _ := NewProxy(args)
+
+	networkEconomics, err := ep.GetNetworkEconomics(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "21556417261819025351089574", networkEconomics.TotalSupply)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+}
+
 func TestProxy_RequestTransactionCost(t *testing.T) {
 	t.Parallel()
 
@@ -606,7 +643,7 @@ func TestProxy_GetEnableEpochsConfig(t *testing.T) {
 
 	enableEpochs := config.EnableEpochs{
 		BalanceWaitingListsEnableEpoch: 1,
-		WaitingListFixEnableEpoch:      1,
+		StakingV2EnableEpoch:           1,
 	}
 
 	expectedEnableEpochsConfig := &data.EnableEpochsConfig{
@@ -775,6 +812,7 @@ func TestElrondProxy_GetESDTTokenData(t *testing.T) {
 		response := &data.ESDTFungibleResponse{
 			Data: struct {
 				TokenData *data.ESDTFungibleTokenData `json:"tokenData"`
+				BlockInfo *api.BlockInfo              `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -802,6 +840,7 @@ func TestElrondProxy_GetESDTTokenData(t *testing.T) {
 		response := &data.ESDTFungibleResponse{
 			Data: struct {
 				TokenData *data.ESDTFungibleTokenData `json:"tokenData"`
+				BlockInfo *api.BlockInfo              `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -929,6 +968,7 @@ func TestElrondProxy_GetNFTTokenData(t *testing.T) {
 		response := &data.ESDTNFTResponse{
 			Data: struct {
 				TokenData *data.ESDTNFTTokenData `json:"tokenData"`
+				BlockInfo *api.BlockInfo         `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -963,6 +1003,7 @@ func TestElrondProxy_GetNFTTokenData(t *testing.T) {
 		response := &data.ESDTNFTResponse{
 			Data: struct {
 				TokenData *data.ESDTNFTTokenData `json:"tokenData"`
+				BlockInfo *api.BlockInfo         `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -990,3 +1031,723 @@ func TestElrondProxy_GetNFTTokenData(t *testing.T) {
 		assert.False(t, responseTokenData == tokenData) // pointer testing
 	})
 }
+
+func TestProxy_CassetteReplayShouldDecodeTheRecordedSession(t *testing.T) {
+	t.Parallel()
+
+	cassette, err := sdkHttp.LoadCassette("testdata/proxySession.json")
+	require.Nil(t, err)
+	replayMiddleware, err := sdkHttp.NewCassetteMiddleware(sdkHttp.ReplayMode, cassette)
+	require.Nil(t, err)
+
+	args := createMockArgsProxy(nil)
+	args.EntityType = sdkCore.Proxy
+	args.Middlewares = []sdkHttp.Middleware{replayMiddleware}
+	ep, _ := NewProxy(args)
+
+	hyperBlock, err := ep.GetHyperBlockByNonce(context.Background(), 1720)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1722), hyperBlock.Round)
+	assert.Equal(t, uint64(3), hyperBlock.Epoch)
+	require.Len(t, hyperBlock.ShardBlocks, 1)
+	assert.Equal(t, uint64(1718), hyperBlock.ShardBlocks[0].Nonce)
+	require.Len(t, hyperBlock.Transactions, 1)
+	assert.Equal(t, "1000000000000000000", hyperBlock.Transactions[0].Value)
+	assert.Equal(t, uint32(1), hyperBlock.Transactions[0].DestinationShard)
+
+	txInfo, err := ep.GetTransactionInfoWithResults(context.Background(), hyperBlock.Transactions[0].Hash)
+	require.Nil(t, err)
+	assert.Equal(t, []byte("hello"), txInfo.Data.Transaction.Data)
+	assert.Equal(t, uint64(1720), txInfo.Data.Transaction.HyperBlockNonce)
+	require.Len(t, txInfo.Data.Transaction.ScResults, 1)
+	assert.Equal(t, "@6f6b", txInfo.Data.Transaction.ScResults[0].Data)
+
+	response, err := ep.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+		Address:  "erd1qqqqqqqqqqqqqpgqhe8t5jewej70zupmh44jurgn29psua5l2jps3ntjj3",
+		FuncName: "getSum",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{42}}, response.Data.ReturnData)
+	assert.Equal(t, uint64(18446744073685949187), response.Data.GasRemaining)
+
+	_, err = ep.GetTransactionInfo(context.Background(), hyperBlock.Transactions[0].Hash)
+	assert.True(t, errors.Is(err, sdkHttp.ErrInteractionNotFound))
+}
+
+func TestProxy_GetAccountStorageValue(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("invalid arguments, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), nil, key, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.Equal(t, ErrNilAddress, err)
+
+		value, err = ep.GetAccountStorageValue(context.Background(), data.NewAddressFromBytes([]byte("invalid")), key, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.Equal(t, ErrInvalidAddress, err)
+
+		value, err = ep.GetAccountStorageValue(context.Background(), validAddress, nil, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.Equal(t, ErrEmptyStorageKey, err)
+	})
+	t.Run("invalid status, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytesWithStatus(make([]byte, 0), http.StatusNotFound)
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), validAddress, key, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
+	})
+	t.Run("response returned error, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"error":"expected error"}`))
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), validAddress, key, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.Equal(t, "expected error", err.Error())
+	})
+	t.Run("invalid hex value, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":{"value":"not hex"}}`))
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), validAddress, key, emptyQueryOptions)
+		assert.Nil(t, value)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing key should return an empty value", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{"value":""}}`))))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), validAddress, key, emptyQueryOptions)
+		assert.Nil(t, err)
+		assert.Empty(t, value.Value)
+		assert.Nil(t, value.BlockInfo)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuffix := "/address/" + validAddress.AddressAsBech32String() + "/key/6b6579?onFinalBlock=true"
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), expectedSuffix))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"value":"0a","blockInfo":{"nonce":37,"hash":"abba","rootHash":"baab"}}}`)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), validAddress, key, api.AccountQueryOptions{OnFinalBlock: true})
+		assert.Nil(t, err)
+		expectedValue := &data.AccountStorageValue{
+			Value:     data.StorageValue{10},
+			BlockInfo: &api.BlockInfo{Nonce: 37, Hash: "abba", RootHash: "baab"},
+		}
+		assert.Equal(t, expectedValue, value)
+	})
+}
+
+func TestProxy_GetAccountKeys(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("nil address, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		pairs, err := ep.GetAccountKeys(context.Background(), nil, emptyQueryOptions)
+		assert.Nil(t, pairs)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("invalid hex key, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":{"pairs":{"not hex":"01"}}}`))
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		pairs, err := ep.GetAccountKeys(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, pairs)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/address/"+validAddress.AddressAsBech32String()+"/keys?blockNonce=37"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"pairs":{"6b657932":"02","6b657931":"01"},"blockInfo":{"nonce":37,"hash":"abba"}}}`)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		queryOptions := sdkCore.AccountQueryOptionsOnBlockNonce(37)
+		keys, err := ep.GetAccountKeys(context.Background(), validAddress, queryOptions)
+		assert.Nil(t, err)
+		expectedKeys := &data.AccountKeys{
+			Pairs: []*data.StorageKeyValuePair{
+				{Key: []byte("key1"), Value: data.StorageValue{1}},
+				{Key: []byte("key2"), Value: data.StorageValue{2}},
+			},
+			BlockInfo: &api.BlockInfo{Nonce: 37, Hash: "abba"},
+		}
+		assert.Equal(t, expectedKeys, keys)
+	})
+}
+
+func TestProxy_IterateAccountKeys(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("invalid arguments, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		page, err := ep.IterateAccountKeys(context.Background(), nil, 10, nil, emptyQueryOptions)
+		assert.Nil(t, page)
+		assert.Equal(t, ErrNilAddress, err)
+
+		page, err = ep.IterateAccountKeys(context.Background(), validAddress, 0, nil, emptyQueryOptions)
+		assert.Nil(t, page)
+		assert.Equal(t, ErrInvalidNumKeys, err)
+	})
+	t.Run("unsupported route, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytesWithStatus(make([]byte, 0), http.StatusNotFound)
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		page, err := ep.IterateAccountKeys(context.Background(), validAddress, 10, nil, emptyQueryOptions)
+		assert.Nil(t, page)
+		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		iteratorState := [][]byte{[]byte("state")}
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/address/iterate-keys?onFinalBlock=true"))
+
+				body, _ := ioutil.ReadAll(req.Body)
+				request := &data.IterateAccountKeysRequest{}
+				require.Nil(t, json.Unmarshal(body, request))
+				assert.Equal(t, validAddress.AddressAsBech32String(), request.Address)
+				assert.Equal(t, uint(2), request.NumKeys)
+				assert.Equal(t, iteratorState, request.IteratorState)
+
+				response := &data.IterateAccountKeysResponse{}
+				response.Data.Pairs = map[string]string{"6b657931": "01"}
+				response.Data.NewIteratorState = [][]byte{[]byte("next state")}
+				response.Data.BlockInfo = &api.BlockInfo{Nonce: 37, RootHash: "baab"}
+				responseBytes, _ := json.Marshal(response)
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		page, err := ep.IterateAccountKeys(context.Background(), validAddress, 2, iteratorState, api.AccountQueryOptions{OnFinalBlock: true})
+		assert.Nil(t, err)
+		expectedPage := &data.AccountKeysPage{
+			Pairs:         []*data.StorageKeyValuePair{{Key: []byte("key1"), Value: data.StorageValue{1}}},
+			IteratorState: [][]byte{[]byte("next state")},
+			BlockInfo:     &api.BlockInfo{Nonce: 37, RootHash: "baab"},
+		}
+		assert.Equal(t, expectedPage, page)
+	})
+}
+
+func TestProxy_GetAllESDTTokensAndNFTs(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	responseBytes := []byte(`{"data":{"esdts":{
+		"TKN-001122":{"tokenIdentifier":"TKN-001122","balance":"1000","properties":"00"},
+		"NFT-334455-0a":{"tokenIdentifier":"NFT-334455-0a","balance":"1","nonce":10,"creator":"erd1creator","royalties":"500","uris":["dXJp"],"attributes":"YXR0cmlidXRlcw==","properties":"01"}
+	}}}`)
+	t.Run("nil address, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+
+		tokens, err := ep.GetAllESDTTokens(context.Background(), nil, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, ErrNilAddress, err)
+
+		tokens, err = ep.GetAllNFTs(context.Background(), nil, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("invalid status, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytesWithStatus(make([]byte, 0), http.StatusNotFound)
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		tokens, err := ep.GetAllESDTTokens(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
+	})
+	t.Run("response returned error, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"error":"expected error"}`))
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		tokens, err := ep.GetAllNFTs(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, "expected error", err.Error())
+	})
+	t.Run("should return only the fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/address/"+validAddress.AddressAsBech32String()+"/esdt"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		tokens, err := ep.GetAllESDTTokens(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		expectedTokens := map[string]*data.ESDTNFTTokenData{
+			"TKN-001122": {
+				TokenIdentifier: "TKN-001122",
+				Balance:         "1000",
+				Properties:      "00",
+			},
+		}
+		assert.Equal(t, expectedTokens, tokens)
+	})
+	t.Run("should return only the non-fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+
+		tokens, err := ep.GetAllNFTs(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		expectedTokens := map[string]*data.ESDTNFTTokenData{
+			"NFT-334455-0a": {
+				TokenIdentifier: "NFT-334455-0a",
+				Balance:         "1",
+				Properties:      "01",
+				Nonce:           10,
+				Creator:         "erd1creator",
+				Royalties:       "500",
+				URIs:            [][]byte{[]byte("uri")},
+				Attributes:      []byte("attributes"),
+			},
+		}
+		assert.Equal(t, expectedTokens, tokens)
+
+		properties, err := tokens["NFT-334455-0a"].GetProperties()
+		assert.Nil(t, err)
+		assert.True(t, properties.Frozen)
+	})
+}
+
+func TestProxy_GetESDTRoles(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("invalid address, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		roles, err := ep.GetESDTRoles(context.Background(), data.NewAddressFromBytes([]byte("invalid")), emptyQueryOptions)
+		assert.Nil(t, roles)
+		assert.Equal(t, ErrInvalidAddress, err)
+	})
+	t.Run("no roles should return an empty map", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{}}`))))
+
+		roles, err := ep.GetESDTRoles(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, &data.ESDTRoles{Roles: make(map[string][]string)}, roles)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/address/"+validAddress.AddressAsBech32String()+"/esdts/roles"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"roles":{"TKN-001122":["ESDTRoleLocalMint","ESDTRoleLocalBurn"]},"blockInfo":{"nonce":37}}}`)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		roles, err := ep.GetESDTRoles(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		expectedRoles := &data.ESDTRoles{
+			Roles:     map[string][]string{"TKN-001122": {"ESDTRoleLocalMint", "ESDTRoleLocalBurn"}},
+			BlockInfo: &api.BlockInfo{Nonce: 37},
+		}
+		assert.Equal(t, expectedRoles, roles)
+	})
+}
+
+func TestProxy_GetESDTsWithRole(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("empty role, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		tokens, err := ep.GetESDTsWithRole(context.Background(), validAddress, "", emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, ErrEmptyRole, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuffix := "/address/" + validAddress.AddressAsBech32String() + "/esdts-with-role/ESDTRoleNFTCreate?onFinalBlock=true"
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), expectedSuffix))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"tokens":["NFT-334455"],"blockInfo":{"nonce":37,"hash":"abba"}}}`)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		tokens, err := ep.GetESDTsWithRole(context.Background(), validAddress, "ESDTRoleNFTCreate", api.AccountQueryOptions{OnFinalBlock: true})
+		assert.Nil(t, err)
+		expectedTokens := &data.ESDTTokensList{
+			Tokens:    []string{"NFT-334455"},
+			BlockInfo: &api.BlockInfo{Nonce: 37, Hash: "abba"},
+		}
+		assert.Equal(t, expectedTokens, tokens)
+	})
+}
+
+func TestProxy_GetRegisteredNFTs(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	emptyQueryOptions := api.AccountQueryOptions{}
+	t.Run("nil address, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		tokens, err := ep.GetRegisteredNFTs(context.Background(), nil, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("invalid response bytes, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte("invalid json"))))
+
+		tokens, err := ep.GetRegisteredNFTs(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, tokens)
+		assert.NotNil(t, err)
+	})
+	t.Run("no tokens should return an empty list", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{"tokens":null}}`))))
+
+		tokens, err := ep.GetRegisteredNFTs(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, &data.ESDTTokensList{Tokens: make([]string, 0)}, tokens)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/address/"+validAddress.AddressAsBech32String()+"/registered-nfts"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"tokens":["NFT-334455","SFT-667788"]}}`)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		tokens, err := ep.GetRegisteredNFTs(context.Background(), validAddress, emptyQueryOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, &data.ESDTTokensList{Tokens: []string{"NFT-334455", "SFT-667788"}}, tokens)
+	})
+}
+
+func TestProxy_GetAccountWithQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	t.Run("nil address, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		account, err := ep.GetAccountWithQueryOptions(context.Background(), nil, sdkCore.AccountQueryOptionsOnBlockNonce(37))
+		assert.Nil(t, account)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("past block should not check the finality and should return the block info", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuffix := "/address/" + validAddress.AddressAsBech32String() + "?blockNonce=37"
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), expectedSuffix))
+
+				responseBytes := []byte(`{"data":{"account":{"nonce":3,"balance":"1000"},"blockInfo":{"nonce":37,"hash":"abba","rootHash":"baab"}}}`)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.FinalityCheck = true
+		ep, _ := NewProxy(args)
+		ep.finalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				assert.Fail(t, "should have not checked the finality")
+				return nil
+			},
+		}
+
+		account, err := ep.GetAccountWithQueryOptions(context.Background(), validAddress, sdkCore.AccountQueryOptionsOnBlockNonce(37))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), account.Nonce)
+		assert.Equal(t, "1000", account.Balance)
+		assert.Equal(t, &api.BlockInfo{Nonce: 37, Hash: "abba", RootHash: "baab"}, account.BlockInfo)
+	})
+}
+
+func TestProxy_ExecuteVMQueryWithQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request, should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		response, err := ep.ExecuteVMQueryWithQueryOptions(context.Background(), nil, api.AccountQueryOptions{})
+		assert.Nil(t, response)
+		assert.Equal(t, ErrNilRequest, err)
+	})
+	t.Run("should query the state at the block hash and return the block info", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/vm-values/query?blockHash=abba"))
+
+				responseBytes := []byte(`{"data":{"data":{"returnData":["MC41LjU="],"returnCode":"ok"},"blockInfo":{"nonce":37,"hash":"abba"}}}`)
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		request := &data.VmValueRequest{
+			Address:  "erd1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q6shuwt",
+			FuncName: "version",
+		}
+		response, err := ep.ExecuteVMQueryWithQueryOptions(context.Background(), request, sdkCore.AccountQueryOptionsOnBlockHash([]byte{0xab, 0xba}))
+		require.Nil(t, err)
+		assert.Equal(t, "0.5.5", string(response.Data.ReturnData[0]))
+		assert.Equal(t, &api.BlockInfo{Nonce: 37, Hash: "abba"}, response.BlockInfo)
+	})
+}
+
+func TestProxy_TokenQueriesShouldReturnTheBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	validAddress := data.NewAddressFromBytes(bytes.Repeat([]byte("1"), 32))
+	queryOptions := sdkCore.AccountQueryOptionsOnBlockRootHash([]byte{0xba, 0xab}, 7)
+	expectedBlockInfo := &api.BlockInfo{Nonce: 37, RootHash: "baab"}
+	newClient := func(responseBytes []byte) *mockHTTPClient {
+		return &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "?blockRootHash=baab&hintEpoch=7"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+	}
+
+	t.Run("fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"tokenData":{"tokenIdentifier":"TKN-001122","balance":"10"},"blockInfo":{"nonce":37,"rootHash":"baab"}}}`)
+		ep, _ := NewProxy(createMockArgsProxy(newClient(responseBytes)))
+
+		tokenData, err := ep.GetESDTTokenData(context.Background(), validAddress, "TKN-001122", queryOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, "10", tokenData.Balance)
+		assert.Equal(t, expectedBlockInfo, tokenData.BlockInfo)
+	})
+	t.Run("non-fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"tokenData":{"tokenIdentifier":"NFT-334455-0a","balance":"1","nonce":10},"blockInfo":{"nonce":37,"rootHash":"baab"}}}`)
+		ep, _ := NewProxy(createMockArgsProxy(newClient(responseBytes)))
+
+		tokenData, err := ep.GetNFTTokenData(context.Background(), validAddress, "NFT-334455", 10, queryOptions)
+		assert.Nil(t, err)
+		assert.Equal(t, "1", tokenData.Balance)
+		assert.Equal(t, expectedBlockInfo, tokenData.BlockInfo)
+	})
+	t.Run("all tokens", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"esdts":{"TKN-001122":{"tokenIdentifier":"TKN-001122","balance":"10"}},"blockInfo":{"nonce":37,"rootHash":"baab"}}}`)
+		ep, _ := NewProxy(createMockArgsProxy(newClient(responseBytes)))
+
+		tokens, err := ep.GetAllESDTTokens(context.Background(), validAddress, queryOptions)
+		assert.Nil(t, err)
+		require.Equal(t, 1, len(tokens))
+		assert.Equal(t, expectedBlockInfo, tokens["TKN-001122"].BlockInfo)
+	})
+}
+
+func TestProxy_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    3,
+		Value:    "0",
+		Receiver: "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx",
+		Sender:   "erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8",
+		GasLimit: 5000000,
+		Data:     []byte("claim"),
+	}
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(make([]byte, 0))))
+
+		results, err := ep.SimulateTransaction(context.Background(), nil, true)
+		assert.Nil(t, results)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("unsupported route, should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytesWithStatus(make([]byte, 0), http.StatusNotFound)
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		results, err := ep.SimulateTransaction(context.Background(), tx, true)
+		assert.Nil(t, results)
+		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
+	})
+	t.Run("response error should error", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes, _ := json.Marshal(&data.ResponseTransactionSimulation{Error: "invalid transaction"})
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+
+		results, err := ep.SimulateTransaction(context.Background(), tx, true)
+		assert.Nil(t, results)
+		assert.Equal(t, "invalid transaction", err.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"result":{"status":"success","scResults":{"aa":{"nonce":4,"value":100,"data":"@6f6b"}},"hash":"bb"}},"error":"","code":"successful"}`)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/transaction/simulate"))
+
+				body, _ := ioutil.ReadAll(req.Body)
+				sentTx := &transaction.FrontendTransaction{}
+				require.Nil(t, json.Unmarshal(body, sentTx))
+				assert.Equal(t, tx, sentTx)
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		results, err := ep.SimulateTransaction(context.Background(), tx, true)
+		require.Nil(t, err)
+		assert.Equal(t, transaction.TxStatusSuccess, results.Status)
+		assert.Equal(t, "bb", results.Hash)
+		require.Len(t, results.ScResults, 1)
+		assert.Equal(t, "@6f6b", results.ScResults["aa"].Data)
+		assert.Equal(t, uint64(4), results.ScResults["aa"].Nonce)
+		assert.Empty(t, results.FailReason())
+	})
+	t.Run("should not check the signature", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), "/transaction/simulate?checkSignature=false"))
+
+				return &http.Response{
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"data":{"result":{}}}`))),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		results, err := ep.SimulateTransaction(context.Background(), tx, false)
+		assert.Nil(t, err)
+		assert.NotNil(t, results)
+	})
+}
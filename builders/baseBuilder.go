@@ -22,7 +22,7 @@ func (builder *baseBuilder) addBytes(bytes []byte) {
 	builder.args = append(builder.args, hex.EncodeToString(bytes))
 }
 
-func (builder *baseBuilder) checkAddress(address core.AddressHandler) error {
+func checkAddress(address core.AddressHandler) error {
 	if check.IfNil(address) {
 		return fmt.Errorf("%w in builder.checkAddress", ErrNilAddress)
 	}
@@ -52,7 +52,7 @@ func (builder *baseBuilder) addArgAddress(address core.AddressHandler) {
 		return
 	}
 
-	err := builder.checkAddress(address)
+	err := checkAddress(address)
 	if err != nil {
 		builder.err = err
 		return
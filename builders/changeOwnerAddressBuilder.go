@@ -0,0 +1,112 @@
+package builders
+
+import (
+	"fmt"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DefaultChangeOwnerAddressGasLimit is the gas limit added for the execution of the ChangeOwnerAddress built-in function
+const DefaultChangeOwnerAddressGasLimit = uint64(6000000)
+
+type changeOwnerAddressBuilder struct {
+	sender        core.AddressHandler
+	contract      core.AddressHandler
+	newOwner      core.AddressHandler
+	gasLimit      uint64
+	networkConfig *data.NetworkConfig
+}
+
+// NewChangeOwnerAddressBuilder creates a new builder for the ChangeOwnerAddress transactions
+func NewChangeOwnerAddressBuilder() *changeOwnerAddressBuilder {
+	return &changeOwnerAddressBuilder{
+		gasLimit: DefaultChangeOwnerAddressGasLimit,
+	}
+}
+
+// SetSender sets the account sending the transaction, which should be the contract's current owner
+func (coab *changeOwnerAddressBuilder) SetSender(sender core.AddressHandler) *changeOwnerAddressBuilder {
+	coab.sender = sender
+
+	return coab
+}
+
+// SetContract sets the contract changing its owner
+func (coab *changeOwnerAddressBuilder) SetContract(contract core.AddressHandler) *changeOwnerAddressBuilder {
+	coab.contract = contract
+
+	return coab
+}
+
+// SetNewOwner sets the new owner of the contract
+func (coab *changeOwnerAddressBuilder) SetNewOwner(newOwner core.AddressHandler) *changeOwnerAddressBuilder {
+	coab.newOwner = newOwner
+
+	return coab
+}
+
+// SetGasLimit overrides the gas limit added for the execution of the ChangeOwnerAddress built-in function
+func (coab *changeOwnerAddressBuilder) SetGasLimit(gasLimit uint64) *changeOwnerAddressBuilder {
+	coab.gasLimit = gasLimit
+
+	return coab
+}
+
+// SetNetworkConfig sets the network config
+func (coab *changeOwnerAddressBuilder) SetNetworkConfig(config *data.NetworkConfig) *changeOwnerAddressBuilder {
+	coab.networkConfig = config
+
+	return coab
+}
+
+// Build builds the ChangeOwnerAddress transaction, having the contract as the receiver.
+// The returned transaction will not be signed and will have the nonce set to 0
+func (coab *changeOwnerAddressBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := coab.checkArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	dataField, err := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionChangeOwnerAddress).
+		ArgAddress(coab.newOwner).
+		ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    "0",
+		Receiver: coab.contract.AddressAsBech32String(),
+		Sender:   coab.sender.AddressAsBech32String(),
+		GasPrice: coab.networkConfig.MinGasPrice,
+		GasLimit: coab.networkConfig.MinGasLimit + coab.networkConfig.GasPerDataByte*uint64(len(dataField)) + coab.gasLimit,
+		Data:     dataField,
+		ChainID:  coab.networkConfig.ChainID,
+		Version:  coab.networkConfig.MinTransactionVersion,
+	}, nil
+}
+
+func (coab *changeOwnerAddressBuilder) checkArguments() error {
+	if coab.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	err := checkAddress(coab.sender)
+	if err != nil {
+		return fmt.Errorf("%w for the sender", err)
+	}
+	err = checkAddress(coab.contract)
+	if err != nil {
+		return fmt.Errorf("%w for the contract", err)
+	}
+	err = checkAddress(coab.newOwner)
+	if err != nil {
+		return fmt.Errorf("%w for the new owner", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package builders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNewOwnerAddress = "erd1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpsk46qrs"
+
+func createChangeOwnerAddressBuilder(t *testing.T) *changeOwnerAddressBuilder {
+	contract, err := data.NewAddressFromBech32String(testReceiverAddress)
+	require.Nil(t, err)
+	newOwner, err := data.NewAddressFromBech32String(testNewOwnerAddress)
+	require.Nil(t, err)
+
+	return NewChangeOwnerAddressBuilder().
+		SetSender(createTestSender(t)).
+		SetContract(contract).
+		SetNewOwner(newOwner).
+		SetNetworkConfig(createMockArgsEsdtTransactionsFactory().NetworkConfig)
+}
+
+func TestChangeOwnerAddressBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createChangeOwnerAddressBuilder(t).SetNetworkConfig(nil).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createChangeOwnerAddressBuilder(t).SetSender(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+
+		tx, err = createChangeOwnerAddressBuilder(t).SetContract(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+
+		tx, err = createChangeOwnerAddressBuilder(t).SetNewOwner(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createChangeOwnerAddressBuilder(t).Build()
+		require.Nil(t, err)
+
+		expectedData := "ChangeOwnerAddress@6a3f3c1ada87cbd89b9b978f3d0946e1aa8b86982ab105b28284e9a5c90b6b83"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testReceiverAddress, tx.Receiver)
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData))+DefaultChangeOwnerAddressGasLimit, tx.GasLimit)
+	})
+	t.Run("should use the provided gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createChangeOwnerAddressBuilder(t).SetGasLimit(100).Build()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(50000+1500*len(tx.Data)+100), tx.GasLimit)
+	})
+}
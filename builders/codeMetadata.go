@@ -0,0 +1,51 @@
+package builders
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// wasmVMType is the VM type of the contracts deployed on the WASM VM
+const wasmVMType = "0500"
+
+// CodeMetadata holds the flags set on a contract when it is deployed or upgraded
+type CodeMetadata struct {
+	Upgradeable bool
+	Readable    bool
+	Payable     bool
+	PayableBySC bool
+}
+
+// DefaultCodeMetadata returns the code metadata of an upgradeable and readable contract that can not receive EGLD
+func DefaultCodeMetadata() CodeMetadata {
+	return CodeMetadata{
+		Upgradeable: true,
+		Readable:    true,
+	}
+}
+
+// ToBytes returns the code metadata as it is encoded in the deploy and upgrade transactions
+func (metadata CodeMetadata) ToBytes() []byte {
+	vmMetadata := &vmcommon.CodeMetadata{
+		Upgradeable: metadata.Upgradeable,
+		Readable:    metadata.Readable,
+		Payable:     metadata.Payable,
+		PayableBySC: metadata.PayableBySC,
+	}
+
+	return vmMetadata.ToBytes()
+}
+
+func loadContractCode(filename string) ([]byte, error) {
+	code, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w in file %s", ErrEmptyCode, filename)
+	}
+
+	return code, nil
+}
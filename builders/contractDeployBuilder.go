@@ -0,0 +1,188 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// contractDeployAddressLength is the length of the zero address receiving the contract deploy transactions
+const contractDeployAddressLength = 32
+
+type contractDeployBuilder struct {
+	addressComputer ContractAddressComputer
+	sender          core.AddressHandler
+	code            []byte
+	codeMetadata    CodeMetadata
+	arguments       [][]byte
+	value           *big.Int
+	gasLimit        uint64
+	networkConfig   *data.NetworkConfig
+	err             error
+}
+
+// NewContractDeployBuilder creates a new builder for the contract deploy transactions. The address computer is used
+// to predict the address of the deployed contract
+func NewContractDeployBuilder(addressComputer ContractAddressComputer) (*contractDeployBuilder, error) {
+	if check.IfNil(addressComputer) {
+		return nil, ErrNilContractAddressComputer
+	}
+
+	return &contractDeployBuilder{
+		addressComputer: addressComputer,
+		codeMetadata:    DefaultCodeMetadata(),
+		value:           big.NewInt(0),
+	}, nil
+}
+
+// SetSender sets the account deploying the contract, which will become the contract's owner
+func (cdb *contractDeployBuilder) SetSender(sender core.AddressHandler) *contractDeployBuilder {
+	cdb.sender = sender
+
+	return cdb
+}
+
+// SetCode sets the WASM code of the contract
+func (cdb *contractDeployBuilder) SetCode(code []byte) *contractDeployBuilder {
+	cdb.code = code
+	cdb.err = nil
+
+	return cdb
+}
+
+// SetCodeFromFile loads the WASM code of the contract from a .wasm file. The loading error, if any, is returned by Build
+func (cdb *contractDeployBuilder) SetCodeFromFile(filename string) *contractDeployBuilder {
+	cdb.code, cdb.err = loadContractCode(filename)
+
+	return cdb
+}
+
+// SetCodeMetadata overrides the default code metadata, an upgradeable and readable contract that can not receive EGLD
+func (cdb *contractDeployBuilder) SetCodeMetadata(metadata CodeMetadata) *contractDeployBuilder {
+	cdb.codeMetadata = metadata
+
+	return cdb
+}
+
+// SetArguments sets the arguments of the contract's init function
+func (cdb *contractDeployBuilder) SetArguments(arguments ...[]byte) *contractDeployBuilder {
+	cdb.arguments = arguments
+
+	return cdb
+}
+
+// SetValue sets the EGLD value sent to the contract's init function. The contract should be payable
+func (cdb *contractDeployBuilder) SetValue(value *big.Int) *contractDeployBuilder {
+	cdb.value = value
+
+	return cdb
+}
+
+// SetGasLimit sets the gas limit needed for the deployment and the execution of the contract's init function.
+// The gas needed for the data field is added by the builder
+func (cdb *contractDeployBuilder) SetGasLimit(gasLimit uint64) *contractDeployBuilder {
+	cdb.gasLimit = gasLimit
+
+	return cdb
+}
+
+// SetNetworkConfig sets the network config
+func (cdb *contractDeployBuilder) SetNetworkConfig(config *data.NetworkConfig) *contractDeployBuilder {
+	cdb.networkConfig = config
+
+	return cdb
+}
+
+// Build builds the contract deploy transaction, having the code@vmType@codeMetadata@arguments data field and the
+// zero address as the receiver.
+// The returned transaction will not be signed and will have the nonce set to 0
+func (cdb *contractDeployBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := cdb.checkArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := NewTxDataBuilder().
+		Function(hex.EncodeToString(cdb.code)).
+		ArgHexString(wasmVMType).
+		ArgBytes(cdb.codeMetadata.ToBytes())
+	for _, arg := range cdb.arguments {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    cdb.value.String(),
+		Receiver: contractDeployAddress().AddressAsBech32String(),
+		Sender:   cdb.sender.AddressAsBech32String(),
+		GasPrice: cdb.networkConfig.MinGasPrice,
+		GasLimit: cdb.networkConfig.MinGasLimit + cdb.networkConfig.GasPerDataByte*uint64(len(dataField)) + cdb.gasLimit,
+		Data:     dataField,
+		ChainID:  cdb.networkConfig.ChainID,
+		Version:  cdb.networkConfig.MinTransactionVersion,
+	}, nil
+}
+
+// ComputeContractAddress returns the address the contract will have after the deploy transaction is executed. It
+// should be called after the transaction's nonce was set, as the address depends on the sender's nonce
+func (cdb *contractDeployBuilder) ComputeContractAddress(tx *transaction.FrontendTransaction) (core.AddressHandler, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+	if tx.Receiver != contractDeployAddress().AddressAsBech32String() {
+		return nil, ErrNotDeployTransaction
+	}
+
+	sender, err := data.NewAddressFromBech32String(tx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the sender", err)
+	}
+
+	return cdb.addressComputer.ComputeArwenScAddress(sender, tx.Nonce)
+}
+
+func (cdb *contractDeployBuilder) checkArguments() error {
+	if cdb.err != nil {
+		return cdb.err
+	}
+	if cdb.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	err := checkAddress(cdb.sender)
+	if err != nil {
+		return fmt.Errorf("%w for the sender", err)
+	}
+
+	return checkContractCodeArguments(cdb.code, cdb.value, cdb.gasLimit)
+}
+
+func checkContractCodeArguments(code []byte, value *big.Int, gasLimit uint64) error {
+	if len(code) == 0 {
+		return ErrEmptyCode
+	}
+	if value == nil {
+		return fmt.Errorf("%w for the value", ErrNilValue)
+	}
+	if value.Sign() < 0 {
+		return fmt.Errorf("%w for the value", ErrInvalidValue)
+	}
+	if gasLimit == 0 {
+		return fmt.Errorf("%w for the gas limit", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func contractDeployAddress() core.AddressHandler {
+	return data.NewAddressFromBytes(make([]byte, contractDeployAddressLength))
+}
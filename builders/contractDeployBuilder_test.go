@@ -0,0 +1,226 @@
+package builders
+
+import (
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContractDeployAddress = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu"
+	testContractCodeHex       = "0061736d01000000"
+)
+
+var testContractCode = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+func createContractDeployBuilder(t *testing.T) *contractDeployBuilder {
+	builder, err := NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{})
+	require.Nil(t, err)
+
+	return builder.
+		SetSender(createTestSender(t)).
+		SetCode(testContractCode).
+		SetGasLimit(10000000).
+		SetNetworkConfig(createMockArgsEsdtTransactionsFactory().NetworkConfig)
+}
+
+func writeTestContractCode(t *testing.T, code []byte) string {
+	dir, err := ioutil.TempDir("", "builders-*")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	filename := filepath.Join(dir, "contract.wasm")
+	require.Nil(t, ioutil.WriteFile(filename, code, 0600))
+
+	return filename
+}
+
+func TestCodeMetadata_ToBytes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte{0, 0}, CodeMetadata{}.ToBytes())
+	assert.Equal(t, []byte{5, 0}, DefaultCodeMetadata().ToBytes())
+	assert.Equal(t, []byte{1, 2}, CodeMetadata{Upgradeable: true, Payable: true}.ToBytes())
+	assert.Equal(t, []byte{4, 4}, CodeMetadata{Readable: true, PayableBySC: true}.ToBytes())
+	assert.Equal(t, []byte{5, 6}, CodeMetadata{Upgradeable: true, Readable: true, Payable: true, PayableBySC: true}.ToBytes())
+}
+
+func TestNewContractDeployBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address computer should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder, err := NewContractDeployBuilder(nil)
+		assert.Nil(t, builder)
+		assert.Equal(t, ErrNilContractAddressComputer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		builder, err := NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{})
+		assert.NotNil(t, builder)
+		assert.Nil(t, err)
+	})
+}
+
+func TestContractDeployBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).SetNetworkConfig(nil).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).SetSender(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("empty code should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).SetCode(nil).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyCode, err)
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).SetValue(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilValue))
+
+		tx, err = createContractDeployBuilder(t).SetValue(big.NewInt(-1)).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("zero gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).SetGasLimit(0).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("missing code file should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createContractDeployBuilder(t).SetCodeFromFile(filepath.Join(os.TempDir(), "missing-contract.wasm"))
+		tx, err := builder.Build()
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+
+		tx, err = builder.SetCode(testContractCode).Build()
+		assert.NotNil(t, tx)
+		assert.Nil(t, err)
+	})
+	t.Run("empty code file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := writeTestContractCode(t, make([]byte, 0))
+		tx, err := createContractDeployBuilder(t).SetCodeFromFile(filename).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrEmptyCode))
+	})
+	t.Run("should work with the default code metadata", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).Build()
+		require.Nil(t, err)
+
+		expectedData := testContractCodeHex + "@0500@0500"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testContractDeployAddress, tx.Receiver)
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+10000000), tx.GasLimit)
+		assert.Equal(t, uint64(1000000000), tx.GasPrice)
+		assert.Equal(t, "T", tx.ChainID)
+		assert.Equal(t, uint32(1), tx.Version)
+		assert.Equal(t, uint64(0), tx.Nonce)
+		assert.Empty(t, tx.Signature)
+	})
+	t.Run("should work with code file, metadata, value and arguments", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractDeployBuilder(t).
+			SetCodeFromFile(writeTestContractCode(t, testContractCode)).
+			SetCodeMetadata(CodeMetadata{Upgradeable: true, Payable: true, PayableBySC: true}).
+			SetValue(big.NewInt(1000)).
+			SetArguments([]byte{10}, []byte("abc")).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := testContractCodeHex + "@0500@0106@0a@616263"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testContractDeployAddress, tx.Receiver)
+		assert.Equal(t, "1000", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+10000000), tx.GasLimit)
+	})
+}
+
+func TestContractDeployBuilder_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := createContractDeployBuilder(t).ComputeContractAddress(nil)
+		assert.True(t, check.IfNil(address))
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("not a deploy transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			Sender:   testSenderAddress,
+			Receiver: testReceiverAddress,
+		}
+		address, err := createContractDeployBuilder(t).ComputeContractAddress(tx)
+		assert.True(t, check.IfNil(address))
+		assert.Equal(t, ErrNotDeployTransaction, err)
+	})
+	t.Run("should use the sender and the nonce of the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		expectedAddress, _ := data.NewAddressFromBech32String(testReceiverAddress)
+		computer := &testsCommon.ContractAddressComputerStub{
+			ComputeArwenScAddressCalled: func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+				assert.Equal(t, testSenderAddress, address.AddressAsBech32String())
+				assert.Equal(t, uint64(37), nonce)
+
+				return expectedAddress, nil
+			},
+		}
+		builder, _ := NewContractDeployBuilder(computer)
+		tx, err := builder.
+			SetSender(createTestSender(t)).
+			SetCode(testContractCode).
+			SetGasLimit(10000000).
+			SetNetworkConfig(createMockArgsEsdtTransactionsFactory().NetworkConfig).
+			Build()
+		require.Nil(t, err)
+
+		tx.Nonce = 37
+		address, err := builder.ComputeContractAddress(tx)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedAddress, address)
+	})
+}
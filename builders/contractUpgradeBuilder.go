@@ -0,0 +1,155 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// upgradeContractFunction is the function called on a contract in order to replace its code
+const upgradeContractFunction = "upgradeContract"
+
+type contractUpgradeBuilder struct {
+	sender        core.AddressHandler
+	contract      core.AddressHandler
+	code          []byte
+	codeMetadata  CodeMetadata
+	arguments     [][]byte
+	value         *big.Int
+	gasLimit      uint64
+	networkConfig *data.NetworkConfig
+	err           error
+}
+
+// NewContractUpgradeBuilder creates a new builder for the contract upgrade transactions
+func NewContractUpgradeBuilder() *contractUpgradeBuilder {
+	return &contractUpgradeBuilder{
+		codeMetadata: DefaultCodeMetadata(),
+		value:        big.NewInt(0),
+	}
+}
+
+// SetSender sets the account upgrading the contract, which should be the contract's owner
+func (cub *contractUpgradeBuilder) SetSender(sender core.AddressHandler) *contractUpgradeBuilder {
+	cub.sender = sender
+
+	return cub
+}
+
+// SetContract sets the upgraded contract
+func (cub *contractUpgradeBuilder) SetContract(contract core.AddressHandler) *contractUpgradeBuilder {
+	cub.contract = contract
+
+	return cub
+}
+
+// SetCode sets the new WASM code of the contract
+func (cub *contractUpgradeBuilder) SetCode(code []byte) *contractUpgradeBuilder {
+	cub.code = code
+	cub.err = nil
+
+	return cub
+}
+
+// SetCodeFromFile loads the new WASM code of the contract from a .wasm file. The loading error, if any, is returned by
+// Build
+func (cub *contractUpgradeBuilder) SetCodeFromFile(filename string) *contractUpgradeBuilder {
+	cub.code, cub.err = loadContractCode(filename)
+
+	return cub
+}
+
+// SetCodeMetadata overrides the default code metadata, an upgradeable and readable contract that can not receive EGLD.
+// The code metadata of the contract is replaced on upgrade
+func (cub *contractUpgradeBuilder) SetCodeMetadata(metadata CodeMetadata) *contractUpgradeBuilder {
+	cub.codeMetadata = metadata
+
+	return cub
+}
+
+// SetArguments sets the arguments of the contract's upgrade function
+func (cub *contractUpgradeBuilder) SetArguments(arguments ...[]byte) *contractUpgradeBuilder {
+	cub.arguments = arguments
+
+	return cub
+}
+
+// SetValue sets the EGLD value sent to the contract's upgrade function. The contract should be payable
+func (cub *contractUpgradeBuilder) SetValue(value *big.Int) *contractUpgradeBuilder {
+	cub.value = value
+
+	return cub
+}
+
+// SetGasLimit sets the gas limit needed for the upgrade and the execution of the contract's upgrade function.
+// The gas needed for the data field is added by the builder
+func (cub *contractUpgradeBuilder) SetGasLimit(gasLimit uint64) *contractUpgradeBuilder {
+	cub.gasLimit = gasLimit
+
+	return cub
+}
+
+// SetNetworkConfig sets the network config
+func (cub *contractUpgradeBuilder) SetNetworkConfig(config *data.NetworkConfig) *contractUpgradeBuilder {
+	cub.networkConfig = config
+
+	return cub
+}
+
+// Build builds the contract upgrade transaction, having the upgradeContract@code@codeMetadata@arguments data field and
+// the contract as the receiver.
+// The returned transaction will not be signed and will have the nonce set to 0
+func (cub *contractUpgradeBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := cub.checkArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := NewTxDataBuilder().
+		Function(upgradeContractFunction).
+		ArgBytes(cub.code).
+		ArgBytes(cub.codeMetadata.ToBytes())
+	for _, arg := range cub.arguments {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    cub.value.String(),
+		Receiver: cub.contract.AddressAsBech32String(),
+		Sender:   cub.sender.AddressAsBech32String(),
+		GasPrice: cub.networkConfig.MinGasPrice,
+		GasLimit: cub.networkConfig.MinGasLimit + cub.networkConfig.GasPerDataByte*uint64(len(dataField)) + cub.gasLimit,
+		Data:     dataField,
+		ChainID:  cub.networkConfig.ChainID,
+		Version:  cub.networkConfig.MinTransactionVersion,
+	}, nil
+}
+
+func (cub *contractUpgradeBuilder) checkArguments() error {
+	if cub.err != nil {
+		return cub.err
+	}
+	if cub.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	err := checkAddress(cub.sender)
+	if err != nil {
+		return fmt.Errorf("%w for the sender", err)
+	}
+	err = checkAddress(cub.contract)
+	if err != nil {
+		return fmt.Errorf("%w for the contract", err)
+	}
+
+	return checkContractCodeArguments(cub.code, cub.value, cub.gasLimit)
+}
@@ -0,0 +1,78 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createContractUpgradeBuilder(t *testing.T) *contractUpgradeBuilder {
+	contract, err := data.NewAddressFromBech32String(testReceiverAddress)
+	require.Nil(t, err)
+
+	return NewContractUpgradeBuilder().
+		SetSender(createTestSender(t)).
+		SetContract(contract).
+		SetCode(testContractCode).
+		SetGasLimit(10000000).
+		SetNetworkConfig(createMockArgsEsdtTransactionsFactory().NetworkConfig)
+}
+
+func TestContractUpgradeBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetNetworkConfig(nil).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetContract(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("empty code should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetCode(make([]byte, 0)).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyCode, err)
+	})
+	t.Run("zero gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetGasLimit(0).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).
+			SetCodeFromFile(writeTestContractCode(t, testContractCode)).
+			SetCodeMetadata(CodeMetadata{Readable: true}).
+			SetValue(big.NewInt(5)).
+			SetArguments([]byte{1}).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "upgradeContract@" + testContractCodeHex + "@0400@01"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testReceiverAddress, tx.Receiver)
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, "5", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+10000000), tx.GasLimit)
+		assert.Equal(t, uint64(1000000000), tx.GasPrice)
+		assert.Equal(t, "T", tx.ChainID)
+		assert.Equal(t, uint32(1), tx.Version)
+		assert.Equal(t, uint64(0), tx.Nonce)
+	})
+}
@@ -40,3 +40,39 @@ var ErrInvalidGasLimitNeededForInnerTransaction = errors.New("invalid gas limit
 
 // ErrGasLimitForInnerTransactionV2ShouldBeZero signals that the gas limit for the inner transaction should be zero
 var ErrGasLimitForInnerTransactionV2ShouldBeZero = errors.New("gas limit of the inner transaction should be 0")
+
+// ErrNoTokenPayments signals that no token payment was provided
+var ErrNoTokenPayments = errors.New("no token payments")
+
+// ErrNilTokenPayment signals that a nil token payment was provided
+var ErrNilTokenPayment = errors.New("nil token payment")
+
+// ErrEmptyTokenIdentifier signals that an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("empty token identifier")
+
+// ErrMissingRelayerAddress signals that the relayer address of a relayed transaction v3 is missing
+var ErrMissingRelayerAddress = errors.New("missing relayer address")
+
+// ErrRelayerAddressMismatch signals that the relayer address of the transaction does not match the signing relayer
+var ErrRelayerAddressMismatch = errors.New("relayer address mismatch")
+
+// ErrInnerTransactionAlreadySigned signals that the inner transaction was signed before setting the relayer
+var ErrInnerTransactionAlreadySigned = errors.New("inner transaction already signed")
+
+// ErrMissingGuardianOption signals that the guarded transaction option is not set
+var ErrMissingGuardianOption = errors.New("the guarded transaction option is not set")
+
+// ErrGuardianAddressMismatch signals that the transaction's guardian differs from the signing guardian
+var ErrGuardianAddressMismatch = errors.New("guardian address mismatch")
+
+// ErrEmptyCode signals that an empty contract code was provided
+var ErrEmptyCode = errors.New("empty contract code")
+
+// ErrNilContractAddressComputer signals that a nil contract address computer was provided
+var ErrNilContractAddressComputer = errors.New("nil contract address computer")
+
+// ErrNotDeployTransaction signals that the provided transaction does not deploy a contract
+var ErrNotDeployTransaction = errors.New("not a contract deploy transaction")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
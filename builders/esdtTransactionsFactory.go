@@ -0,0 +1,421 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	esdtIssueFunction             = "issue"
+	esdtIssueSemiFungibleFunction = "issueSemiFungible"
+	esdtIssueNonFungibleFunction  = "issueNonFungible"
+	esdtRegisterMetaFunction      = "registerMetaESDT"
+	esdtSetSpecialRoleFunction    = "setSpecialRole"
+	esdtFreezeFunction            = "freeze"
+	esdtUnFreezeFunction          = "unFreeze"
+	esdtWipeFunction              = "wipe"
+	esdtFreezeSingleNFTFunction   = "freezeSingleNFT"
+	esdtUnFreezeSingleNFTFunction = "unFreezeSingleNFT"
+	esdtWipeSingleNFTFunction     = "wipeSingleNFT"
+	esdtPauseFunction             = "pause"
+	esdtUnPauseFunction           = "unPause"
+	esdtTransferOwnershipFunction = "transferOwnership"
+	esdtControlChangesFunction    = "controlChanges"
+
+	propertyCanFreeze                = "canFreeze"
+	propertyCanWipe                  = "canWipe"
+	propertyCanPause                 = "canPause"
+	propertyCanTransferNFTCreateRole = "canTransferNFTCreateRole"
+	propertyCanChangeOwner           = "canChangeOwner"
+	propertyCanUpgrade               = "canUpgrade"
+	propertyCanAddSpecialRoles       = "canAddSpecialRoles"
+
+	valueTrue  = "true"
+	valueFalse = "false"
+)
+
+// DefaultEsdtIssueCost is the default value (0.05 EGLD) paid for issuing or registering a token
+var DefaultEsdtIssueCost = big.NewInt(50000000000000000)
+
+// EsdtGasLimits holds the gas units consumed by the execution of the token operations. The cost of the data field
+// is computed from the network config and added on top of these values
+type EsdtGasLimits struct {
+	Issue             uint64
+	SetSpecialRole    uint64
+	LocalMint         uint64
+	LocalBurn         uint64
+	NFTCreate         uint64
+	NFTAddQuantity    uint64
+	Freezing          uint64
+	Wiping            uint64
+	Pausing           uint64
+	ChangeOwnership   uint64
+	UpgradeProperties uint64
+	StorePerByte      uint64
+}
+
+// DefaultEsdtGasLimits returns the gas limits commonly used for the token operations
+func DefaultEsdtGasLimits() EsdtGasLimits {
+	return EsdtGasLimits{
+		Issue:             60000000,
+		SetSpecialRole:    60000000,
+		LocalMint:         300000,
+		LocalBurn:         300000,
+		NFTCreate:         3000000,
+		NFTAddQuantity:    1000000,
+		Freezing:          60000000,
+		Wiping:            60000000,
+		Pausing:           60000000,
+		ChangeOwnership:   60000000,
+		UpgradeProperties: 60000000,
+		StorePerByte:      50000,
+	}
+}
+
+// TokenProperties holds the properties that can be set when issuing a token or upgraded afterwards
+type TokenProperties struct {
+	CanFreeze                bool
+	CanWipe                  bool
+	CanPause                 bool
+	CanTransferNFTCreateRole bool
+	CanChangeOwner           bool
+	CanUpgrade               bool
+	CanAddSpecialRoles       bool
+}
+
+// ArgsIssueFungible holds the arguments of a fungible token issue
+type ArgsIssueFungible struct {
+	Name          string
+	Ticker        string
+	InitialSupply *big.Int
+	NumDecimals   uint32
+	Properties    TokenProperties
+}
+
+// ArgsNFTCreate holds the arguments of an ESDTNFTCreate call
+type ArgsNFTCreate struct {
+	TokenIdentifier string
+	InitialQuantity *big.Int
+	Name            string
+	Royalties       uint32
+	Hash            []byte
+	Attributes      []byte
+	URIs            []string
+}
+
+// ArgsEsdtTransactionsFactory is the DTO used in the ESDT transactions factory constructor
+type ArgsEsdtTransactionsFactory struct {
+	NetworkConfig *data.NetworkConfig
+	GasLimits     EsdtGasLimits
+	IssueCost     *big.Int
+}
+
+type esdtTransactionsFactory struct {
+	networkConfig *data.NetworkConfig
+	gasLimits     EsdtGasLimits
+	issueCost     *big.Int
+	systemSC      string
+}
+
+// NewEsdtTransactionsFactory creates a factory able to produce the transactions managing ESDT tokens. The produced
+// transactions are not signed and have the nonce set to 0, so the nonce should be applied before signing them
+func NewEsdtTransactionsFactory(args ArgsEsdtTransactionsFactory) (*esdtTransactionsFactory, error) {
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if args.IssueCost == nil {
+		return nil, fmt.Errorf("%w for IssueCost", ErrNilValue)
+	}
+	if args.IssueCost.Sign() < 0 {
+		return nil, fmt.Errorf("%w for IssueCost", ErrInvalidValue)
+	}
+
+	return &esdtTransactionsFactory{
+		networkConfig: args.NetworkConfig,
+		gasLimits:     args.GasLimits,
+		issueCost:     big.NewInt(0).Set(args.IssueCost),
+		systemSC:      data.NewAddressFromBytes(chainCore.ESDTSCAddress).AddressAsBech32String(),
+	}, nil
+}
+
+// IssueFungible creates the transaction issuing a fungible token
+func (factory *esdtTransactionsFactory) IssueFungible(sender core.AddressHandler, args ArgsIssueFungible) (*transaction.FrontendTransaction, error) {
+	if args.InitialSupply == nil {
+		return nil, fmt.Errorf("%w for InitialSupply", ErrNilValue)
+	}
+
+	builder := NewTxDataBuilder().
+		Function(esdtIssueFunction).
+		ArgBytes([]byte(args.Name)).
+		ArgBytes([]byte(args.Ticker)).
+		ArgBigInt(args.InitialSupply).
+		ArgInt64(int64(args.NumDecimals))
+	addProperties(builder, args.Properties, false)
+
+	return factory.createSystemSCTransaction(sender, builder, factory.issueCost, factory.gasLimits.Issue)
+}
+
+// IssueSemiFungible creates the transaction issuing a semi-fungible token (SFT) collection
+func (factory *esdtTransactionsFactory) IssueSemiFungible(sender core.AddressHandler, name string, ticker string, properties TokenProperties) (*transaction.FrontendTransaction, error) {
+	return factory.issueCollection(sender, esdtIssueSemiFungibleFunction, name, ticker, properties)
+}
+
+// IssueNonFungible creates the transaction issuing a non-fungible token (NFT) collection
+func (factory *esdtTransactionsFactory) IssueNonFungible(sender core.AddressHandler, name string, ticker string, properties TokenProperties) (*transaction.FrontendTransaction, error) {
+	return factory.issueCollection(sender, esdtIssueNonFungibleFunction, name, ticker, properties)
+}
+
+// RegisterMetaESDT creates the transaction registering a Meta-ESDT collection
+func (factory *esdtTransactionsFactory) RegisterMetaESDT(sender core.AddressHandler, name string, ticker string, numDecimals uint32, properties TokenProperties) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(esdtRegisterMetaFunction).
+		ArgBytes([]byte(name)).
+		ArgBytes([]byte(ticker)).
+		ArgInt64(int64(numDecimals))
+	addProperties(builder, properties, true)
+
+	return factory.createSystemSCTransaction(sender, builder, factory.issueCost, factory.gasLimits.Issue)
+}
+
+func (factory *esdtTransactionsFactory) issueCollection(sender core.AddressHandler, function string, name string, ticker string, properties TokenProperties) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(name)).
+		ArgBytes([]byte(ticker))
+	addProperties(builder, properties, true)
+
+	return factory.createSystemSCTransaction(sender, builder, factory.issueCost, factory.gasLimits.Issue)
+}
+
+// SetSpecialRoles creates the transaction granting the provided roles (such as ESDTRoleLocalMint or ESDTRoleNFTCreate)
+// to the holder address
+func (factory *esdtTransactionsFactory) SetSpecialRoles(sender core.AddressHandler, tokenIdentifier string, holder core.AddressHandler, roles ...string) (*transaction.FrontendTransaction, error) {
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("%w: no roles provided", ErrInvalidValue)
+	}
+
+	builder := NewTxDataBuilder().
+		Function(esdtSetSpecialRoleFunction).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgAddress(holder)
+	for _, role := range roles {
+		builder.ArgBytes([]byte(role))
+	}
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), factory.gasLimits.SetSpecialRole)
+}
+
+// LocalMint creates the transaction minting the provided amount of a fungible token in the sender's account
+func (factory *esdtTransactionsFactory) LocalMint(sender core.AddressHandler, tokenIdentifier string, amount *big.Int) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionESDTLocalMint).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(amount)
+
+	return factory.createSelfTransaction(sender, builder, factory.gasLimits.LocalMint)
+}
+
+// LocalBurn creates the transaction burning the provided amount of a fungible token from the sender's account
+func (factory *esdtTransactionsFactory) LocalBurn(sender core.AddressHandler, tokenIdentifier string, amount *big.Int) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionESDTLocalBurn).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(amount)
+
+	return factory.createSelfTransaction(sender, builder, factory.gasLimits.LocalBurn)
+}
+
+// NFTCreate creates the transaction creating a new NFT, SFT or Meta-ESDT in the sender's account. The storage of
+// the attributes and URIs is paid on top of the NFTCreate gas limit
+func (factory *esdtTransactionsFactory) NFTCreate(sender core.AddressHandler, args ArgsNFTCreate) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionESDTNFTCreate).
+		ArgBytes([]byte(args.TokenIdentifier)).
+		ArgBigInt(args.InitialQuantity).
+		ArgHexString(hex.EncodeToString([]byte(args.Name))).
+		ArgInt64(int64(args.Royalties)).
+		ArgHexString(hex.EncodeToString(args.Hash)).
+		ArgHexString(hex.EncodeToString(args.Attributes))
+
+	storedBytes := len(args.Attributes)
+	for _, uri := range args.URIs {
+		builder.ArgHexString(hex.EncodeToString([]byte(uri)))
+		storedBytes += len(uri)
+	}
+	if len(args.URIs) == 0 {
+		builder.ArgHexString("")
+	}
+
+	gasLimit := factory.gasLimits.NFTCreate + factory.gasLimits.StorePerByte*uint64(storedBytes)
+
+	return factory.createSelfTransaction(sender, builder, gasLimit)
+}
+
+// NFTAddQuantity creates the transaction adding quantity to an existing SFT or Meta-ESDT nonce
+func (factory *esdtTransactionsFactory) NFTAddQuantity(sender core.AddressHandler, tokenIdentifier string, nonce uint64, quantity *big.Int) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionESDTNFTAddQuantity).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgBigInt(quantity)
+
+	return factory.createSelfTransaction(sender, builder, factory.gasLimits.NFTAddQuantity)
+}
+
+// Freeze creates the transaction freezing the token balance of the target account. A nonce greater than 0
+// freezes a single NFT, SFT or Meta-ESDT nonce
+func (factory *esdtTransactionsFactory) Freeze(sender core.AddressHandler, tokenIdentifier string, nonce uint64, target core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return factory.targetedOperation(sender, esdtFreezeFunction, esdtFreezeSingleNFTFunction, tokenIdentifier, nonce, target, factory.gasLimits.Freezing)
+}
+
+// UnFreeze creates the transaction unfreezing the token balance of the target account. A nonce greater than 0
+// unfreezes a single NFT, SFT or Meta-ESDT nonce
+func (factory *esdtTransactionsFactory) UnFreeze(sender core.AddressHandler, tokenIdentifier string, nonce uint64, target core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return factory.targetedOperation(sender, esdtUnFreezeFunction, esdtUnFreezeSingleNFTFunction, tokenIdentifier, nonce, target, factory.gasLimits.Freezing)
+}
+
+// Wipe creates the transaction wiping the frozen token balance of the target account. A nonce greater than 0
+// wipes a single NFT, SFT or Meta-ESDT nonce
+func (factory *esdtTransactionsFactory) Wipe(sender core.AddressHandler, tokenIdentifier string, nonce uint64, target core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return factory.targetedOperation(sender, esdtWipeFunction, esdtWipeSingleNFTFunction, tokenIdentifier, nonce, target, factory.gasLimits.Wiping)
+}
+
+func (factory *esdtTransactionsFactory) targetedOperation(
+	sender core.AddressHandler,
+	function string,
+	singleNFTFunction string,
+	tokenIdentifier string,
+	nonce uint64,
+	target core.AddressHandler,
+	gasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder()
+	if nonce == 0 {
+		builder.Function(function).
+			ArgBytes([]byte(tokenIdentifier)).
+			ArgAddress(target)
+	} else {
+		builder.Function(singleNFTFunction).
+			ArgBytes([]byte(tokenIdentifier)).
+			ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+			ArgAddress(target)
+	}
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), gasLimit)
+}
+
+// Pause creates the transaction pausing all the transfers of the token
+func (factory *esdtTransactionsFactory) Pause(sender core.AddressHandler, tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(esdtPauseFunction).
+		ArgBytes([]byte(tokenIdentifier))
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), factory.gasLimits.Pausing)
+}
+
+// UnPause creates the transaction resuming the transfers of the token
+func (factory *esdtTransactionsFactory) UnPause(sender core.AddressHandler, tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(esdtUnPauseFunction).
+		ArgBytes([]byte(tokenIdentifier))
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), factory.gasLimits.Pausing)
+}
+
+// TransferOwnership creates the transaction transferring the management of the token to a new owner
+func (factory *esdtTransactionsFactory) TransferOwnership(sender core.AddressHandler, tokenIdentifier string, newOwner core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(esdtTransferOwnershipFunction).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgAddress(newOwner)
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), factory.gasLimits.ChangeOwnership)
+}
+
+// UpgradeProperties creates the transaction changing the properties of an upgradable token
+func (factory *esdtTransactionsFactory) UpgradeProperties(sender core.AddressHandler, tokenIdentifier string, properties TokenProperties) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(esdtControlChangesFunction).
+		ArgBytes([]byte(tokenIdentifier))
+	addProperties(builder, properties, true)
+
+	return factory.createSystemSCTransaction(sender, builder, big.NewInt(0), factory.gasLimits.UpgradeProperties)
+}
+
+func (factory *esdtTransactionsFactory) createSystemSCTransaction(sender core.AddressHandler, builder TxDataBuilder, value *big.Int, executionGasLimit uint64) (*transaction.FrontendTransaction, error) {
+	return factory.createTransaction(sender, factory.systemSC, builder, value, executionGasLimit)
+}
+
+func (factory *esdtTransactionsFactory) createSelfTransaction(sender core.AddressHandler, builder TxDataBuilder, executionGasLimit uint64) (*transaction.FrontendTransaction, error) {
+	err := checkAddress(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.createTransaction(sender, sender.AddressAsBech32String(), builder, big.NewInt(0), executionGasLimit)
+}
+
+func (factory *esdtTransactionsFactory) createTransaction(
+	sender core.AddressHandler,
+	receiver string,
+	builder TxDataBuilder,
+	value *big.Int,
+	executionGasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	err := checkAddress(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    value.String(),
+		Receiver: receiver,
+		Sender:   sender.AddressAsBech32String(),
+		GasPrice: factory.networkConfig.MinGasPrice,
+		GasLimit: factory.computeGasLimit(dataField, executionGasLimit),
+		Data:     dataField,
+		ChainID:  factory.networkConfig.ChainID,
+		Version:  factory.networkConfig.MinTransactionVersion,
+	}, nil
+}
+
+func (factory *esdtTransactionsFactory) computeGasLimit(dataField []byte, executionGasLimit uint64) uint64 {
+	return factory.networkConfig.MinGasLimit + factory.networkConfig.GasPerDataByte*uint64(len(dataField)) + executionGasLimit
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (factory *esdtTransactionsFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
+
+func addProperties(builder TxDataBuilder, properties TokenProperties, withNFTCreateRoleTransfer bool) {
+	addProperty(builder, propertyCanFreeze, properties.CanFreeze)
+	addProperty(builder, propertyCanWipe, properties.CanWipe)
+	addProperty(builder, propertyCanPause, properties.CanPause)
+	if withNFTCreateRoleTransfer {
+		addProperty(builder, propertyCanTransferNFTCreateRole, properties.CanTransferNFTCreateRole)
+	}
+	addProperty(builder, propertyCanChangeOwner, properties.CanChangeOwner)
+	addProperty(builder, propertyCanUpgrade, properties.CanUpgrade)
+	addProperty(builder, propertyCanAddSpecialRoles, properties.CanAddSpecialRoles)
+}
+
+func addProperty(builder TxDataBuilder, name string, value bool) {
+	builder.ArgBytes([]byte(name))
+	if value {
+		builder.ArgBytes([]byte(valueTrue))
+	} else {
+		builder.ArgBytes([]byte(valueFalse))
+	}
+}
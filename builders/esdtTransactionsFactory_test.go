@@ -0,0 +1,311 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEsdtSystemSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+	testSenderAddress       = "erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8"
+)
+
+func createMockArgsEsdtTransactionsFactory() ArgsEsdtTransactionsFactory {
+	return ArgsEsdtTransactionsFactory{
+		NetworkConfig: &data.NetworkConfig{
+			ChainID:               "T",
+			GasPerDataByte:        1500,
+			MinGasLimit:           50000,
+			MinGasPrice:           1000000000,
+			MinTransactionVersion: 1,
+		},
+		GasLimits: DefaultEsdtGasLimits(),
+		IssueCost: DefaultEsdtIssueCost,
+	}
+}
+
+func createTestSender(t *testing.T) sdkCore.AddressHandler {
+	address, err := data.NewAddressFromBech32String(testSenderAddress)
+	require.Nil(t, err)
+
+	return address
+}
+
+func TestNewEsdtTransactionsFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEsdtTransactionsFactory()
+		args.NetworkConfig = nil
+		factory, err := NewEsdtTransactionsFactory(args)
+		assert.True(t, check.IfNil(factory))
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil issue cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEsdtTransactionsFactory()
+		args.IssueCost = nil
+		factory, err := NewEsdtTransactionsFactory(args)
+		assert.True(t, check.IfNil(factory))
+		assert.True(t, errors.Is(err, ErrNilValue))
+	})
+	t.Run("negative issue cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEsdtTransactionsFactory()
+		args.IssueCost = big.NewInt(-1)
+		factory, err := NewEsdtTransactionsFactory(args)
+		assert.True(t, check.IfNil(factory))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		factory, err := NewEsdtTransactionsFactory(createMockArgsEsdtTransactionsFactory())
+		assert.False(t, check.IfNil(factory))
+		assert.Nil(t, err)
+		assert.Equal(t, testEsdtSystemSCAddress, factory.systemSC)
+	})
+}
+
+func TestEsdtTransactionsFactory_Issue(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEsdtTransactionsFactory()
+	factory, _ := NewEsdtTransactionsFactory(args)
+	sender := createTestSender(t)
+
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.Pause(nil, "TKN-123456")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.IssueFungible(sender, ArgsIssueFungible{
+			Name:          "Token",
+			Ticker:        "TKN",
+			InitialSupply: big.NewInt(1000),
+			NumDecimals:   6,
+			Properties: TokenProperties{
+				CanFreeze:  true,
+				CanUpgrade: true,
+			},
+		})
+		require.Nil(t, err)
+
+		expectedData := "issue@546f6b656e@544b4e@03e8@06" +
+			"@63616e467265657a65@74727565" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@66616c7365" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@74727565" +
+			"@63616e4164645370656369616c526f6c6573@66616c7365"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testEsdtSystemSCAddress, tx.Receiver)
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, "50000000000000000", tx.Value)
+		assert.Equal(t, args.NetworkConfig.MinGasPrice, tx.GasPrice)
+		assert.Equal(t, args.NetworkConfig.ChainID, tx.ChainID)
+		assert.Equal(t, args.NetworkConfig.MinTransactionVersion, tx.Version)
+		assert.Equal(t, uint64(0), tx.Nonce)
+		expectedGasLimit := args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len(expectedData)) + args.GasLimits.Issue
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("fungible token with nil supply should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.IssueFungible(sender, ArgsIssueFungible{Name: "Token", Ticker: "TKN"})
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilValue))
+	})
+	t.Run("collections should include the NFT create role transfer property", func(t *testing.T) {
+		t.Parallel()
+
+		properties := TokenProperties{CanTransferNFTCreateRole: true}
+		expectedProperties := "@63616e467265657a65@66616c7365" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@66616c7365" +
+			"@63616e5472616e736665724e4654437265617465526f6c65@74727565" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@66616c7365" +
+			"@63616e4164645370656369616c526f6c6573@66616c7365"
+
+		tx, err := factory.IssueSemiFungible(sender, "Token", "TKN", properties)
+		require.Nil(t, err)
+		assert.Equal(t, "issueSemiFungible@546f6b656e@544b4e"+expectedProperties, string(tx.Data))
+		assert.Equal(t, "50000000000000000", tx.Value)
+
+		tx, err = factory.IssueNonFungible(sender, "Token", "TKN", properties)
+		require.Nil(t, err)
+		assert.Equal(t, "issueNonFungible@546f6b656e@544b4e"+expectedProperties, string(tx.Data))
+
+		tx, err = factory.RegisterMetaESDT(sender, "Token", "TKN", 18, properties)
+		require.Nil(t, err)
+		assert.Equal(t, "registerMetaESDT@546f6b656e@544b4e@12"+expectedProperties, string(tx.Data))
+		assert.Equal(t, testEsdtSystemSCAddress, tx.Receiver)
+	})
+}
+
+func TestEsdtTransactionsFactory_SetSpecialRoles(t *testing.T) {
+	t.Parallel()
+
+	factory, _ := NewEsdtTransactionsFactory(createMockArgsEsdtTransactionsFactory())
+	sender := createTestSender(t)
+
+	t.Run("no roles should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.SetSpecialRoles(sender, "TKN-123456", sender)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.SetSpecialRoles(sender, "TKN-123456", sender, core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn)
+		require.Nil(t, err)
+		assert.Equal(t, "setSpecialRole@544b4e2d313233343536@b2a11555ce521e4944e09ab17549d85b487dcd26c84b5017a39e31a3670889ba"+
+			"@45534454526f6c654c6f63616c4d696e74@45534454526f6c654c6f63616c4275726e", string(tx.Data))
+		assert.Equal(t, testEsdtSystemSCAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+	})
+}
+
+func TestEsdtTransactionsFactory_SelfOperations(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEsdtTransactionsFactory()
+	factory, _ := NewEsdtTransactionsFactory(args)
+	sender := createTestSender(t)
+
+	t.Run("local mint and burn", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.LocalMint(sender, "TKN-123456", big.NewInt(10))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTLocalMint@544b4e2d313233343536@0a", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+
+		tx, err = factory.LocalBurn(sender, "TKN-123456", big.NewInt(10))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTLocalBurn@544b4e2d313233343536@0a", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+	})
+	t.Run("NFT create should pay for the stored bytes", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.NFTCreate(sender, ArgsNFTCreate{
+			TokenIdentifier: "NFT-123456",
+			InitialQuantity: big.NewInt(1),
+			Name:            "nft",
+			Royalties:       1000,
+			Attributes:      []byte("attr"),
+			URIs:            []string{"uri1", "uri2"},
+		})
+		require.Nil(t, err)
+
+		expectedData := "ESDTNFTCreate@4e46542d313233343536@01@6e6674@03e8@@61747472@75726931@75726932"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+		expectedGasLimit := args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len(expectedData)) +
+			args.GasLimits.NFTCreate + args.GasLimits.StorePerByte*12
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("NFT create without URIs should add an empty URI", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.NFTCreate(sender, ArgsNFTCreate{
+			TokenIdentifier: "NFT-123456",
+			InitialQuantity: big.NewInt(1),
+			Name:            "nft",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTCreate@4e46542d313233343536@01@6e6674@00@@@", string(tx.Data))
+	})
+	t.Run("NFT add quantity", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.NFTAddQuantity(sender, "SFT-123456", 2, big.NewInt(5))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTAddQuantity@5346542d313233343536@02@05", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+	})
+}
+
+func TestEsdtTransactionsFactory_ManagementOperations(t *testing.T) {
+	t.Parallel()
+
+	factory, _ := NewEsdtTransactionsFactory(createMockArgsEsdtTransactionsFactory())
+	sender := createTestSender(t)
+	hexToken := "544b4e2d313233343536"
+	hexAddress := "b2a11555ce521e4944e09ab17549d85b487dcd26c84b5017a39e31a3670889ba"
+
+	t.Run("freeze, unfreeze and wipe", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.Freeze(sender, "TKN-123456", 0, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "freeze@"+hexToken+"@"+hexAddress, string(tx.Data))
+
+		tx, err = factory.UnFreeze(sender, "TKN-123456", 0, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "unFreeze@"+hexToken+"@"+hexAddress, string(tx.Data))
+
+		tx, err = factory.Wipe(sender, "TKN-123456", 0, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "wipe@"+hexToken+"@"+hexAddress, string(tx.Data))
+		assert.Equal(t, testEsdtSystemSCAddress, tx.Receiver)
+	})
+	t.Run("single NFT operations", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.Freeze(sender, "TKN-123456", 3, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "freezeSingleNFT@"+hexToken+"@03@"+hexAddress, string(tx.Data))
+
+		tx, err = factory.UnFreeze(sender, "TKN-123456", 3, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "unFreezeSingleNFT@"+hexToken+"@03@"+hexAddress, string(tx.Data))
+
+		tx, err = factory.Wipe(sender, "TKN-123456", 3, sender)
+		require.Nil(t, err)
+		assert.Equal(t, "wipeSingleNFT@"+hexToken+"@03@"+hexAddress, string(tx.Data))
+	})
+	t.Run("pause, ownership and properties", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.Pause(sender, "TKN-123456")
+		require.Nil(t, err)
+		assert.Equal(t, "pause@"+hexToken, string(tx.Data))
+
+		tx, err = factory.UnPause(sender, "TKN-123456")
+		require.Nil(t, err)
+		assert.Equal(t, "unPause@"+hexToken, string(tx.Data))
+
+		tx, err = factory.TransferOwnership(sender, "TKN-123456", sender)
+		require.Nil(t, err)
+		assert.Equal(t, "transferOwnership@"+hexToken+"@"+hexAddress, string(tx.Data))
+
+		tx, err = factory.UpgradeProperties(sender, "TKN-123456", TokenProperties{CanPause: true})
+		require.Nil(t, err)
+		assert.Contains(t, string(tx.Data), "controlChanges@"+hexToken+"@63616e467265657a65@66616c7365")
+		assert.Contains(t, string(tx.Data), "@63616e5061757365@74727565")
+		assert.Equal(t, "0", tx.Value)
+	})
+}
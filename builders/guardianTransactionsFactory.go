@@ -0,0 +1,116 @@
+package builders
+
+import (
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DefaultGuardianGasLimit is the gas limit commonly used for the execution of the guardian built-in functions
+const DefaultGuardianGasLimit = uint64(250000)
+
+// ArgsGuardianTransactionsFactory is the DTO used in the guardian transactions factory constructor
+type ArgsGuardianTransactionsFactory struct {
+	NetworkConfig *data.NetworkConfig
+	// ExecutionGasLimit is added on top of the gas limit computed for the data field
+	ExecutionGasLimit uint64
+}
+
+type guardianTransactionsFactory struct {
+	networkConfig     *data.NetworkConfig
+	executionGasLimit uint64
+}
+
+// NewGuardianTransactionsFactory creates a factory able to produce the transactions managing the guardian of an
+// account. The produced transactions are sent by the account to itself, are not signed and have the nonce set to 0.
+// If the account is already guarded, its active guardian should be provided so the transactions are guarded as well,
+// with the network's extra gas limit for the guarded transactions included in the gas limit
+func NewGuardianTransactionsFactory(args ArgsGuardianTransactionsFactory) (*guardianTransactionsFactory, error) {
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	return &guardianTransactionsFactory{
+		networkConfig:     args.NetworkConfig,
+		executionGasLimit: args.ExecutionGasLimit,
+	}, nil
+}
+
+// SetGuardian creates the transaction registering the provided guardian for the sender's account. The service ID
+// identifies the co-signing service that provided the guardian. The active guardian is nil if the account is not guarded
+func (factory *guardianTransactionsFactory) SetGuardian(
+	sender core.AddressHandler,
+	guardian core.AddressHandler,
+	serviceID string,
+	activeGuardian core.AddressHandler,
+) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().
+		Function(chainCore.BuiltInFunctionSetGuardian).
+		ArgAddress(guardian).
+		ArgBytes([]byte(serviceID))
+
+	return factory.createTransaction(sender, builder, activeGuardian)
+}
+
+// GuardAccount creates the transaction activating the guardian previously set for the sender's account. The active
+// guardian is nil if the account is not guarded
+func (factory *guardianTransactionsFactory) GuardAccount(sender core.AddressHandler, activeGuardian core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().Function(chainCore.BuiltInFunctionGuardAccount)
+
+	return factory.createTransaction(sender, builder, activeGuardian)
+}
+
+// UnGuardAccount creates the transaction deactivating the guardian of the sender's account. The active guardian is
+// nil if the account is not guarded
+func (factory *guardianTransactionsFactory) UnGuardAccount(sender core.AddressHandler, activeGuardian core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	builder := NewTxDataBuilder().Function(chainCore.BuiltInFunctionUnGuardAccount)
+
+	return factory.createTransaction(sender, builder, activeGuardian)
+}
+
+func (factory *guardianTransactionsFactory) createTransaction(
+	sender core.AddressHandler,
+	builder TxDataBuilder,
+	activeGuardian core.AddressHandler,
+) (*transaction.FrontendTransaction, error) {
+	err := checkAddress(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := factory.networkConfig.MinGasLimit + factory.networkConfig.GasPerDataByte*uint64(len(dataField)) + factory.executionGasLimit
+
+	tx := &transaction.FrontendTransaction{
+		Value:    "0",
+		Receiver: sender.AddressAsBech32String(),
+		Sender:   sender.AddressAsBech32String(),
+		GasPrice: factory.networkConfig.MinGasPrice,
+		GasLimit: gasLimit,
+		Data:     dataField,
+		ChainID:  factory.networkConfig.ChainID,
+		Version:  factory.networkConfig.MinTransactionVersion,
+	}
+	if check.IfNil(activeGuardian) {
+		return tx, nil
+	}
+
+	err = setGuardian(tx, activeGuardian)
+	if err != nil {
+		return nil, err
+	}
+	tx.GasLimit += factory.networkConfig.ExtraGasLimitGuardedTx
+
+	return tx, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (factory *guardianTransactionsFactory) IsInterfaceNil() bool {
+	return factory == nil
+}
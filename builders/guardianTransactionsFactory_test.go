@@ -0,0 +1,118 @@
+package builders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGuardianTransactionsFactory() ArgsGuardianTransactionsFactory {
+	networkConfig := createMockArgsEsdtTransactionsFactory().NetworkConfig
+	networkConfig.ExtraGasLimitGuardedTx = 50000
+
+	return ArgsGuardianTransactionsFactory{
+		NetworkConfig:     networkConfig,
+		ExecutionGasLimit: DefaultGuardianGasLimit,
+	}
+}
+
+func TestNewGuardianTransactionsFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGuardianTransactionsFactory()
+		args.NetworkConfig = nil
+		factory, err := NewGuardianTransactionsFactory(args)
+		assert.True(t, check.IfNil(factory))
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		factory, err := NewGuardianTransactionsFactory(createMockArgsGuardianTransactionsFactory())
+		assert.False(t, check.IfNil(factory))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGuardianTransactionsFactory_Transactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardianTransactionsFactory()
+	factory, _ := NewGuardianTransactionsFactory(args)
+	sender := createTestSender(t)
+
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.GuardAccount(nil, nil)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("set guardian", func(t *testing.T) {
+		t.Parallel()
+
+		guardian, err := data.NewAddressFromBech32String(testReceiverAddress)
+		require.Nil(t, err)
+
+		tx, err := factory.SetGuardian(sender, guardian, "service", nil)
+		require.Nil(t, err)
+
+		expectedData := "SetGuardian@000000000000000005000e8a594d1c9b52073fcd3c856c87986045c85f568b98@73657276696365"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+		expectedGasLimit := args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len(expectedData)) + DefaultGuardianGasLimit
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("set guardian without service ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.SetGuardian(sender, sender, "", nil)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("guard and unguard account", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.GuardAccount(sender, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "GuardAccount", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+
+		tx, err = factory.UnGuardAccount(sender, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "UnGuardAccount", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+	})
+	t.Run("guarded account should guard the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		activeGuardian, err := data.NewAddressFromBech32String(testReceiverAddress)
+		require.Nil(t, err)
+
+		tx, err := factory.UnGuardAccount(sender, activeGuardian)
+		require.Nil(t, err)
+		assert.Equal(t, testReceiverAddress, tx.GuardianAddr)
+		assert.Equal(t, transaction.MaskGuardedTransaction, tx.Options&transaction.MaskGuardedTransaction)
+		assert.Equal(t, minGuardedTransactionVersion, tx.Version)
+		expectedGasLimit := args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len("UnGuardAccount")) +
+			DefaultGuardianGasLimit + args.NetworkConfig.ExtraGasLimitGuardedTx
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("invalid active guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := factory.GuardAccount(sender, data.NewAddressFromBytes([]byte("invalid")))
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidAddress))
+	})
+}
@@ -51,3 +51,9 @@ type Signer interface {
 	SignByteSlice(msg []byte, privateKey crypto.PrivateKey) ([]byte, error)
 	IsInterfaceNil() bool
 }
+
+// ContractAddressComputer defines the component able to compute the address of a contract deployed by an account
+type ContractAddressComputer interface {
+	ComputeArwenScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+	IsInterfaceNil() bool
+}
@@ -112,6 +112,11 @@ func prepareInnerTxForRelayV1(tx *transaction.FrontendTransaction) (string, erro
 		return "", err
 	}
 
+	guardianBytes, guardianSignatureBytes, err := guardianFieldsToBytes(tx)
+	if err != nil {
+		return "", err
+	}
+
 	// TODO: remove this hardcoded implementation. Inside mx-chain-core-go, create there a dedicated converter between FrontendTransaction <-> Transaction
 	coreTx := &transaction.Transaction{
 		Nonce:     tx.Nonce,
@@ -125,6 +130,9 @@ func prepareInnerTxForRelayV1(tx *transaction.FrontendTransaction) (string, erro
 		Version:   tx.Version,
 		Signature: signatureBytes,
 		Options:   tx.Options,
+
+		GuardianAddr:      guardianBytes,
+		GuardianSignature: guardianSignatureBytes,
 	}
 
 	serializedTx, err := json.Marshal(coreTx)
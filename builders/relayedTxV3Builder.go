@@ -0,0 +1,74 @@
+package builders
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+type relayedTxV3Builder struct {
+	innerTransaction *transaction.FrontendTransaction
+	relayerAddress   core.AddressHandler
+	networkConfig    *data.NetworkConfig
+}
+
+// NewRelayedTxV3Builder creates a new relayed transaction v3 builder
+func NewRelayedTxV3Builder() *relayedTxV3Builder {
+	return &relayedTxV3Builder{
+		innerTransaction: nil,
+		relayerAddress:   nil,
+		networkConfig:    nil,
+	}
+}
+
+// SetInnerTransaction sets the transaction to be relayed. As opposed to the previous relayed versions, the
+// transaction is not wrapped so it should not be signed yet
+func (rtb *relayedTxV3Builder) SetInnerTransaction(tx *transaction.FrontendTransaction) *relayedTxV3Builder {
+	rtb.innerTransaction = tx
+
+	return rtb
+}
+
+// SetRelayerAddress sets the relayer address (that will pay the transaction fee)
+func (rtb *relayedTxV3Builder) SetRelayerAddress(address core.AddressHandler) *relayedTxV3Builder {
+	rtb.relayerAddress = address
+
+	return rtb
+}
+
+// SetNetworkConfig sets the network config
+func (rtb *relayedTxV3Builder) SetNetworkConfig(config *data.NetworkConfig) *relayedTxV3Builder {
+	rtb.networkConfig = config
+
+	return rtb
+}
+
+// Build builds the relayed transaction v3: a copy of the inner transaction holding the relayer address, with the gas
+// limit increased by the minimum gas limit the network charges for the relayer.
+// The returned transaction will not be signed: the sender should sign it first, then the relayer should co-sign it
+// using the ApplySignature and ApplyRelayerSignature methods of the transaction builder. The relayer should be in
+// the same shard as the sender
+func (rtb *relayedTxV3Builder) Build() (*transaction.FrontendTransaction, error) {
+	if rtb.innerTransaction == nil {
+		return nil, ErrNilInnerTransaction
+	}
+	if len(rtb.innerTransaction.Signature) > 0 || len(rtb.innerTransaction.RelayerSignature) > 0 {
+		return nil, ErrInnerTransactionAlreadySigned
+	}
+	if check.IfNil(rtb.relayerAddress) {
+		return nil, ErrNilAddress
+	}
+	if !rtb.relayerAddress.IsValid() {
+		return nil, ErrInvalidAddress
+	}
+	if rtb.networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	relayedTx := *rtb.innerTransaction
+	relayedTx.RelayerAddr = rtb.relayerAddress.AddressAsBech32String()
+	relayedTx.GasLimit += rtb.networkConfig.MinGasLimit
+
+	return &relayedTx, nil
+}
@@ -0,0 +1,187 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayedTxV3Builder_Build(t *testing.T) {
+	t.Parallel()
+
+	netConfig := &data.NetworkConfig{
+		ChainID:               "T",
+		MinGasLimit:           50000,
+		MinTransactionVersion: 2,
+	}
+	relayerAcc, _ := getAccount(t, testRelayerMnemonic)
+	relayerAddress, err := data.NewAddressFromBech32String(relayerAcc.Address)
+	require.Nil(t, err)
+	innerTx := &transaction.FrontendTransaction{
+		Nonce:    37,
+		Value:    "0",
+		Receiver: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u",
+		Sender:   "erd1mlh7q3fcgrjeq0et65vaaxcw6m5ky8jhu296pdxpk9g32zga6uhsemxx2a",
+		GasPrice: 1000000000,
+		GasLimit: 60000,
+		ChainID:  netConfig.ChainID,
+		Version:  netConfig.MinTransactionVersion,
+	}
+
+	t.Run("nil inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetRelayerAddress(relayerAddress).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilInnerTransaction, errBuild)
+	})
+	t.Run("signed inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		signedTx := *innerTx
+		signedTx.Signature = "aa"
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetInnerTransaction(&signedTx).
+			SetRelayerAddress(relayerAddress).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrInnerTransactionAlreadySigned, errBuild)
+	})
+	t.Run("nil relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilAddress, errBuild)
+	})
+	t.Run("invalid relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAddress(data.NewAddressFromBytes([]byte("invalid"))).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrInvalidAddress, errBuild)
+	})
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAddress(relayerAddress).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilNetworkConfig, errBuild)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, errBuild := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAddress(relayerAddress).
+			SetNetworkConfig(netConfig).
+			Build()
+		require.Nil(t, errBuild)
+
+		expectedTx := *innerTx
+		expectedTx.RelayerAddr = relayerAcc.Address
+		expectedTx.GasLimit = 110000
+		assert.Equal(t, &expectedTx, relayedTx)
+		assert.Empty(t, innerTx.RelayerAddr)
+		assert.Equal(t, uint64(60000), innerTx.GasLimit)
+	})
+}
+
+func TestRelayedTxV3Builder_SignaturesShouldBeAcceptedByTheNode(t *testing.T) {
+	t.Parallel()
+
+	netConfig := &data.NetworkConfig{
+		ChainID:               "T",
+		MinGasLimit:           50000,
+		MinTransactionVersion: 2,
+	}
+	_, senderPrivateKey := getAccount(t, testInnerSenderMnemonic)
+	_, relayerPrivateKey := getAccount(t, testRelayerMnemonic)
+	senderHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, senderPrivateKey)
+	require.Nil(t, err)
+	relayerHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, relayerPrivateKey)
+	require.Nil(t, err)
+
+	relayedTx, err := NewRelayedTxV3Builder().
+		SetInnerTransaction(&transaction.FrontendTransaction{
+			Nonce:    37,
+			Value:    "1000000000000000000",
+			Receiver: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u",
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+			ChainID:  netConfig.ChainID,
+			Version:  netConfig.MinTransactionVersion,
+		}).
+		SetRelayerAddress(relayerHolder.GetAddressHandler()).
+		SetNetworkConfig(netConfig).
+		Build()
+	require.Nil(t, err)
+
+	tb, err := NewTxBuilder(cryptoProvider.NewSigner())
+	require.Nil(t, err)
+
+	t.Run("missing relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		txCopy := *relayedTx
+		txCopy.RelayerAddr = ""
+		errSign := tb.ApplyRelayerSignature(relayerHolder, &txCopy)
+		assert.Equal(t, ErrMissingRelayerAddress, errSign)
+		assert.Empty(t, txCopy.RelayerSignature)
+	})
+	t.Run("relayer address mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		txCopy := *relayedTx
+		errSign := tb.ApplyRelayerSignature(senderHolder, &txCopy)
+		assert.ErrorIs(t, errSign, ErrRelayerAddressMismatch)
+		assert.Empty(t, txCopy.RelayerSignature)
+	})
+	t.Run("both signatures should verify on the node's signing data and be part of the hash", func(t *testing.T) {
+		t.Parallel()
+
+		txCopy := *relayedTx
+		require.Nil(t, tb.ApplySignature(senderHolder, &txCopy))
+		require.Nil(t, tb.ApplyRelayerSignature(relayerHolder, &txCopy))
+
+		nodeTx, errConvert := transactionToNodeTransaction(&txCopy)
+		require.Nil(t, errConvert)
+		assert.Equal(t, relayerHolder.GetAddressHandler().AddressBytes(), nodeTx.RelayerAddr)
+		assert.Len(t, nodeTx.RelayerSignature, 64)
+
+		dataForSigning, errConvert := nodeTx.GetDataForSigning(core.AddressPublicKeyConverter, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+		require.Nil(t, errConvert)
+		singleSigner := &singlesig.Ed25519Signer{}
+		assert.Nil(t, singleSigner.Verify(senderHolder.GetPublicKey(), dataForSigning, nodeTx.Signature))
+		assert.Nil(t, singleSigner.Verify(relayerHolder.GetPublicKey(), dataForSigning, nodeTx.RelayerSignature))
+
+		txHash, errHash := tb.ComputeTxHash(&txCopy)
+		require.Nil(t, errHash)
+		txCopy.RelayerSignature = txCopy.Signature
+		otherTxHash, errHash := tb.ComputeTxHash(&txCopy)
+		require.Nil(t, errHash)
+		assert.NotEqual(t, txHash, otherTxHash)
+	})
+}
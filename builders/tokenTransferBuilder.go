@@ -0,0 +1,185 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DefaultGasLimitPerTransfer is the gas limit added for each of the transferred tokens
+const DefaultGasLimitPerTransfer = uint64(200000)
+
+type tokenTransferBuilder struct {
+	sender               core.AddressHandler
+	receiver             core.AddressHandler
+	payments             []*data.TokenPayment
+	function             string
+	arguments            [][]byte
+	networkConfig        *data.NetworkConfig
+	gasLimitPerTransfer  uint64
+	contractCallGasLimit uint64
+}
+
+// NewTokenTransferBuilder creates a new builder for the ESDTTransfer, ESDTNFTTransfer and MultiESDTNFTTransfer transactions
+func NewTokenTransferBuilder() *tokenTransferBuilder {
+	return &tokenTransferBuilder{
+		gasLimitPerTransfer: DefaultGasLimitPerTransfer,
+	}
+}
+
+// SetSender sets the account sending the tokens
+func (ttb *tokenTransferBuilder) SetSender(sender core.AddressHandler) *tokenTransferBuilder {
+	ttb.sender = sender
+
+	return ttb
+}
+
+// SetReceiver sets the account or the contract receiving the tokens
+func (ttb *tokenTransferBuilder) SetReceiver(receiver core.AddressHandler) *tokenTransferBuilder {
+	ttb.receiver = receiver
+
+	return ttb
+}
+
+// SetPayments sets the transferred tokens
+func (ttb *tokenTransferBuilder) SetPayments(payments ...*data.TokenPayment) *tokenTransferBuilder {
+	ttb.payments = payments
+
+	return ttb
+}
+
+// SetContractCall sets the contract function called after the tokens are transferred, along with its arguments.
+// The gas limit should cover only the execution of the called function
+func (ttb *tokenTransferBuilder) SetContractCall(function string, arguments [][]byte, gasLimit uint64) *tokenTransferBuilder {
+	ttb.function = function
+	ttb.arguments = arguments
+	ttb.contractCallGasLimit = gasLimit
+
+	return ttb
+}
+
+// SetNetworkConfig sets the network config
+func (ttb *tokenTransferBuilder) SetNetworkConfig(config *data.NetworkConfig) *tokenTransferBuilder {
+	ttb.networkConfig = config
+
+	return ttb
+}
+
+// SetGasLimitPerTransfer overrides the gas limit added for each of the transferred tokens
+func (ttb *tokenTransferBuilder) SetGasLimitPerTransfer(gasLimit uint64) *tokenTransferBuilder {
+	ttb.gasLimitPerTransfer = gasLimit
+
+	return ttb
+}
+
+// Build builds the token transfer transaction. A single fungible token is sent with ESDTTransfer directly to the
+// receiver, a single NFT, SFT or Meta-ESDT is sent with ESDTNFTTransfer and multiple tokens are sent with
+// MultiESDTNFTTransfer, the last two having the sender as the transaction's receiver.
+// The returned transaction will not be signed and will have the nonce set to 0
+func (ttb *tokenTransferBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := ttb.checkArguments()
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, builder := ttb.createTransferData()
+	if len(ttb.function) > 0 {
+		builder.ArgBytes([]byte(ttb.function))
+		for _, arg := range ttb.arguments {
+			builder.ArgHexString(hex.EncodeToString(arg))
+		}
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := ttb.networkConfig.MinGasLimit + ttb.networkConfig.GasPerDataByte*uint64(len(dataField)) +
+		ttb.gasLimitPerTransfer*uint64(len(ttb.payments)) + ttb.contractCallGasLimit
+
+	return &transaction.FrontendTransaction{
+		Value:    "0",
+		Receiver: receiver.AddressAsBech32String(),
+		Sender:   ttb.sender.AddressAsBech32String(),
+		GasPrice: ttb.networkConfig.MinGasPrice,
+		GasLimit: gasLimit,
+		Data:     dataField,
+		ChainID:  ttb.networkConfig.ChainID,
+		Version:  ttb.networkConfig.MinTransactionVersion,
+	}, nil
+}
+
+func (ttb *tokenTransferBuilder) checkArguments() error {
+	if ttb.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	err := checkAddress(ttb.sender)
+	if err != nil {
+		return fmt.Errorf("%w for the sender", err)
+	}
+	err = checkAddress(ttb.receiver)
+	if err != nil {
+		return fmt.Errorf("%w for the receiver", err)
+	}
+
+	if len(ttb.payments) == 0 {
+		return ErrNoTokenPayments
+	}
+	for idx, payment := range ttb.payments {
+		if payment == nil {
+			return fmt.Errorf("%w at index %d", ErrNilTokenPayment, idx)
+		}
+		if len(payment.TokenIdentifier) == 0 {
+			return fmt.Errorf("%w at index %d", ErrEmptyTokenIdentifier, idx)
+		}
+		if payment.Amount == nil {
+			return fmt.Errorf("%w for the amount at index %d", ErrNilValue, idx)
+		}
+		if payment.Amount.Sign() <= 0 {
+			return fmt.Errorf("%w for the amount at index %d", ErrInvalidValue, idx)
+		}
+	}
+
+	return nil
+}
+
+func (ttb *tokenTransferBuilder) createTransferData() (core.AddressHandler, TxDataBuilder) {
+	builder := NewTxDataBuilder()
+
+	if len(ttb.payments) > 1 {
+		builder.Function(chainCore.BuiltInFunctionMultiESDTNFTTransfer).
+			ArgAddress(ttb.receiver).
+			ArgInt64(int64(len(ttb.payments)))
+		for _, payment := range ttb.payments {
+			builder.ArgBytes([]byte(payment.TokenIdentifier)).
+				ArgBigInt(big.NewInt(0).SetUint64(payment.Nonce)).
+				ArgBigInt(payment.Amount)
+		}
+
+		return ttb.sender, builder
+	}
+
+	payment := ttb.payments[0]
+	if payment.Nonce == 0 {
+		builder.Function(chainCore.BuiltInFunctionESDTTransfer).
+			ArgBytes([]byte(payment.TokenIdentifier)).
+			ArgBigInt(payment.Amount)
+
+		return ttb.receiver, builder
+	}
+
+	builder.Function(chainCore.BuiltInFunctionESDTNFTTransfer).
+		ArgBytes([]byte(payment.TokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(payment.Nonce)).
+		ArgBigInt(payment.Amount).
+		ArgAddress(ttb.receiver)
+
+	return ttb.sender, builder
+}
@@ -0,0 +1,132 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReceiverAddress = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+
+func createTokenTransferBuilder(t *testing.T, payments ...*data.TokenPayment) *tokenTransferBuilder {
+	receiver, err := data.NewAddressFromBech32String(testReceiverAddress)
+	require.Nil(t, err)
+
+	return NewTokenTransferBuilder().
+		SetSender(createTestSender(t)).
+		SetReceiver(receiver).
+		SetPayments(payments...).
+		SetNetworkConfig(createMockArgsEsdtTransactionsFactory().NetworkConfig)
+}
+
+func TestTokenTransferBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	hexReceiver := "000000000000000005000e8a594d1c9b52073fcd3c856c87986045c85f568b98"
+	hexToken := "544b4e2d313233343536"
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(1)})
+		builder.SetNetworkConfig(nil)
+		tx, err := builder.Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(1)})
+		builder.SetReceiver(nil)
+		tx, err := builder.Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("invalid payments should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createTokenTransferBuilder(t).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoTokenPayments, err)
+
+		tx, err = createTokenTransferBuilder(t, nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilTokenPayment))
+
+		tx, err = createTokenTransferBuilder(t, &data.TokenPayment{Amount: big.NewInt(1)}).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrEmptyTokenIdentifier))
+
+		tx, err = createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456"}).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilValue))
+
+		tx, err = createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(0)}).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("fungible token should use ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(10)})
+		tx, err := builder.Build()
+		require.Nil(t, err)
+
+		expectedData := "ESDTTransfer@" + hexToken + "@0a"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testReceiverAddress, tx.Receiver)
+		assert.Equal(t, testSenderAddress, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, "T", tx.ChainID)
+		expectedGasLimit := builder.networkConfig.MinGasLimit + builder.networkConfig.GasPerDataByte*uint64(len(expectedData)) +
+			DefaultGasLimitPerTransfer
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("fungible token with contract call", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(10)})
+		builder.SetContractCall("deposit", [][]byte{{1}, {}}, 5000000)
+		tx, err := builder.Build()
+		require.Nil(t, err)
+
+		expectedData := "ESDTTransfer@" + hexToken + "@0a@6465706f736974@01@"
+		assert.Equal(t, expectedData, string(tx.Data))
+		expectedGasLimit := builder.networkConfig.MinGasLimit + builder.networkConfig.GasPerDataByte*uint64(len(expectedData)) +
+			DefaultGasLimitPerTransfer + 5000000
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+	t.Run("single NFT should use ESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t, &data.TokenPayment{TokenIdentifier: "TKN-123456", Nonce: 5, Amount: big.NewInt(1)})
+		builder.SetContractCall("deposit", nil, 0)
+		tx, err := builder.Build()
+		require.Nil(t, err)
+
+		assert.Equal(t, "ESDTNFTTransfer@"+hexToken+"@05@01@"+hexReceiver+"@6465706f736974", string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+	})
+	t.Run("multiple tokens should use MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createTokenTransferBuilder(t,
+			&data.TokenPayment{TokenIdentifier: "TKN-123456", Amount: big.NewInt(10)},
+			&data.TokenPayment{TokenIdentifier: "NFT-123456", Nonce: 1, Amount: big.NewInt(1)},
+		)
+		builder.SetGasLimitPerTransfer(100)
+		tx, err := builder.Build()
+		require.Nil(t, err)
+
+		expectedData := "MultiESDTNFTTransfer@" + hexReceiver + "@02@" + hexToken + "@00@0a@4e46542d313233343536@01@01"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testSenderAddress, tx.Receiver)
+		expectedGasLimit := builder.networkConfig.MinGasLimit + builder.networkConfig.GasPerDataByte*uint64(len(expectedData)) + 200
+		assert.Equal(t, expectedGasLimit, tx.GasLimit)
+	})
+}
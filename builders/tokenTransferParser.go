@@ -0,0 +1,123 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ParseTokenTransfer returns the token transfer held by the data field of a transaction or of a smart contract
+// result, or nil if the data field is not an ESDTTransfer, ESDTNFTTransfer or MultiESDTNFTTransfer call. The NFT
+// transfers issued by users are sent to themselves, with the destination as argument, while the ones issued by
+// contracts as smart contract results are sent directly to the destination
+func ParseTokenTransfer(sender string, receiver string, dataField []byte) (*data.TokenTransfer, error) {
+	function, args, err := parseCallData(dataField)
+	if err != nil {
+		// not a built in function call, the data is a plain note
+		return nil, nil
+	}
+
+	switch function {
+	case chainCore.BuiltInFunctionESDTTransfer:
+		return parseESDTTransfer(receiver, args)
+	case chainCore.BuiltInFunctionESDTNFTTransfer:
+		return parseESDTNFTTransfer(sender, receiver, args)
+	case chainCore.BuiltInFunctionMultiESDTNFTTransfer:
+		return parseMultiESDTNFTTransfer(sender, receiver, args)
+	default:
+		return nil, nil
+	}
+}
+
+func parseESDTTransfer(receiver string, args [][]byte) (*data.TokenTransfer, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("%w: not enough arguments for %s", ErrInvalidValue, chainCore.BuiltInFunctionESDTTransfer)
+	}
+
+	return &data.TokenTransfer{
+		Function:    chainCore.BuiltInFunctionESDTTransfer,
+		Destination: receiver,
+		Payments:    []*data.TokenPayment{createTokenPayment(args[0], nil, args[1])},
+	}, nil
+}
+
+func parseESDTNFTTransfer(sender string, receiver string, args [][]byte) (*data.TokenTransfer, error) {
+	function := chainCore.BuiltInFunctionESDTNFTTransfer
+	if len(args) < 3 {
+		return nil, fmt.Errorf("%w: not enough arguments for %s", ErrInvalidValue, function)
+	}
+	destination := receiver
+	if sender == receiver {
+		if len(args) < 4 {
+			return nil, fmt.Errorf("%w: missing destination for %s", ErrInvalidValue, function)
+		}
+		destination = data.NewAddressFromBytes(args[3]).AddressAsBech32String()
+	}
+
+	return &data.TokenTransfer{
+		Function:    function,
+		Destination: destination,
+		Payments:    []*data.TokenPayment{createTokenPayment(args[0], args[1], args[2])},
+	}, nil
+}
+
+func parseMultiESDTNFTTransfer(sender string, receiver string, args [][]byte) (*data.TokenTransfer, error) {
+	function := chainCore.BuiltInFunctionMultiESDTNFTTransfer
+	destination := receiver
+	if sender == receiver {
+		if len(args) < 1 {
+			return nil, fmt.Errorf("%w: missing destination for %s", ErrInvalidValue, function)
+		}
+		destination = data.NewAddressFromBytes(args[0]).AddressAsBech32String()
+		args = args[1:]
+	}
+	if len(args) < 1 {
+		return nil, fmt.Errorf("%w: missing number of transfers for %s", ErrInvalidValue, function)
+	}
+
+	// the number of transfers is checked against the number of arguments before being used, so a huge value can
+	// neither overflow nor allocate
+	numTransfersValue := big.NewInt(0).SetBytes(args[0])
+	args = args[1:]
+	if !numTransfersValue.IsUint64() || numTransfersValue.Uint64() > uint64(len(args))/3 {
+		return nil, fmt.Errorf("%w: not enough arguments for %s", ErrInvalidValue, function)
+	}
+	numTransfers := numTransfersValue.Uint64()
+
+	payments := make([]*data.TokenPayment, 0, numTransfers)
+	for i := uint64(0); i < numTransfers; i++ {
+		payments = append(payments, createTokenPayment(args[i*3], args[i*3+1], args[i*3+2]))
+	}
+
+	return &data.TokenTransfer{
+		Function:    function,
+		Destination: destination,
+		Payments:    payments,
+	}, nil
+}
+
+func parseCallData(dataField []byte) (string, [][]byte, error) {
+	parts := strings.Split(string(dataField), "@")
+	args := make([][]byte, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		arg, err := hex.DecodeString(part)
+		if err != nil {
+			return "", nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return parts[0], args, nil
+}
+
+func createTokenPayment(identifier []byte, nonce []byte, amount []byte) *data.TokenPayment {
+	return &data.TokenPayment{
+		TokenIdentifier: string(identifier),
+		Nonce:           big.NewInt(0).SetBytes(nonce).Uint64(),
+		Amount:          big.NewInt(0).SetBytes(amount),
+	}
+}
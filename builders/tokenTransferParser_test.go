@@ -0,0 +1,155 @@
+package builders
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractAddress = "erd1qqqqqqqqqqqqqpgqfzydqmdw7m2vazsp6u5p95yxz76t2p9rd8ss0zp9ts"
+
+func createCallData(function string, args ...[]byte) []byte {
+	parts := []string{function}
+	for _, arg := range args {
+		parts = append(parts, hex.EncodeToString(arg))
+	}
+
+	return []byte(strings.Join(parts, "@"))
+}
+
+func TestParseTokenTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should parse the transfers built by the token transfer builder", func(t *testing.T) {
+		t.Parallel()
+
+		paymentsSets := [][]*data.TokenPayment{
+			{{TokenIdentifier: "TKN-123456", Amount: big.NewInt(10)}},
+			{{TokenIdentifier: "NFT-123456", Nonce: 5, Amount: big.NewInt(1)}},
+			{
+				{TokenIdentifier: "TKN-123456", Amount: big.NewInt(10)},
+				{TokenIdentifier: "NFT-123456", Nonce: 1, Amount: big.NewInt(1)},
+			},
+		}
+		for _, payments := range paymentsSets {
+			tx, err := createTokenTransferBuilder(t, payments...).Build()
+			require.Nil(t, err)
+
+			transfer, err := ParseTokenTransfer(tx.Sender, tx.Receiver, tx.Data)
+			require.Nil(t, err)
+			assert.Equal(t, testReceiverAddress, transfer.Destination)
+			assert.Equal(t, payments, transfer.Payments)
+		}
+	})
+	t.Run("NFT transfer sent by a contract should go to the receiver", func(t *testing.T) {
+		t.Parallel()
+
+		txData := createCallData("ESDTNFTTransfer", []byte("NFT-123456"), big.NewInt(2).Bytes(), big.NewInt(1).Bytes())
+		transfer, err := ParseTokenTransfer(testContractAddress, testReceiverAddress, txData)
+		require.Nil(t, err)
+
+		expectedTransfer := &data.TokenTransfer{
+			Function:    "ESDTNFTTransfer",
+			Destination: testReceiverAddress,
+			Payments:    []*data.TokenPayment{{TokenIdentifier: "NFT-123456", Nonce: 2, Amount: big.NewInt(1)}},
+		}
+		assert.Equal(t, expectedTransfer, transfer)
+	})
+	t.Run("not a token transfer should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		for _, txData := range [][]byte{nil, []byte("a plain note"), []byte("ESDTTransfer@not-hex"), createCallData("claim")} {
+			transfer, err := ParseTokenTransfer(testSenderAddress, testReceiverAddress, txData)
+			assert.Nil(t, err)
+			assert.Nil(t, transfer)
+		}
+	})
+}
+
+func TestParseTokenTransfer_MalformedArgumentsShouldError(t *testing.T) {
+	t.Parallel()
+
+	receiver, _ := data.NewAddressFromBech32String(testReceiverAddress)
+	testCases := []struct {
+		name     string
+		sender   string
+		receiver string
+		txData   []byte
+	}{
+		{
+			name:     "ESDTTransfer without amount",
+			sender:   testSenderAddress,
+			receiver: testReceiverAddress,
+			txData:   createCallData("ESDTTransfer", []byte("TKN-123456")),
+		},
+		{
+			name:     "ESDTNFTTransfer without amount",
+			sender:   testSenderAddress,
+			receiver: testReceiverAddress,
+			txData:   createCallData("ESDTNFTTransfer", []byte("NFT-123456"), big.NewInt(1).Bytes()),
+		},
+		{
+			name:     "ESDTNFTTransfer sent to self without destination",
+			sender:   testSenderAddress,
+			receiver: testSenderAddress,
+			txData:   createCallData("ESDTNFTTransfer", []byte("NFT-123456"), big.NewInt(1).Bytes(), big.NewInt(1).Bytes()),
+		},
+		{
+			name:     "MultiESDTNFTTransfer sent to self without destination",
+			sender:   testSenderAddress,
+			receiver: testSenderAddress,
+			txData:   []byte("MultiESDTNFTTransfer"),
+		},
+		{
+			name:     "MultiESDTNFTTransfer without number of transfers",
+			sender:   testSenderAddress,
+			receiver: testSenderAddress,
+			txData:   createCallData("MultiESDTNFTTransfer", receiver.AddressBytes()),
+		},
+		{
+			name:     "MultiESDTNFTTransfer with not enough arguments",
+			sender:   testSenderAddress,
+			receiver: testSenderAddress,
+			txData: createCallData("MultiESDTNFTTransfer", receiver.AddressBytes(), big.NewInt(2).Bytes(),
+				[]byte("TKN-123456"), nil, big.NewInt(150).Bytes(), []byte("TKN-123456"), nil),
+		},
+		{
+			name:     "MultiESDTNFTTransfer with a number of transfers overflowing when multiplied",
+			sender:   testContractAddress,
+			receiver: testReceiverAddress,
+			txData: createCallData("MultiESDTNFTTransfer", []byte{0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x56},
+				[]byte("TKN-123456"), nil, big.NewInt(150).Bytes()),
+		},
+		{
+			name:     "MultiESDTNFTTransfer with the maximum number of transfers",
+			sender:   testContractAddress,
+			receiver: testReceiverAddress,
+			txData: createCallData("MultiESDTNFTTransfer", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				[]byte("TKN-123456"), nil, big.NewInt(150).Bytes()),
+		},
+		{
+			name:     "MultiESDTNFTTransfer with a number of transfers not fitting an uint64",
+			sender:   testContractAddress,
+			receiver: testReceiverAddress,
+			txData: createCallData("MultiESDTNFTTransfer", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				[]byte("TKN-123456"), nil, big.NewInt(150).Bytes()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transfer, err := ParseTokenTransfer(testCase.sender, testCase.receiver, testCase.txData)
+			assert.True(t, errors.Is(err, ErrInvalidValue))
+			assert.Nil(t, transfer)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package builders
 
 import (
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -11,6 +12,8 @@ import (
 	"github.com/multiversx/mx-sdk-go/core"
 )
 
+const minGuardedTransactionVersion = uint32(2)
+
 var (
 	blake2bHasher          = blake2b.NewBlake2b()
 	nodeInternalMarshaller = &marshal.GogoProtoMarshalizer{}
@@ -49,6 +52,89 @@ func (builder *txBuilder) ApplySignature(
 	return nil
 }
 
+// SetGuardian sets the guardian address, the guarded transaction option and the minimum version supporting options.
+// It should be called before applying any signature as the guardian address is part of the signed message.
+// The caller should also add the network's extra gas limit for guarded transactions to the transaction gas limit
+func (builder *txBuilder) SetGuardian(tx *transaction.FrontendTransaction, guardian core.AddressHandler) error {
+	return setGuardian(tx, guardian)
+}
+
+func setGuardian(tx *transaction.FrontendTransaction, guardian core.AddressHandler) error {
+	if check.IfNil(guardian) {
+		return ErrNilAddress
+	}
+	if !guardian.IsValid() {
+		return ErrInvalidAddress
+	}
+
+	tx.GuardianAddr = guardian.AddressAsBech32String()
+	tx.Options |= transaction.MaskGuardedTransaction
+	if tx.Version < minGuardedTransactionVersion {
+		tx.Version = minGuardedTransactionVersion
+	}
+
+	return nil
+}
+
+// ApplyGuardianSignature will compute and set the guardian signature field. The transaction should have the guarded
+// option set and the guardian address should match the address of the provided crypto holder
+func (builder *txBuilder) ApplyGuardianSignature(
+	guardianCryptoHolder core.CryptoComponentsHolder,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(guardianCryptoHolder) {
+		return ErrNilCryptoComponentsHolder
+	}
+	if tx.Options&transaction.MaskGuardedTransaction == 0 {
+		return ErrMissingGuardianOption
+	}
+	if tx.GuardianAddr != guardianCryptoHolder.GetBech32() {
+		return fmt.Errorf("%w, transaction guardian %s, signing guardian %s",
+			ErrGuardianAddressMismatch, tx.GuardianAddr, guardianCryptoHolder.GetBech32())
+	}
+
+	unsignedMessage := builder.createUnsignedTx(tx)
+
+	signature, err := builder.signer.SignTransaction(unsignedMessage, guardianCryptoHolder.GetPrivateKey())
+	if err != nil {
+		return err
+	}
+
+	tx.GuardianSignature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// ApplyRelayerSignature will compute and set the relayer signature field of a relayed transaction v3. The sender
+// should have already signed the transaction, as the relayer address is part of the sender's signed message, and the
+// relayer address should match the address of the provided crypto holder
+func (builder *txBuilder) ApplyRelayerSignature(
+	relayerCryptoHolder core.CryptoComponentsHolder,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(relayerCryptoHolder) {
+		return ErrNilCryptoComponentsHolder
+	}
+	if len(tx.RelayerAddr) == 0 {
+		return ErrMissingRelayerAddress
+	}
+	if tx.RelayerAddr != relayerCryptoHolder.GetBech32() {
+		return fmt.Errorf("%w, transaction relayer %s, signing relayer %s",
+			ErrRelayerAddressMismatch, tx.RelayerAddr, relayerCryptoHolder.GetBech32())
+	}
+
+	unsignedMessage := builder.createUnsignedTx(tx)
+
+	signature, err := builder.signer.SignTransaction(unsignedMessage, relayerCryptoHolder.GetPrivateKey())
+	if err != nil {
+		return err
+	}
+
+	tx.RelayerSignature = hex.EncodeToString(signature)
+
+	return nil
+}
+
 // ComputeTxHash will return the hash of the provided transaction. It assumes that the transaction is already signed,
 // otherwise it will return an error.
 func (builder *txBuilder) ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error) {
@@ -86,6 +172,16 @@ func transactionToNodeTransaction(tx *transaction.FrontendTransaction) (*transac
 		return nil, err
 	}
 
+	guardianBytes, guardianSignatureBytes, err := guardianFieldsToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	relayerBytes, relayerSignatureBytes, err := relayerFieldsToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+
 	valueBI, ok := big.NewInt(0).SetString(tx.Value, 10)
 	if !ok {
 		return nil, ErrInvalidValue
@@ -103,12 +199,56 @@ func transactionToNodeTransaction(tx *transaction.FrontendTransaction) (*transac
 		Version:   tx.Version,
 		Signature: signaturesBytes,
 		Options:   tx.Options,
+
+		GuardianAddr:      guardianBytes,
+		GuardianSignature: guardianSignatureBytes,
+
+		RelayerAddr:      relayerBytes,
+		RelayerSignature: relayerSignatureBytes,
 	}, nil
 }
 
+func guardianFieldsToBytes(tx *transaction.FrontendTransaction) ([]byte, []byte, error) {
+	if len(tx.GuardianAddr) == 0 {
+		return nil, nil, nil
+	}
+
+	guardianBytes, err := core.AddressPublicKeyConverter.Decode(tx.GuardianAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	guardianSignatureBytes, err := hex.DecodeString(tx.GuardianSignature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return guardianBytes, guardianSignatureBytes, nil
+}
+
+func relayerFieldsToBytes(tx *transaction.FrontendTransaction) ([]byte, []byte, error) {
+	if len(tx.RelayerAddr) == 0 {
+		return nil, nil, nil
+	}
+
+	relayerBytes, err := core.AddressPublicKeyConverter.Decode(tx.RelayerAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relayerSignatureBytes, err := hex.DecodeString(tx.RelayerSignature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return relayerBytes, relayerSignatureBytes, nil
+}
+
 func (builder *txBuilder) createUnsignedTx(tx *transaction.FrontendTransaction) *transaction.FrontendTransaction {
 	copiedTransaction := *tx
 	copiedTransaction.Signature = ""
+	copiedTransaction.GuardianSignature = ""
+	copiedTransaction.RelayerSignature = ""
 
 	return &copiedTransaction
 }
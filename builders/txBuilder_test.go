@@ -8,10 +8,14 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -146,3 +150,111 @@ func TestTxBuilder_ApplySignatureAndGenerateTxHash(t *testing.T) {
 		assert.Equal(t, "8bbb2b7474deb2e67fa8f9db1eccef57ec14aa93710452a5de5ff52e5a369144", hex.EncodeToString(txHash))
 	})
 }
+
+func TestTxBuilder_SetGuardian(t *testing.T) {
+	t.Parallel()
+
+	tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+
+	t.Run("nil guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{Version: 1}
+		err := tb.SetGuardian(tx, nil)
+		assert.Equal(t, ErrNilAddress, err)
+		assert.Empty(t, tx.GuardianAddr)
+	})
+	t.Run("should set the guardian, the option and the version", func(t *testing.T) {
+		t.Parallel()
+
+		guardian, err := data.NewAddressFromBech32String("erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw")
+		require.Nil(t, err)
+
+		tx := &transaction.FrontendTransaction{Version: 1, Options: transaction.MaskSignedWithHash}
+		err = tb.SetGuardian(tx, guardian)
+		require.Nil(t, err)
+		assert.Equal(t, guardian.AddressAsBech32String(), tx.GuardianAddr)
+		assert.Equal(t, transaction.MaskSignedWithHash|transaction.MaskGuardedTransaction, tx.Options)
+		assert.Equal(t, uint32(2), tx.Version)
+	})
+}
+
+func TestTxBuilder_ApplyGuardianSignature(t *testing.T) {
+	t.Parallel()
+
+	senderSk, err := hex.DecodeString("28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+	require.Nil(t, err)
+	senderHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, senderSk)
+	require.Nil(t, err)
+	guardianSk, err := hex.DecodeString("6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4")
+	require.Nil(t, err)
+	guardianHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, guardianSk)
+	require.Nil(t, err)
+
+	createTx := func() *transaction.FrontendTransaction {
+		return &transaction.FrontendTransaction{
+			Nonce:    1,
+			Value:    "0",
+			Receiver: "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw",
+			GasPrice: 1000000000,
+			GasLimit: 100000,
+			ChainID:  "T",
+			Version:  uint32(1),
+		}
+	}
+	tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+
+	t.Run("nil crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := tb.ApplyGuardianSignature(nil, createTx())
+		assert.Equal(t, ErrNilCryptoComponentsHolder, err)
+	})
+	t.Run("missing guarded option should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.GuardianAddr = guardianHolder.GetBech32()
+		err := tb.ApplyGuardianSignature(guardianHolder, tx)
+		assert.Equal(t, ErrMissingGuardianOption, err)
+	})
+	t.Run("different guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		_ = tb.SetGuardian(tx, senderHolder.GetAddressHandler())
+		err := tb.ApplyGuardianSignature(guardianHolder, tx)
+		assert.True(t, errors.Is(err, ErrGuardianAddressMismatch))
+		assert.Empty(t, tx.GuardianSignature)
+	})
+	t.Run("both signatures should be verifiable and included in the hash", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		err := tb.SetGuardian(tx, guardianHolder.GetAddressHandler())
+		require.Nil(t, err)
+		err = tb.ApplySignature(senderHolder, tx)
+		require.Nil(t, err)
+		err = tb.ApplyGuardianSignature(guardianHolder, tx)
+		require.Nil(t, err)
+		require.NotEmpty(t, tx.GuardianSignature)
+
+		nodeTx, err := transactionToNodeTransaction(tx)
+		require.Nil(t, err)
+		assert.Equal(t, guardianHolder.GetAddressHandler().AddressBytes(), nodeTx.GuardianAddr)
+		assert.True(t, nodeTx.HasOptionGuardianSet())
+
+		signedMessage, err := nodeTx.GetDataForSigning(core.AddressPublicKeyConverter, &marshal.JsonMarshalizer{}, blake2bHasher)
+		require.Nil(t, err)
+		singleSigner := &singlesig.Ed25519Signer{}
+		assert.Nil(t, singleSigner.Verify(senderHolder.GetPublicKey(), signedMessage, nodeTx.Signature))
+		assert.Nil(t, singleSigner.Verify(guardianHolder.GetPublicKey(), signedMessage, nodeTx.GuardianSignature))
+
+		hashWithGuardianSignature, err := tb.ComputeTxHash(tx)
+		require.Nil(t, err)
+		tx.GuardianSignature = ""
+		hashWithoutGuardianSignature, err := tb.ComputeTxHash(tx)
+		require.Nil(t, err)
+		assert.NotEqual(t, hashWithGuardianSignature, hashWithoutGuardianSignature)
+	})
+}
@@ -65,7 +65,7 @@ func (builder *vmQueryBuilder) ArgBytes(bytes []byte) VMQueryBuilder {
 
 // CallerAddress sets the caller address
 func (builder *vmQueryBuilder) CallerAddress(address core.AddressHandler) VMQueryBuilder {
-	err := builder.checkAddress(address)
+	err := checkAddress(address)
 	if err != nil {
 		builder.err = err
 		return builder
@@ -78,7 +78,7 @@ func (builder *vmQueryBuilder) CallerAddress(address core.AddressHandler) VMQuer
 
 // Address sets the destination address
 func (builder *vmQueryBuilder) Address(address core.AddressHandler) VMQueryBuilder {
-	err := builder.checkAddress(address)
+	err := checkAddress(address)
 	if err != nil {
 		builder.err = err
 		return builder
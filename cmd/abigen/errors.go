@@ -0,0 +1,7 @@
+package main
+
+import "errors"
+
+var errInvalidPackageName = errors.New("invalid package name")
+
+var errUnsupportedType = errors.New("unsupported ABI type")
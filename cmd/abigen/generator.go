@@ -0,0 +1,645 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/multiversx/mx-sdk-go/serde"
+)
+
+const enumUnderlyingType = "uint8"
+
+// reservedParamNames contains the identifiers used inside the generated methods that should not be shadowed by parameters
+var reservedParamNames = map[string]struct{}{
+	"ctx":        {},
+	"client":     {},
+	"result":     {},
+	"returnData": {},
+	"err":        {},
+	"args":       {},
+}
+
+type generator struct {
+	abi         *serde.Abi
+	packageName string
+	abiJSON     []byte
+	typeNames   map[string]string
+	tupleTypes  map[string]string
+	body        *bytes.Buffer
+	tuples      *bytes.Buffer
+}
+
+// generateClient returns the Go source of a typed client for the contract described by the provided ABI JSON
+func generateClient(abiJSON []byte, packageName string) ([]byte, error) {
+	abi, err := serde.NewAbiFromJSON(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+	if !token.IsIdentifier(packageName) {
+		return nil, fmt.Errorf("%w: %q", errInvalidPackageName, packageName)
+	}
+
+	gen := &generator{
+		abi:         abi,
+		packageName: packageName,
+		abiJSON:     abiJSON,
+		typeNames:   make(map[string]string),
+		tupleTypes:  make(map[string]string),
+		body:        &bytes.Buffer{},
+		tuples:      &bytes.Buffer{},
+	}
+
+	return gen.generate()
+}
+
+func (gen *generator) generate() ([]byte, error) {
+	for _, name := range gen.sortedTypeNames() {
+		gen.typeNames[name] = exportedName(name)
+	}
+
+	gen.writeClient()
+
+	for _, name := range gen.sortedTypeNames() {
+		err := gen.writeCustomType(name, gen.abi.Types[name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if gen.abi.Constructor != nil {
+		err := gen.writeConstructor(gen.abi.Constructor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, endpoint := range gen.abi.Endpoints {
+		err := gen.writeEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	source := &bytes.Buffer{}
+	gen.writeHeader(source)
+	source.Write(gen.body.Bytes())
+	source.Write(gen.tuples.Bytes())
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w while formatting the generated source", err)
+	}
+
+	return formatted, nil
+}
+
+func (gen *generator) sortedTypeNames() []string {
+	names := make([]string, 0, len(gen.abi.Types))
+	for name := range gen.abi.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (gen *generator) writeHeader(source *bytes.Buffer) {
+	fmt.Fprintf(source, "// Code generated by abigen from the %s ABI. DO NOT EDIT.\n\n", gen.abi.Name)
+	fmt.Fprintf(source, "package %s\n\n", gen.packageName)
+
+	source.WriteString("import (\n")
+	source.WriteString("\t\"context\"\n\t\"encoding/hex\"\n\t\"errors\"\n\t\"math/big\"\n\n")
+	source.WriteString("\t\"github.com/multiversx/mx-chain-core-go/core/check\"\n")
+	source.WriteString("\t\"github.com/multiversx/mx-chain-core-go/data/transaction\"\n")
+	source.WriteString("\t\"github.com/multiversx/mx-sdk-go/builders\"\n")
+	source.WriteString("\t\"github.com/multiversx/mx-sdk-go/core\"\n")
+	source.WriteString("\t\"github.com/multiversx/mx-sdk-go/data\"\n")
+	source.WriteString("\t\"github.com/multiversx/mx-sdk-go/serde\"\n")
+	source.WriteString(")\n\n")
+
+	fmt.Fprintf(source, "const abiJSON = %s\n\n", quoteSource(string(gen.abiJSON)))
+}
+
+func (gen *generator) writeClient() {
+	contractName := gen.abi.Name
+	w := gen.body
+
+	fmt.Fprintf(w, `var (
+	// ErrNilContractAddress signals that a nil contract address was provided
+	ErrNilContractAddress = errors.New("nil contract address")
+	// ErrNilQueryExecutor signals that a nil query executor was provided
+	ErrNilQueryExecutor = errors.New("nil query executor")
+	// ErrNilSender signals that a nil sender was provided
+	ErrNilSender = errors.New("nil sender")
+	// ErrNilNetworkConfig signals that a nil network config was provided
+	ErrNilNetworkConfig = errors.New("nil network config")
+)
+
+// QueryExecutor defines the component able to execute VM queries, such as the one created by blockchain.NewVmQueryGetter
+type QueryExecutor interface {
+	ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// CallArgs holds the fields of the transactions calling the endpoints of the %[1]s contract
+type CallArgs struct {
+	Sender core.AddressHandler
+	// Value is the EGLD value sent to the endpoint, nil meaning no value
+	Value *big.Int
+	// GasLimit is the gas needed for the execution of the endpoint, the gas needed for the data field being added
+	GasLimit      uint64
+	NetworkConfig *data.NetworkConfig
+}
+
+// Client is a typed client of the %[1]s contract. Views are executed as VM queries while the other endpoints
+// return the transactions calling them
+type Client struct {
+	contract      core.AddressHandler
+	queryExecutor QueryExecutor
+	codec         serde.AbiCodec
+}
+
+// NewClient creates a new typed client of the %[1]s contract deployed at the provided address
+func NewClient(contract core.AddressHandler, queryExecutor QueryExecutor) (*Client, error) {
+	if check.IfNil(contract) {
+		return nil, ErrNilContractAddress
+	}
+	if check.IfNil(queryExecutor) {
+		return nil, ErrNilQueryExecutor
+	}
+
+	codec, err := newCodec()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		contract:      contract,
+		queryExecutor: queryExecutor,
+		codec:         codec,
+	}, nil
+}
+
+func newCodec() (serde.AbiCodec, error) {
+	abi, err := serde.NewAbiFromJSON([]byte(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return serde.NewAbiCodec(abi)
+}
+
+// Address returns the address of the contract
+func (client *Client) Address() core.AddressHandler {
+	return client.contract
+}
+
+func (client *Client) query(ctx context.Context, endpointName string, values []interface{}) ([][]byte, error) {
+	args, err := client.codec.EncodeEndpointArguments(endpointName, values)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewVMQueryBuilder().
+		Address(client.contract).
+		Function(endpointName)
+	for _, arg := range args {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	return client.queryExecutor.ExecuteQueryFromBuilder(ctx, builder)
+}
+
+func (client *Client) call(args CallArgs, endpointName string, values []interface{}) (*transaction.FrontendTransaction, error) {
+	if check.IfNil(args.Sender) {
+		return nil, ErrNilSender
+	}
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	encodedArgs, err := client.codec.EncodeEndpointArguments(endpointName, values)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewTxDataBuilder().Function(endpointName)
+	for _, arg := range encodedArgs {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value := "0"
+	if args.Value != nil {
+		value = args.Value.String()
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    value,
+		Receiver: client.contract.AddressAsBech32String(),
+		Sender:   args.Sender.AddressAsBech32String(),
+		GasPrice: args.NetworkConfig.MinGasPrice,
+		GasLimit: args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len(dataField)) + args.GasLimit,
+		Data:     dataField,
+		ChainID:  args.NetworkConfig.ChainID,
+		Version:  args.NetworkConfig.MinTransactionVersion,
+	}, nil
+}
+
+`, contractName)
+}
+
+func (gen *generator) writeCustomType(name string, definition *serde.AbiTypeDefinition) error {
+	goName := gen.typeNames[name]
+	w := gen.body
+
+	switch definition.Type {
+	case serde.AbiTypeStruct:
+		fmt.Fprintf(w, "// %s is the Go representation of the %s ABI struct\n", goName, name)
+		writeDocs(w, definition.Docs)
+		fmt.Fprintf(w, "type %s struct {\n", goName)
+		for _, field := range definition.Fields {
+			fieldType, err := gen.goTypeOf(field.Type, goName+exportedName(field.Name))
+			if err != nil {
+				return fmt.Errorf("%w for field %s of %s", err, field.Name, name)
+			}
+			fmt.Fprintf(w, "\t%s %s `abi:%q`\n", exportedName(field.Name), fieldType, field.Name)
+		}
+		w.WriteString("}\n\n")
+		return nil
+	case serde.AbiTypeEnum:
+		if !isFieldlessEnum(definition) {
+			fmt.Fprintf(w, "// %s is the Go representation of the %s ABI enum, its variants carrying fields\n", goName, name)
+			writeDocs(w, definition.Docs)
+			fmt.Fprintf(w, "type %s = serde.EnumValue\n\n", goName)
+			gen.writeEnumVariantNames(goName, definition)
+			return nil
+		}
+
+		fmt.Fprintf(w, "// %s is the Go representation of the %s ABI enum\n", goName, name)
+		writeDocs(w, definition.Docs)
+		fmt.Fprintf(w, "type %s %s\n\n", goName, enumUnderlyingType)
+		w.WriteString("const (\n")
+		for _, variant := range definition.Variants {
+			fmt.Fprintf(w, "\t%s%s %s = %d\n", goName, exportedName(variant.Name), goName, variant.Discriminant)
+		}
+		w.WriteString(")\n\n")
+		return nil
+	default:
+		return fmt.Errorf("%w: %s has kind %s", errUnsupportedType, name, definition.Type)
+	}
+}
+
+func (gen *generator) writeEnumVariantNames(goName string, definition *serde.AbiTypeDefinition) {
+	w := gen.body
+	w.WriteString("const (\n")
+	for _, variant := range definition.Variants {
+		fmt.Fprintf(w, "\t%sVariant%s = %q\n", goName, exportedName(variant.Name), variant.Name)
+	}
+	w.WriteString(")\n\n")
+}
+
+func (gen *generator) writeConstructor(constructor *serde.AbiEndpoint) error {
+	params, values, err := gen.params("Constructor", constructor.Inputs, "")
+	if err != nil {
+		return fmt.Errorf("%w for the constructor", err)
+	}
+
+	w := gen.body
+	w.WriteString("// EncodeConstructorArguments encodes the arguments of the contract constructor as used in the deploy transaction\n")
+	fmt.Fprintf(w, "func EncodeConstructorArguments(%s) ([][]byte, error) {\n", params)
+	w.WriteString("\tcodec, err := newCodec()\n\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(w, "\treturn codec.EncodeConstructorArguments([]interface{}{%s})\n}\n\n", values)
+
+	return nil
+}
+
+func (gen *generator) writeEndpoint(endpoint *serde.AbiEndpoint) error {
+	methodName := exportedName(endpoint.Name)
+	leadingParam := "args CallArgs"
+	if endpoint.IsReadonly() {
+		leadingParam = "ctx context.Context"
+	}
+	params, values, err := gen.params(methodName, endpoint.Inputs, leadingParam)
+	if err != nil {
+		return fmt.Errorf("%w for endpoint %s", err, endpoint.Name)
+	}
+
+	resultType, err := gen.writeOutputType(methodName, endpoint)
+	if err != nil {
+		return err
+	}
+
+	w := gen.body
+	if endpoint.IsReadonly() {
+		fmt.Fprintf(w, "// %s executes the %s view\n", methodName, endpoint.Name)
+		writeDocs(w, endpoint.Docs)
+		if len(resultType) == 0 {
+			fmt.Fprintf(w, "func (client *Client) %s(%s) error {\n", methodName, params)
+			fmt.Fprintf(w, "\t_, err := client.query(ctx, %q, []interface{}{%s})\n\n\treturn err\n}\n\n", endpoint.Name, values)
+			return nil
+		}
+
+		fmt.Fprintf(w, "func (client *Client) %s(%s) (%s, error) {\n", methodName, params, resultType)
+		fmt.Fprintf(w, "\treturnData, err := client.query(ctx, %q, []interface{}{%s})\n", endpoint.Name, values)
+		fmt.Fprintf(w, "\tif err != nil {\n\t\tvar result %s\n\t\treturn result, err\n\t}\n\n", resultType)
+		fmt.Fprintf(w, "\treturn client.Decode%sOutputs(returnData)\n}\n\n", methodName)
+		return gen.writeOutputsDecoder(methodName, endpoint, resultType)
+	}
+
+	fmt.Fprintf(w, "// %s creates the transaction calling the %s endpoint. The returned transaction will not be signed and will\n", methodName, endpoint.Name)
+	w.WriteString("// have the nonce set to 0\n")
+	writeDocs(w, endpoint.Docs)
+	fmt.Fprintf(w, "func (client *Client) %s(%s) (*transaction.FrontendTransaction, error) {\n", methodName, params)
+	fmt.Fprintf(w, "\treturn client.call(args, %q, []interface{}{%s})\n}\n\n", endpoint.Name, values)
+	if len(resultType) == 0 {
+		return nil
+	}
+
+	return gen.writeOutputsDecoder(methodName, endpoint, resultType)
+}
+
+// writeOutputType writes the struct grouping the outputs of an endpoint, if it has more than one, and returns
+// the Go type of the endpoint result. An empty string is returned for endpoints without outputs
+func (gen *generator) writeOutputType(methodName string, endpoint *serde.AbiEndpoint) (string, error) {
+	switch len(endpoint.Outputs) {
+	case 0:
+		return "", nil
+	case 1:
+		resultType, err := gen.goTypeOf(endpoint.Outputs[0].Type, methodName+"Output")
+		if err != nil {
+			return "", fmt.Errorf("%w for the output of endpoint %s", err, endpoint.Name)
+		}
+		return resultType, nil
+	}
+
+	outputTypeName := methodName + "Output"
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "// %s groups the results of the %s endpoint\n", outputTypeName, endpoint.Name)
+	fmt.Fprintf(w, "type %s struct {\n", outputTypeName)
+	for i, output := range endpoint.Outputs {
+		fieldName := outputFieldName(output, i)
+		fieldType, err := gen.goTypeOf(output.Type, outputTypeName+fieldName)
+		if err != nil {
+			return "", fmt.Errorf("%w for output %d of endpoint %s", err, i, endpoint.Name)
+		}
+		fmt.Fprintf(w, "\t%s %s\n", fieldName, fieldType)
+	}
+	w.WriteString("}\n\n")
+	gen.body.Write(w.Bytes())
+
+	return "*" + outputTypeName, nil
+}
+
+func (gen *generator) writeOutputsDecoder(methodName string, endpoint *serde.AbiEndpoint, resultType string) error {
+	w := gen.body
+	fmt.Fprintf(w, "// Decode%sOutputs decodes the return data of the %s endpoint\n", methodName, endpoint.Name)
+	fmt.Fprintf(w, "func (client *Client) Decode%sOutputs(returnData [][]byte) (%s, error) {\n", methodName, resultType)
+
+	if len(endpoint.Outputs) == 1 {
+		fmt.Fprintf(w, "\tvar result %s\n", resultType)
+		fmt.Fprintf(w, "\terr := client.codec.DecodeEndpointOutputsInto(%q, returnData, &result)\n\n\treturn result, err\n}\n\n", endpoint.Name)
+		return nil
+	}
+
+	targets := make([]string, 0, len(endpoint.Outputs))
+	for i, output := range endpoint.Outputs {
+		targets = append(targets, "&result."+outputFieldName(output, i))
+	}
+	fmt.Fprintf(w, "\tresult := &%s{}\n", strings.TrimPrefix(resultType, "*"))
+	fmt.Fprintf(w, "\terr := client.codec.DecodeEndpointOutputsInto(%q, returnData, %s)\n", endpoint.Name, strings.Join(targets, ", "))
+	w.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n\n\treturn result, nil\n}\n\n")
+
+	return nil
+}
+
+// params returns the parameters list of the generated method, starting with the provided leading parameter if any,
+// and the list of values passed to the codec
+func (gen *generator) params(methodName string, inputs []*serde.AbiParam, leadingParam string) (string, string, error) {
+	params := make([]string, 0, len(inputs)+1)
+	values := make([]string, 0, len(inputs))
+	if len(leadingParam) > 0 {
+		params = append(params, leadingParam)
+	}
+
+	used := make(map[string]struct{})
+	for i, input := range inputs {
+		name := paramName(input.Name, i)
+		paramType, err := gen.goTypeOf(input.Type, methodName+exportedName(name))
+		if err != nil {
+			return "", "", fmt.Errorf("%w for input %d", err, i)
+		}
+
+		if _, isUsed := used[name]; isUsed {
+			name = fmt.Sprintf("%s%d", name, i)
+		}
+		used[name] = struct{}{}
+
+		params = append(params, name+" "+paramType)
+		values = append(values, name)
+	}
+
+	return strings.Join(params, ", "), strings.Join(values, ", "), nil
+}
+
+// goTypeOf returns the Go type of the provided ABI type expression. The context name is used to name the
+// structs generated for the tuples and multi-values found in the expression
+func (gen *generator) goTypeOf(typeExpression string, contextName string) (string, error) {
+	t, err := serde.ParseAbiType(typeExpression)
+	if err != nil {
+		return "", err
+	}
+
+	return gen.goType(t, contextName)
+}
+
+func (gen *generator) goType(t *serde.AbiType, contextName string) (string, error) {
+	switch t.Name {
+	case "u8":
+		return "uint8", nil
+	case "u16":
+		return "uint16", nil
+	case "u32", "usize":
+		return "uint32", nil
+	case "u64":
+		return "uint64", nil
+	case "i8":
+		return "int8", nil
+	case "i16":
+		return "int16", nil
+	case "i32", "isize":
+		return "int32", nil
+	case "i64":
+		return "int64", nil
+	case "BigUint", "BigInt":
+		return "*big.Int", nil
+	case "bool":
+		return "bool", nil
+	case "Address":
+		return "core.AddressHandler", nil
+	case "bytes", "BoxedBytes", "ManagedBuffer", "H256", "CodeMetadata":
+		return "[]byte", nil
+	case "utf-8 string", "TokenIdentifier", "EgldOrEsdtTokenIdentifier":
+		return "string", nil
+	case "Option", "optional":
+		inner, err := gen.goType(t.Generics[0], contextName)
+		if err != nil {
+			return "", err
+		}
+		if isNilable(inner) {
+			return inner, nil
+		}
+		return "*" + inner, nil
+	case "List", "variadic", "counted-variadic":
+		inner, err := gen.goType(t.Generics[0], contextName+"Item")
+		if err != nil {
+			return "", err
+		}
+		return "[]" + inner, nil
+	case "tuple", "multi":
+		return gen.tupleType(t, contextName)
+	}
+
+	if length, isArray := t.ArrayLength(); isArray {
+		inner, err := gen.goType(t.Generics[0], contextName+"Item")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("[%d]%s", length, inner), nil
+	}
+
+	goName, found := gen.typeNames[t.Name]
+	if !found {
+		return "", fmt.Errorf("%w: %s", errUnsupportedType, t.Name)
+	}
+
+	return goName, nil
+}
+
+// tupleType declares a struct named after the context holding the items of the provided tuple or multi-value.
+// A type with the same name and the same ABI definition is reused
+func (gen *generator) tupleType(t *serde.AbiType, contextName string) (string, error) {
+	typeName := contextName
+	for index := 2; ; index++ {
+		_, isCustomType := gen.typeNames[typeName]
+		expression, isDeclared := gen.tupleTypes[typeName]
+		if isDeclared && expression == t.String() {
+			return typeName, nil
+		}
+		if !isDeclared && !isCustomType {
+			break
+		}
+		typeName = fmt.Sprintf("%s%d", contextName, index)
+	}
+	gen.tupleTypes[typeName] = t.String()
+
+	fields := make([]string, 0, len(t.Generics))
+	for i, generic := range t.Generics {
+		fieldType, err := gen.goType(generic, fmt.Sprintf("%sItem%d", typeName, i))
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, fmt.Sprintf("\tItem%d %s\n", i, fieldType))
+	}
+
+	fmt.Fprintf(gen.tuples, "// %s holds the items of the %s ABI type\n", typeName, t.String())
+	fmt.Fprintf(gen.tuples, "type %s struct {\n%s}\n\n", typeName, strings.Join(fields, ""))
+
+	return typeName, nil
+}
+
+// writeDocs appends the ABI docs as a separate paragraph of the doc comment
+func writeDocs(w *bytes.Buffer, docs []string) {
+	if len(docs) > 0 {
+		w.WriteString("//\n")
+	}
+	for _, line := range docs {
+		fmt.Fprintf(w, "// %s\n", strings.TrimSpace(line))
+	}
+}
+
+func isFieldlessEnum(definition *serde.AbiTypeDefinition) bool {
+	for _, variant := range definition.Variants {
+		if len(variant.Fields) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNilable(goType string) bool {
+	return strings.HasPrefix(goType, "*") || goType == "core.AddressHandler"
+}
+
+func outputFieldName(output *serde.AbiParam, index int) string {
+	if len(output.Name) > 0 {
+		return exportedName(output.Name)
+	}
+
+	return fmt.Sprintf("Output%d", index)
+}
+
+func paramName(name string, index int) string {
+	words := splitWords(name)
+	if len(words) == 0 {
+		return fmt.Sprintf("arg%d", index)
+	}
+
+	words[0] = strings.ToLower(words[0][:1]) + words[0][1:]
+	result := strings.Join(words, "")
+	if !token.IsIdentifier(result) {
+		return fmt.Sprintf("arg%d", index)
+	}
+	if _, isReserved := reservedParamNames[result]; isReserved {
+		return result + "Arg"
+	}
+
+	return result
+}
+
+// exportedName converts an ABI identifier such as `fee_percent` or `getSum` to an exported Go identifier
+func exportedName(name string) string {
+	words := splitWords(name)
+	if len(words) == 0 {
+		return "Field"
+	}
+
+	result := strings.Join(words, "")
+	if !unicode.IsLetter(rune(result[0])) {
+		result = "Field" + result
+	}
+
+	return result
+}
+
+func splitWords(name string) []string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make([]string, 0, len(parts))
+	for _, part := range parts {
+		words = append(words, strings.ToUpper(part[:1])+part[1:])
+	}
+
+	return words
+}
+
+func quoteSource(value string) string {
+	if strings.Contains(value, "`") {
+		return strconv.Quote(value)
+	}
+
+	return "`" + value + "`"
+}
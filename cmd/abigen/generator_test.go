@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sdkModulePath               = "github.com/multiversx/mx-sdk-go"
+	testRepositoryRoot          = "../.."
+	testContractAbiPath         = "../../serde/testingMocks/testContract.abi.json"
+	testGeneratedClientTestPath = "testdata/generatedClient_test.go"
+)
+
+func TestGenerateClient(t *testing.T) {
+	t.Parallel()
+
+	abiJSON, err := ioutil.ReadFile(testContractAbiPath)
+	require.Nil(t, err)
+
+	t.Run("invalid ABI should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generateClient([]byte("not a json"), "testcontract")
+		assert.NotNil(t, err)
+		assert.Nil(t, source)
+	})
+	t.Run("invalid package name should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generateClient(abiJSON, "test-contract")
+		assert.True(t, errors.Is(err, errInvalidPackageName))
+		assert.Nil(t, source)
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		abi := `{"name":"C","endpoints":[{"name":"get","mutability":"readonly","inputs":[],"outputs":[{"type":"Missing"}]}]}`
+		source, err := generateClient([]byte(abi), "c")
+		assert.True(t, errors.Is(err, errUnsupportedType))
+		assert.Nil(t, source)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		source, err := generateClient(abiJSON, "testcontract")
+		require.Nil(t, err)
+
+		file, err := parser.ParseFile(token.NewFileSet(), "testcontract.go", source, parser.ParseComments)
+		require.Nil(t, err)
+		assert.Equal(t, "testcontract", file.Name.Name)
+
+		generated := string(source)
+		expectedDeclarations := []string{
+			"func NewClient(contract core.AddressHandler, queryExecutor QueryExecutor) (*Client, error)",
+			"func EncodeConstructorArguments(initialValue *big.Int) ([][]byte, error)",
+			"func (client *Client) GetSum(ctx context.Context) (*big.Int, error)",
+			"func (client *Client) Deposit(args CallArgs, destination core.AddressHandler, memo *[]byte, payments []DepositPaymentsItem) (*transaction.FrontendTransaction, error)",
+			"func (client *Client) GetConfig(ctx context.Context, key *string) (*GetConfigOutput, error)",
+			"func (client *Client) DecodeGetConfigOutputs(returnData [][]byte) (*GetConfigOutput, error)",
+			"type Action = serde.EnumValue",
+			"type Status uint8",
+			"StatusActive   Status = 1",
+			"FeePercent uint32              `abi:\"fee_percent\"`",
+			"Pair       ConfigPair          `abi:\"pair\"`",
+			"type DepositPaymentsItem struct",
+		}
+		for _, declaration := range expectedDeclarations {
+			assert.Contains(t, generated, declaration)
+		}
+	})
+}
+
+func TestGenerateClient_GeneratedPackageShouldBuildAndRoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this test builds the generated package with the go tool")
+	}
+
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go tool is not available")
+	}
+
+	abiJSON, err := ioutil.ReadFile(testContractAbiPath)
+	require.Nil(t, err)
+	source, err := generateClient(abiJSON, "testcontract")
+	require.Nil(t, err)
+	roundTripTests, err := ioutil.ReadFile(testGeneratedClientTestPath)
+	require.Nil(t, err)
+
+	// the package is generated in a separate module using the SDK from this repository, the dependencies being the
+	// ones of the SDK
+	repoRoot, err := filepath.Abs(testRepositoryRoot)
+	require.Nil(t, err)
+	goMod, err := ioutil.ReadFile(filepath.Join(repoRoot, "go.mod"))
+	require.Nil(t, err)
+	goSum, err := ioutil.ReadFile(filepath.Join(repoRoot, "go.sum"))
+	require.Nil(t, err)
+
+	goMod = bytes.Replace(goMod, []byte("module "+sdkModulePath), []byte("module testcontract"), 1)
+	goMod = append(goMod, fmt.Sprintf("\nrequire %s v0.0.0\n\nreplace %s => %s\n", sdkModulePath, sdkModulePath, repoRoot)...)
+
+	dir := t.TempDir()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), goMod, 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "testcontract.go"), source, 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "testcontract_test.go"), roundTripTests, 0600))
+
+	cmd := exec.Command(goTool, "vet", ".")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	require.Nil(t, err, string(output))
+
+	cmd = exec.Command(goTool, "test", "-count=1", ".")
+	cmd.Dir = dir
+	output, err = cmd.CombinedOutput()
+	require.Nil(t, err, string(output))
+}
+
+func TestParamName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "initialValue", paramName("initial_value", 0))
+	assert.Equal(t, "arg1", paramName("", 1))
+	assert.Equal(t, "arg2", paramName("type", 2))
+	assert.Equal(t, "resultArg", paramName("result", 3))
+	assert.Equal(t, "FeePercent", exportedName("fee_percent"))
+	assert.Equal(t, "Field0", exportedName("0"))
+}
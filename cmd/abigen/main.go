@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path to the contract `.abi.json` file")
+	packageName := flag.String("package", "", "name of the generated Go package (defaults to the lower case ABI file name)")
+	outputPath := flag.String("out", "", "path of the generated Go file (defaults to the standard output)")
+	flag.Parse()
+
+	err := run(*abiPath, *packageName, *outputPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "abigen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath string, packageName string, outputPath string) error {
+	if len(abiPath) == 0 {
+		return fmt.Errorf("missing the -abi flag")
+	}
+
+	abiJSON, err := ioutil.ReadFile(abiPath)
+	if err != nil {
+		return err
+	}
+	if len(packageName) == 0 {
+		packageName = defaultPackageName(abiPath)
+	}
+
+	source, err := generateClient(abiJSON, packageName)
+	if err != nil {
+		return err
+	}
+	if len(outputPath) == 0 {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return ioutil.WriteFile(outputPath, source, 0644)
+}
+
+func defaultPackageName(abiPath string) string {
+	fileName := abiPath[strings.LastIndexAny(abiPath, `/\`)+1:]
+	name := strings.TrimSuffix(fileName, ".abi.json")
+
+	return strings.ToLower(strings.Join(splitWords(name), ""))
+}
@@ -0,0 +1,190 @@
+package testcontract
+
+// This file is copied next to the client generated from serde/testingMocks/testContract.abi.json and checks that the
+// generated methods round-trip against the serde codec
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/serde"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryExecutorStub struct {
+	returnData [][]byte
+	request    *data.VmValueRequest
+}
+
+func (stub *queryExecutorStub) ExecuteQueryFromBuilder(_ context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
+	request, err := builder.ToVmValueRequest()
+	if err != nil {
+		return nil, err
+	}
+	stub.request = request
+
+	return stub.returnData, nil
+}
+
+func (stub *queryExecutorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createTestCodec(t *testing.T) serde.AbiCodec {
+	codec, err := newCodec()
+	require.Nil(t, err)
+
+	return codec
+}
+
+func decodeHexArguments(t *testing.T, hexArgs []string) [][]byte {
+	args := make([][]byte, 0, len(hexArgs))
+	for _, hexArg := range hexArgs {
+		arg, err := hex.DecodeString(hexArg)
+		require.Nil(t, err)
+		args = append(args, arg)
+	}
+
+	return args
+}
+
+func TestGeneratedClient_GetConfig(t *testing.T) {
+	codec := createTestCodec(t)
+	owner := data.NewAddressFromBytes(bytes.Repeat([]byte{1}, 32))
+	expectedConfig := Config{
+		Owner:      owner,
+		Token:      "TKN-abcdef",
+		FeePercent: 15,
+		Limits:     []*big.Int{big.NewInt(10), big.NewInt(2000)},
+		Pair:       ConfigPair{Item0: -3, Item1: true},
+	}
+	expectedActions := []Action{
+		{Name: "Nothing", Discriminant: 0, Fields: map[string]interface{}{}},
+		{Name: "Transfer", Discriminant: 1, Fields: map[string]interface{}{"to": owner, "amount": big.NewInt(-7)}},
+	}
+
+	encodedConfig, err := codec.EncodeTopLevel("Config", expectedConfig)
+	require.Nil(t, err)
+	encodedStatus, err := codec.EncodeTopLevel("Status", StatusActive)
+	require.Nil(t, err)
+	returnData := [][]byte{encodedConfig, encodedStatus}
+	for _, action := range expectedActions {
+		encodedAction, errEncode := codec.EncodeTopLevel("Action", action)
+		require.Nil(t, errEncode)
+		returnData = append(returnData, encodedAction)
+	}
+
+	executor := &queryExecutorStub{returnData: returnData}
+	client, err := NewClient(owner, executor)
+	require.Nil(t, err)
+
+	key := "TKN-abcdef"
+	output, err := client.GetConfig(context.Background(), &key)
+	require.Nil(t, err)
+
+	assert.Equal(t, "getConfig", executor.request.FuncName)
+	args := decodeHexArguments(t, executor.request.Args)
+	require.Len(t, args, 1)
+	decodedKey, err := codec.DecodeTopLevel("TokenIdentifier", args[0])
+	require.Nil(t, err)
+	assert.Equal(t, key, decodedKey)
+
+	assert.Equal(t, expectedConfig.Owner.AddressBytes(), output.Output0.Owner.AddressBytes())
+	assert.Equal(t, expectedConfig.Token, output.Output0.Token)
+	assert.Equal(t, expectedConfig.FeePercent, output.Output0.FeePercent)
+	assert.Equal(t, expectedConfig.Limits, output.Output0.Limits)
+	assert.Equal(t, expectedConfig.Pair, output.Output0.Pair)
+	assert.Equal(t, StatusActive, output.Output1)
+	require.Len(t, output.Output2, 2)
+	assert.Equal(t, "Nothing", output.Output2[0].Name)
+	assert.Equal(t, "Transfer", output.Output2[1].Name)
+	assert.Equal(t, big.NewInt(-7), output.Output2[1].Fields["amount"])
+
+	_, err = client.GetConfig(context.Background(), nil)
+	require.Nil(t, err)
+	assert.Empty(t, executor.request.Args)
+}
+
+func TestGeneratedClient_Deposit(t *testing.T) {
+	codec := createTestCodec(t)
+	destination := data.NewAddressFromBytes(bytes.Repeat([]byte{2}, 32))
+	client, err := NewClient(destination, &queryExecutorStub{})
+	require.Nil(t, err)
+
+	memo := []byte("memo")
+	payments := []DepositPaymentsItem{
+		{Item0: "EGLD", Item1: 0, Item2: big.NewInt(1000)},
+		{Item0: "TKN-abcdef", Item1: 5, Item2: big.NewInt(1)},
+	}
+	sender := data.NewAddressFromBytes(bytes.Repeat([]byte{3}, 32))
+	callArgs := CallArgs{
+		Sender:   sender,
+		Value:    big.NewInt(5),
+		GasLimit: 1000000,
+		NetworkConfig: &data.NetworkConfig{
+			ChainID:               "T",
+			GasPerDataByte:        1500,
+			MinGasLimit:           50000,
+			MinGasPrice:           1000000000,
+			MinTransactionVersion: 2,
+		},
+	}
+
+	_, err = client.Deposit(CallArgs{NetworkConfig: callArgs.NetworkConfig}, destination, &memo, payments)
+	assert.Equal(t, ErrNilSender, err)
+	_, err = client.Deposit(CallArgs{Sender: sender}, destination, &memo, payments)
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	tx, err := client.Deposit(callArgs, destination, &memo, payments)
+	require.Nil(t, err)
+	assert.Equal(t, sender.AddressAsBech32String(), tx.Sender)
+	assert.Equal(t, destination.AddressAsBech32String(), tx.Receiver)
+	assert.Equal(t, "5", tx.Value)
+	assert.Equal(t, uint64(50000+1500*len(tx.Data)+1000000), tx.GasLimit)
+	assert.Equal(t, uint64(1000000000), tx.GasPrice)
+	assert.Equal(t, "T", tx.ChainID)
+	assert.Equal(t, uint32(2), tx.Version)
+
+	parts := strings.Split(string(tx.Data), "@")
+	assert.Equal(t, "deposit", parts[0])
+	args := decodeHexArguments(t, parts[1:])
+	require.Len(t, args, 2+3*len(payments))
+
+	decodedDestination, err := codec.DecodeTopLevel("Address", args[0])
+	require.Nil(t, err)
+	assert.Equal(t, destination.AddressBytes(), decodedDestination.(core.AddressHandler).AddressBytes())
+	decodedMemo, err := codec.DecodeTopLevel("Option<bytes>", args[1])
+	require.Nil(t, err)
+	assert.Equal(t, memo, decodedMemo)
+
+	for i, payment := range payments {
+		token, errDecode := codec.DecodeTopLevel("EgldOrEsdtTokenIdentifier", args[2+3*i])
+		require.Nil(t, errDecode)
+		nonce, errDecode := codec.DecodeTopLevel("u64", args[3+3*i])
+		require.Nil(t, errDecode)
+		amount, errDecode := codec.DecodeTopLevel("BigUint", args[4+3*i])
+		require.Nil(t, errDecode)
+
+		assert.Equal(t, payment.Item0, token)
+		assert.Equal(t, payment.Item1, nonce)
+		assert.Equal(t, payment.Item2, amount)
+	}
+}
+
+func TestGeneratedClient_EncodeConstructorArguments(t *testing.T) {
+	args, err := EncodeConstructorArguments(big.NewInt(256))
+	require.Nil(t, err)
+	require.Len(t, args, 1)
+
+	decoded, err := createTestCodec(t).DecodeTopLevel("BigUint", args[0])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(256), decoded)
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 )
 
@@ -51,3 +52,42 @@ func BuildUrlWithAccountQueryOptions(path string, options api.AccountQueryOption
 	u.RawQuery = query.Encode()
 	return u.String()
 }
+
+// AccountQueryOptionsOnBlockNonce returns the query options selecting the state at the end of the block with the
+// provided nonce
+func AccountQueryOptionsOnBlockNonce(nonce uint64) api.AccountQueryOptions {
+	return api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{
+			Value:    nonce,
+			HasValue: true,
+		},
+	}
+}
+
+// AccountQueryOptionsOnBlockHash returns the query options selecting the state at the end of the block with the
+// provided hash
+func AccountQueryOptionsOnBlockHash(hash []byte) api.AccountQueryOptions {
+	return api.AccountQueryOptions{
+		BlockHash: hash,
+	}
+}
+
+// AccountQueryOptionsOnBlockRootHash returns the query options selecting the state with the provided root hash. The
+// hint epoch, the epoch of the block having the root hash, speeds up the lookup of the state
+func AccountQueryOptionsOnBlockRootHash(rootHash []byte, hintEpoch uint32) api.AccountQueryOptions {
+	return api.AccountQueryOptions{
+		BlockRootHash: rootHash,
+		HintEpoch: core.OptionalUint32{
+			Value:    hintEpoch,
+			HasValue: true,
+		},
+	}
+}
+
+// HasBlockCoordinates returns true if the query options select the state at a past block, instead of the latest one
+func HasBlockCoordinates(options api.AccountQueryOptions) bool {
+	return options.OnStartOfEpoch.HasValue ||
+		options.BlockNonce.HasValue ||
+		len(options.BlockHash) > 0 ||
+		len(options.BlockRootHash) > 0
+}
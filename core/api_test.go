@@ -44,3 +44,21 @@ func TestBuildUrlWithAccountQueryOptions_ShouldWork(t *testing.T) {
 	require.Equal(t, "bbaa", parsed.Query().Get("blockRootHash"))
 	require.Equal(t, "3", parsed.Query().Get("hintEpoch"))
 }
+
+func TestAccountQueryOptionsOnBlock(t *testing.T) {
+	options := AccountQueryOptionsOnBlockNonce(42)
+	require.True(t, HasBlockCoordinates(options))
+	require.Equal(t, "/address/erd1alice?blockNonce=42", BuildUrlWithAccountQueryOptions("/address/erd1alice", options))
+
+	options = AccountQueryOptionsOnBlockHash([]byte{0xab, 0xba})
+	require.True(t, HasBlockCoordinates(options))
+	require.Equal(t, "/address/erd1alice?blockHash=abba", BuildUrlWithAccountQueryOptions("/address/erd1alice", options))
+
+	options = AccountQueryOptionsOnBlockRootHash([]byte{0xba, 0xab}, 3)
+	require.True(t, HasBlockCoordinates(options))
+	require.Equal(t, "/address/erd1alice?blockRootHash=baab&hintEpoch=3", BuildUrlWithAccountQueryOptions("/address/erd1alice", options))
+
+	require.True(t, HasBlockCoordinates(api.AccountQueryOptions{OnStartOfEpoch: core.OptionalUint32{HasValue: true, Value: 1}}))
+	require.False(t, HasBlockCoordinates(api.AccountQueryOptions{}))
+	require.False(t, HasBlockCoordinates(api.AccountQueryOptions{OnFinalBlock: true}))
+}
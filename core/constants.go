@@ -3,6 +3,9 @@ package core
 // AddressBytesLen represents the number of bytes of an address
 const AddressBytesLen = 32
 
+// DefaultAddressPrefix is the default hrp of MultiversX/Elrond
+const DefaultAddressPrefix = "erd"
+
 // MinAllowedDeltaToFinal is the minimum value between nonces allowed when checking finality on a shard
 const MinAllowedDeltaToFinal = 1
 
@@ -0,0 +1,269 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// CassetteMode defines what a cassette middleware does with the requests
+type CassetteMode string
+
+const (
+	// RecordMode forwards the requests and appends each request and response pair to the cassette
+	RecordMode CassetteMode = "record"
+	// ReplayMode answers the requests from the cassette, without forwarding them
+	ReplayMode CassetteMode = "replay"
+)
+
+const cassetteFilePermissions = 0644
+
+// Interaction is a request and response pair stored in a cassette. The JSON format is the one of the fake gateway
+// fixtures, so a recorded cassette can also be served by a local HTTP server. The bodies that are not valid JSON are
+// stored as JSON strings and flagged as text, so they are unwrapped on replay while the bodies being JSON strings
+// themselves are replayed as they were recorded
+type Interaction struct {
+	Method            string          `json:"method"`
+	Route             string          `json:"route"`
+	RequestBody       json.RawMessage `json:"requestBody,omitempty"`
+	RequestBodyIsText bool            `json:"requestBodyIsText,omitempty"`
+	StatusCode        int             `json:"statusCode"`
+	Response          json.RawMessage `json:"response"`
+	ResponseIsText    bool            `json:"responseIsText,omitempty"`
+}
+
+// Cassette holds the interactions recorded on, or replayed from, a file
+type Cassette struct {
+	mut          sync.Mutex
+	interactions []Interaction
+	numReplayed  map[string]int
+}
+
+// NewCassette creates an empty cassette, ready for recording
+func NewCassette() *Cassette {
+	return &Cassette{
+		interactions: make([]Interaction, 0),
+		numReplayed:  make(map[string]int),
+	}
+}
+
+// LoadCassette reads a cassette saved with Save
+func LoadCassette(filename string) (*Cassette, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := NewCassette()
+	err = json.Unmarshal(buff, &cassette.interactions)
+	if err != nil {
+		return nil, fmt.Errorf("%w in cassette %s: %s", ErrInvalidValue, filename, err.Error())
+	}
+	for idx := range cassette.interactions {
+		cassette.interactions[idx].RequestBody = compactJSON(cassette.interactions[idx].RequestBody)
+		cassette.interactions[idx].Response = compactJSON(cassette.interactions[idx].Response)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the recorded interactions, in the order they happened
+func (cassette *Cassette) Save(filename string) error {
+	cassette.mut.Lock()
+	buff, err := json.MarshalIndent(cassette.interactions, "", "  ")
+	cassette.mut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, buff, cassetteFilePermissions)
+}
+
+// Interactions returns the interactions held by the cassette
+func (cassette *Cassette) Interactions() []Interaction {
+	cassette.mut.Lock()
+	defer cassette.mut.Unlock()
+
+	return append(make([]Interaction, 0, len(cassette.interactions)), cassette.interactions...)
+}
+
+func (cassette *Cassette) record(method string, endpoint string, requestBody []byte, statusCode int, response []byte) {
+	rawRequestBody, requestBodyIsText := toRawJSON(requestBody)
+	rawResponse, responseIsText := toRawJSON(response)
+	interaction := Interaction{
+		Method:            method,
+		Route:             endpoint,
+		RequestBody:       rawRequestBody,
+		RequestBodyIsText: requestBodyIsText,
+		StatusCode:        statusCode,
+		Response:          rawResponse,
+		ResponseIsText:    responseIsText,
+	}
+
+	cassette.mut.Lock()
+	cassette.interactions = append(cassette.interactions, interaction)
+	cassette.mut.Unlock()
+}
+
+// replay returns the response of the recorded interaction matching the method, the endpoint and the body. The same
+// request recorded several times, like a transaction status polled until it is final, is answered with the recorded
+// responses in order, the last one being repeated once all were replayed
+func (cassette *Cassette) replay(method string, endpoint string, requestBody []byte) ([]byte, int, error) {
+	rawBody, bodyIsText := toRawJSON(requestBody)
+	body := canonicalJSON(rawBody)
+	route := strings.Trim(endpoint, "/")
+
+	cassette.mut.Lock()
+	defer cassette.mut.Unlock()
+
+	matching := make([]*Interaction, 0)
+	for idx := range cassette.interactions {
+		interaction := &cassette.interactions[idx]
+		if interaction.Method != method || strings.Trim(interaction.Route, "/") != route {
+			continue
+		}
+		if interaction.RequestBodyIsText != bodyIsText || !bytes.Equal(canonicalJSON(interaction.RequestBody), body) {
+			continue
+		}
+
+		matching = append(matching, interaction)
+	}
+	if len(matching) == 0 {
+		return nil, 0, fmt.Errorf("%w for %s %s", ErrInteractionNotFound, method, endpoint)
+	}
+
+	key := fmt.Sprintf("%s %s %t %s", method, route, bodyIsText, body)
+	idx := cassette.numReplayed[key]
+	if idx >= len(matching) {
+		idx = len(matching) - 1
+	}
+	cassette.numReplayed[key]++
+
+	return fromRawJSON(matching[idx].Response, matching[idx].ResponseIsText), matching[idx].StatusCode, nil
+}
+
+type cassetteClientWrapper struct {
+	next     ClientWrapper
+	mode     CassetteMode
+	cassette *Cassette
+}
+
+// NewCassetteMiddleware creates a middleware recording the requests on the cassette or replaying them from it. In
+// replay mode the requests are matched on the method, the endpoint and the body and are never forwarded, so the
+// wrapped client can point to any URL
+func NewCassetteMiddleware(mode CassetteMode, cassette *Cassette) (Middleware, error) {
+	if mode != RecordMode && mode != ReplayMode {
+		return nil, fmt.Errorf("%w for cassette mode: %s", ErrInvalidValue, mode)
+	}
+	if cassette == nil {
+		return nil, ErrNilCassette
+	}
+
+	return func(next ClientWrapper) ClientWrapper {
+		return &cassetteClientWrapper{
+			next:     next,
+			mode:     mode,
+			cassette: cassette,
+		}
+	}, nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint, recording or replaying it
+func (wrapper *cassetteClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	if wrapper.mode == ReplayMode {
+		return wrapper.cassette.replay(http.MethodGet, endpoint, nil)
+	}
+
+	buff, code, err := wrapper.next.GetHTTP(ctx, endpoint)
+	if err == nil {
+		wrapper.cassette.record(http.MethodGet, endpoint, nil, code, buff)
+	}
+
+	return buff, code, err
+}
+
+// PostHTTP does a POST method operation on the specified endpoint, recording or replaying it
+func (wrapper *cassetteClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	if wrapper.mode == ReplayMode {
+		return wrapper.cassette.replay(http.MethodPost, endpoint, data)
+	}
+
+	buff, code, err := wrapper.next.PostHTTP(ctx, endpoint, data)
+	if err == nil {
+		wrapper.cassette.record(http.MethodPost, endpoint, data, code, buff)
+	}
+
+	return buff, code, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *cassetteClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
+
+// toRawJSON returns the body as stored in the cassette and whether it was wrapped in a JSON string
+func toRawJSON(buff []byte) (json.RawMessage, bool) {
+	if len(buff) == 0 {
+		return nil, false
+	}
+	if json.Valid(buff) {
+		return compactJSON(buff), false
+	}
+
+	encoded, _ := json.Marshal(string(buff))
+	return encoded, true
+}
+
+func fromRawJSON(raw json.RawMessage, isText bool) []byte {
+	if isText {
+		var text string
+		err := json.Unmarshal(raw, &text)
+		if err == nil {
+			return []byte(text)
+		}
+	}
+
+	return append(make([]byte, 0, len(raw)), raw...)
+}
+
+// canonicalJSON re-encodes the JSON with the object keys sorted, so the bodies written by hand in a different fields
+// order still match
+func canonicalJSON(buff []byte) []byte {
+	if len(buff) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return buff
+	}
+
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return buff
+	}
+
+	return canonical
+}
+
+func compactJSON(buff []byte) []byte {
+	if len(buff) == 0 {
+		return nil
+	}
+
+	compacted := bytes.NewBuffer(nil)
+	err := json.Compact(compacted, buff)
+	if err != nil {
+		return buff
+	}
+
+	return compacted.Bytes()
+}
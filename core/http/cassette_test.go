@@ -0,0 +1,144 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCassetteMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCassetteMiddleware("rewind", NewCassette())
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("nil cassette should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCassetteMiddleware(RecordMode, nil)
+		assert.Nil(t, middleware)
+		assert.Equal(t, ErrNilCassette, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCassetteMiddleware(ReplayMode, NewCassette())
+		assert.NotNil(t, middleware)
+		assert.Nil(t, err)
+	})
+}
+
+func TestCassetteMiddleware_RecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	numStatusCalls := 0
+	stub := &testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			switch endpoint {
+			case "transaction/aabb/status":
+				numStatusCalls++
+				if numStatusCalls == 1 {
+					return []byte(`{"data":{"status":"pending"}}`), http.StatusOK, nil
+				}
+				return []byte(`{"data":{"status":"success"}}`), http.StatusOK, nil
+			case "network/config":
+				return []byte("bad gateway"), http.StatusBadGateway, nil
+			case "network/status/0":
+				return []byte(`"a JSON string"`), http.StatusOK, nil
+			default:
+				return nil, 0, errors.New("connection refused")
+			}
+		},
+		PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+			return append([]byte(`{"data":`), append(data, '}')...), http.StatusOK, nil
+		},
+	}
+
+	cassette := NewCassette()
+	recordMiddleware, _ := NewCassetteMiddleware(RecordMode, cassette)
+	recorder := recordMiddleware(stub)
+
+	_, _, _ = recorder.GetHTTP(context.Background(), "transaction/aabb/status")
+	_, _, _ = recorder.GetHTTP(context.Background(), "transaction/aabb/status")
+	_, _, _ = recorder.GetHTTP(context.Background(), "network/config")
+	_, _, _ = recorder.GetHTTP(context.Background(), "network/status/0")
+	_, _, err := recorder.GetHTTP(context.Background(), "address/unreachable")
+	assert.NotNil(t, err)
+	_, _, _ = recorder.PostHTTP(context.Background(), "vm-values/query", []byte(`{"funcName": "first"}`))
+	_, _, _ = recorder.PostHTTP(context.Background(), "vm-values/query", []byte(`{"funcName": "second"}`))
+	require.Len(t, cassette.Interactions(), 6, "the failed requests should not be recorded")
+
+	filename := filepath.Join(t.TempDir(), "cassette.json")
+	require.Nil(t, cassette.Save(filename))
+	loaded, err := LoadCassette(filename)
+	require.Nil(t, err)
+	assert.Equal(t, cassette.Interactions(), loaded.Interactions())
+
+	replayMiddleware, _ := NewCassetteMiddleware(ReplayMode, loaded)
+	player := replayMiddleware(&testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			assert.Fail(t, "should not forward requests in replay mode")
+			return nil, 0, nil
+		},
+	})
+
+	t.Run("same request should replay the responses in order", func(t *testing.T) {
+		expectedResponses := []string{
+			`{"data":{"status":"pending"}}`,
+			`{"data":{"status":"success"}}`,
+			`{"data":{"status":"success"}}`,
+		}
+		endpoints := []string{
+			"transaction/aabb/status",
+			"/transaction/aabb/status",
+			"transaction/aabb/status/",
+		}
+		for idx, expected := range expectedResponses {
+			buff, code, errGet := player.GetHTTP(context.Background(), endpoints[idx])
+			require.Nil(t, errGet)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, expected, string(buff))
+		}
+	})
+	t.Run("non JSON response should be replayed as it is", func(t *testing.T) {
+		buff, code, errGet := player.GetHTTP(context.Background(), "network/config")
+		require.Nil(t, errGet)
+		assert.Equal(t, http.StatusBadGateway, code)
+		assert.Equal(t, "bad gateway", string(buff))
+	})
+	t.Run("JSON string response should be replayed as it was recorded", func(t *testing.T) {
+		buff, code, errGet := player.GetHTTP(context.Background(), "network/status/0")
+		require.Nil(t, errGet)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, `"a JSON string"`, string(buff))
+	})
+	t.Run("request body should select the interaction", func(t *testing.T) {
+		buff, _, errPost := player.PostHTTP(context.Background(), "vm-values/query", []byte(`{"funcName":"second"}`))
+		require.Nil(t, errPost)
+		assert.Equal(t, `{"data":{"funcName":"second"}}`, string(buff))
+
+		_, _, errPost = player.PostHTTP(context.Background(), "vm-values/query", []byte(`{"funcName":"third"}`))
+		assert.True(t, errors.Is(errPost, ErrInteractionNotFound))
+	})
+	t.Run("unknown request should error", func(t *testing.T) {
+		_, _, errGet := player.GetHTTP(context.Background(), "address/unreachable")
+		assert.True(t, errors.Is(errGet, ErrInteractionNotFound))
+	})
+}
+
+func TestLoadCassette(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadCassette(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+}
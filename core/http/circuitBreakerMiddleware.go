@@ -0,0 +1,143 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ArgsCircuitBreaker is the DTO used in the circuit breaker middleware constructor
+type ArgsCircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit
+	FailureThreshold int
+	// OpenDuration is the time the requests are rejected before a trial request is let through
+	OpenDuration time.Duration
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuitBreakerClientWrapper struct {
+	next             ClientWrapper
+	failureThreshold int
+	openDuration     time.Duration
+
+	mut         sync.Mutex
+	state       circuitState
+	numFailures int
+	openedAt    time.Time
+	getTimeFunc func() time.Time
+}
+
+// NewCircuitBreakerMiddleware creates a middleware rejecting the requests with ErrCircuitOpen for a while after a
+// number of consecutive failures (transport errors or 5xx status codes). Once the open duration elapses, one trial
+// request is let through: its success closes the circuit, its failure opens it again. Each client wrapper the
+// middleware is applied on gets its own circuit
+func NewCircuitBreakerMiddleware(args ArgsCircuitBreaker) (Middleware, error) {
+	if args.FailureThreshold < 1 {
+		return nil, fmt.Errorf("%w for FailureThreshold", ErrInvalidValue)
+	}
+	if args.OpenDuration <= 0 {
+		return nil, fmt.Errorf("%w for OpenDuration", ErrInvalidValue)
+	}
+
+	return func(next ClientWrapper) ClientWrapper {
+		return &circuitBreakerClientWrapper{
+			next:             next,
+			failureThreshold: args.FailureThreshold,
+			openDuration:     args.OpenDuration,
+			getTimeFunc:      time.Now,
+		}
+	}, nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint if the circuit allows it
+func (wrapper *circuitBreakerClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.do(ctx, func(ctx context.Context) ([]byte, int, error) {
+		return wrapper.next.GetHTTP(ctx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the specified endpoint if the circuit allows it
+func (wrapper *circuitBreakerClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return wrapper.do(ctx, func(ctx context.Context) ([]byte, int, error) {
+		return wrapper.next.PostHTTP(ctx, endpoint, data)
+	})
+}
+
+func (wrapper *circuitBreakerClientWrapper) do(ctx context.Context, request requestFunc) ([]byte, int, error) {
+	if !wrapper.allow() {
+		return nil, http.StatusServiceUnavailable, ErrCircuitOpen
+	}
+
+	buff, code, err := request(ctx)
+	if ctx.Err() != nil {
+		// the caller gave up, the endpoint is not to blame
+		wrapper.abort()
+		return buff, code, err
+	}
+
+	wrapper.record(err == nil && code < http.StatusInternalServerError)
+
+	return buff, code, err
+}
+
+func (wrapper *circuitBreakerClientWrapper) allow() bool {
+	wrapper.mut.Lock()
+	defer wrapper.mut.Unlock()
+
+	switch wrapper.state {
+	case circuitOpen:
+		if wrapper.getTimeFunc().Sub(wrapper.openedAt) < wrapper.openDuration {
+			return false
+		}
+		wrapper.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// a trial request is already in flight
+		return false
+	default:
+		return true
+	}
+}
+
+func (wrapper *circuitBreakerClientWrapper) record(success bool) {
+	wrapper.mut.Lock()
+	defer wrapper.mut.Unlock()
+
+	if success {
+		wrapper.state = circuitClosed
+		wrapper.numFailures = 0
+		return
+	}
+
+	wrapper.numFailures++
+	if wrapper.state == circuitHalfOpen || wrapper.numFailures >= wrapper.failureThreshold {
+		if wrapper.state != circuitOpen {
+			log.Debug("circuitBreakerClientWrapper: opening the circuit", "consecutive failures", wrapper.numFailures)
+		}
+		wrapper.state = circuitOpen
+		wrapper.openedAt = wrapper.getTimeFunc()
+	}
+}
+
+// abort lets another trial request through if the aborted one was the trial
+func (wrapper *circuitBreakerClientWrapper) abort() {
+	wrapper.mut.Lock()
+	if wrapper.state == circuitHalfOpen {
+		wrapper.state = circuitOpen
+	}
+	wrapper.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *circuitBreakerClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
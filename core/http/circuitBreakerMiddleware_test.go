@@ -0,0 +1,102 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCircuitBreakerMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid failure threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{FailureThreshold: 0, OpenDuration: time.Second})
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid open duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{FailureThreshold: 1, OpenDuration: 0})
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{FailureThreshold: 1, OpenDuration: time.Second})
+		assert.NotNil(t, middleware)
+		assert.Nil(t, err)
+	})
+}
+
+func TestCircuitBreakerClientWrapper_States(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{FailureThreshold: 2, OpenDuration: time.Minute})
+
+	numCalls := 0
+	code := http.StatusInternalServerError
+	wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			numCalls++
+			return nil, code, nil
+		},
+	}).(*circuitBreakerClientWrapper)
+	currentTime := time.Now()
+	wrapper.getTimeFunc = func() time.Time {
+		return currentTime
+	}
+
+	_, _, err := wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	_, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numCalls)
+
+	_, responseCode, err := wrapper.PostHTTP(context.Background(), "endpoint", nil)
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Equal(t, http.StatusServiceUnavailable, responseCode)
+	assert.Equal(t, 2, numCalls)
+
+	// failed trial request opens the circuit again
+	currentTime = currentTime.Add(time.Minute)
+	_, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, numCalls)
+	_, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Equal(t, ErrCircuitOpen, err)
+
+	// successful trial request closes the circuit
+	currentTime = currentTime.Add(time.Minute)
+	code = http.StatusOK
+	_, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	_, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, numCalls)
+}
+
+func TestCircuitBreakerClientWrapper_ClientErrorsShouldNotOpenTheCircuit(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{FailureThreshold: 1, OpenDuration: time.Minute})
+	wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			return nil, http.StatusNotFound, nil
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+	}
+}
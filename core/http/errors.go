@@ -0,0 +1,27 @@
+package http
+
+import "errors"
+
+// ErrNoEndpoints signals that no endpoints were provided
+var ErrNoEndpoints = errors.New("no endpoints provided")
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNoEndpointForShard signals that none of the endpoints can serve the requested shard
+var ErrNoEndpointForShard = errors.New("no endpoint for shard")
+
+// ErrCircuitOpen signals that the requests are rejected because the circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrNilCassette signals that a nil cassette was provided
+var ErrNilCassette = errors.New("nil cassette")
+
+// ErrInteractionNotFound signals that the cassette holds no interaction matching the request
+var ErrInteractionNotFound = errors.New("interaction not found in cassette")
@@ -0,0 +1,95 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const (
+	addressPlaceholder = ":address"
+	hashPlaceholder    = ":hash"
+	numberPlaceholder  = ":number"
+	tokenPlaceholder   = ":token"
+)
+
+var (
+	addressRegex = regexp.MustCompile(`^[a-z]+1[02-9ac-hj-np-z]{58}$`)
+	hashRegex    = regexp.MustCompile(`^[0-9a-fA-F]{32,}$`)
+	numberRegex  = regexp.MustCompile(`^[0-9]+$`)
+	tokenRegex   = regexp.MustCompile(`^[A-Z0-9]{3,10}-[0-9a-f]{6}(-[0-9a-f]+)?$`)
+)
+
+type metricsClientWrapper struct {
+	next           ClientWrapper
+	metricsHandler core.MetricsHandler
+}
+
+// NewMetricsMiddleware creates a middleware reporting the latency, the status code and the error of each request to
+// the metrics handler. The endpoints are reported as routes, the addresses, hashes, numbers and token identifiers
+// being replaced by placeholders so the number of distinct endpoints stays bounded
+func NewMetricsMiddleware(metricsHandler core.MetricsHandler) (Middleware, error) {
+	if check.IfNil(metricsHandler) {
+		return nil, ErrNilMetricsHandler
+	}
+
+	return func(next ClientWrapper) ClientWrapper {
+		return &metricsClientWrapper{
+			next:           next,
+			metricsHandler: metricsHandler,
+		}
+	}, nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint and reports it
+func (wrapper *metricsClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.observe(http.MethodGet, endpoint, func() ([]byte, int, error) {
+		return wrapper.next.GetHTTP(ctx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the specified endpoint and reports it
+func (wrapper *metricsClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return wrapper.observe(http.MethodPost, endpoint, func() ([]byte, int, error) {
+		return wrapper.next.PostHTTP(ctx, endpoint, data)
+	})
+}
+
+func (wrapper *metricsClientWrapper) observe(method string, endpoint string, request func() ([]byte, int, error)) ([]byte, int, error) {
+	start := time.Now()
+	buff, code, err := request()
+	wrapper.metricsHandler.ObserveRequest(method, NormalizeEndpoint(endpoint), code, time.Since(start), err)
+
+	return buff, code, err
+}
+
+// NormalizeEndpoint returns the route of the endpoint, without the query parameters and with the addresses, hashes,
+// numbers and token identifiers replaced by placeholders
+func NormalizeEndpoint(endpoint string) string {
+	path := strings.SplitN(endpoint, "?", 2)[0]
+	segments := strings.Split(path, "/")
+	for idx, segment := range segments {
+		switch {
+		case addressRegex.MatchString(segment):
+			segments[idx] = addressPlaceholder
+		case numberRegex.MatchString(segment):
+			segments[idx] = numberPlaceholder
+		case hashRegex.MatchString(segment):
+			segments[idx] = hashPlaceholder
+		case tokenRegex.MatchString(segment):
+			segments[idx] = tokenPlaceholder
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *metricsClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
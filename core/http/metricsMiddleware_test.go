@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewMetricsMiddleware(nil)
+		assert.Nil(t, middleware)
+		assert.Equal(t, ErrNilMetricsHandler, err)
+	})
+	t.Run("should report the requests", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		reported := make([]string, 0)
+		middleware, err := NewMetricsMiddleware(&testsCommon.MetricsHandlerStub{
+			ObserveRequestCalled: func(method string, endpoint string, statusCode int, duration time.Duration, err error) {
+				reported = append(reported, method+" "+endpoint)
+				if method == http.MethodPost {
+					assert.Equal(t, http.StatusBadRequest, statusCode)
+					assert.Equal(t, expectedErr, err)
+				}
+			},
+		})
+		assert.Nil(t, err)
+
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		})
+		_, _, _ = wrapper.GetHTTP(context.Background(), "address/erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th/nonce")
+		_, _, _ = wrapper.PostHTTP(context.Background(), "transaction/send", nil)
+
+		assert.Equal(t, []string{"GET address/:address/nonce", "POST transaction/send"}, reported)
+	})
+}
+
+func TestNormalizeEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"network/config":            "network/config",
+		"network/status/4294967295": "network/status/:number",
+		"transaction/7e5e5e0e1e6e8e1f3a39aa0ce1bbda6a74d8fb7d3ee3a5d32ab4a7f5ef4a3e6e?withResults=true": "transaction/:hash",
+		"address/erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th/esdt/WEGLD-bd4d79":      "address/:address/esdt/:token",
+		"address/erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th/nft/NFT-abcdef/nonce/7": "address/:address/nft/:token/nonce/:number",
+	}
+	for endpoint, expected := range testCases {
+		assert.Equal(t, expected, NormalizeEndpoint(endpoint), endpoint)
+	}
+}
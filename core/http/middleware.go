@@ -0,0 +1,42 @@
+package http
+
+import (
+	"context"
+	"time"
+)
+
+// ClientWrapper defines the HTTP operations done by a client wrapper
+type ClientWrapper interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
+
+// Middleware decorates a client wrapper with an extra policy such as retries or rate limiting. A middleware creates
+// a new state (token bucket, circuit breaker) each time it is applied, so applying it on the wrappers of different
+// hosts keeps their states independent
+type Middleware func(next ClientWrapper) ClientWrapper
+
+// ApplyMiddlewares decorates the provided client wrapper with the middlewares, the first one being the outermost
+func ApplyMiddlewares(wrapper ClientWrapper, middlewares ...Middleware) ClientWrapper {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		wrapper = middlewares[i](wrapper)
+	}
+
+	return wrapper
+}
+
+// DefaultMiddlewares returns a circuit breaker opening for 10 seconds after 5 consecutive failed requests on top of
+// the retry policy returned by DefaultRetryPolicy. The retries happen inside the circuit breaker, so a request
+// failing after all its retries counts as a single failure
+func DefaultMiddlewares() []Middleware {
+	retryMiddleware, _ := NewRetryMiddleware(DefaultRetryPolicy())
+	circuitBreakerMiddleware, _ := NewCircuitBreakerMiddleware(ArgsCircuitBreaker{
+		FailureThreshold: 5,
+		OpenDuration:     time.Second * 10,
+	})
+
+	return []Middleware{circuitBreakerMiddleware, retryMiddleware}
+}
+
+type requestFunc func(ctx context.Context) ([]byte, int, error)
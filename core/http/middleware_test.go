@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyMiddlewares(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	createMiddleware := func(name string) Middleware {
+		return func(next ClientWrapper) ClientWrapper {
+			return &testsCommon.HTTPClientWrapperStub{
+				GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+					calls = append(calls, name)
+					return next.GetHTTP(ctx, endpoint)
+				},
+			}
+		}
+	}
+	base := &testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			calls = append(calls, "base")
+			return nil, http.StatusOK, nil
+		},
+	}
+
+	wrapper := ApplyMiddlewares(base, createMiddleware("outer"), createMiddleware("inner"))
+	_, _, _ = wrapper.GetHTTP(context.Background(), "endpoint")
+	assert.Equal(t, []string{"outer", "inner", "base"}, calls)
+
+	assert.Equal(t, base, ApplyMiddlewares(base))
+	assert.Equal(t, 2, len(DefaultMiddlewares()))
+}
+
+func TestDefaultMiddlewares_RetriesShouldCountAsOneCircuitBreakerFailure(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	base := &testsCommon.HTTPClientWrapperStub{
+		GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+			numCalls++
+			return nil, http.StatusInternalServerError, nil
+		},
+	}
+	wrapper := ApplyMiddlewares(base, DefaultMiddlewares()...)
+
+	// each logical request is tried 4 times, so the 2 failed requests would have opened the circuit if every
+	// attempt were counted as a failure
+	for i := 0; i < 2; i++ {
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, code)
+	}
+	assert.Equal(t, 8, numCalls)
+}
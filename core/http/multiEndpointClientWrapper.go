@@ -0,0 +1,330 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/core/http")
+
+// SelectionStrategy defines how the endpoint serving a request is chosen among the healthy ones
+type SelectionStrategy string
+
+const (
+	// RoundRobinSelection cycles through the healthy endpoints
+	RoundRobinSelection SelectionStrategy = "round-robin"
+	// LatencySelection prefers the healthy endpoint with the lowest average response time
+	LatencySelection SelectionStrategy = "latency"
+)
+
+// latencyWeight is the weight of the latest response time in the moving average of an endpoint's latency
+const latencyWeight = 0.2
+
+// EndpointConfig holds the configuration of a gateway or observer endpoint
+type EndpointConfig struct {
+	URL string
+	// ShardIDs lists the shards served by an observer. An empty list marks an endpoint able to serve all shards,
+	// such as a gateway
+	ShardIDs []uint32
+}
+
+// ArgsMultiEndpointClientWrapper is the DTO used in the multi-endpoint client wrapper constructor
+type ArgsMultiEndpointClientWrapper struct {
+	Client    Client
+	Endpoints []EndpointConfig
+	Strategy  SelectionStrategy
+	// RequestTimeout bounds each attempt so a hanging endpoint can be failed over, 0 meaning no bound
+	RequestTimeout time.Duration
+	// HealthCheckInterval is the interval between the health checks, 0 disabling them
+	HealthCheckInterval time.Duration
+	HealthCheckEndpoint string
+	// Middlewares are applied on each endpoint, the health checks bypassing them
+	Middlewares []Middleware
+}
+
+type endpointState struct {
+	url      string
+	shardIDs map[uint32]struct{}
+	wrapper  ClientWrapper
+	direct   *clientWrapper
+
+	mut     sync.RWMutex
+	healthy bool
+	latency time.Duration
+}
+
+type multiEndpointClientWrapper struct {
+	endpoints           []*endpointState
+	strategy            SelectionStrategy
+	requestTimeout      time.Duration
+	healthCheckEndpoint string
+	counter             uint64
+	cancel              func()
+}
+
+// NewMultiEndpointClientWrapper creates a client wrapper spreading the requests over multiple endpoints. A request
+// failing with a transport error, a timeout or a 5xx status code is retried on the next endpoint and the failing
+// endpoint is marked as unhealthy until the next successful health check or request. Requests made with a context
+// returned by WithShardID are routed to the observers serving that shard first, the other ones being routed to the
+// endpoints serving all the shards first
+func NewMultiEndpointClientWrapper(args ArgsMultiEndpointClientWrapper) (*multiEndpointClientWrapper, error) {
+	err := checkArgsMultiEndpointClientWrapper(args)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper := &multiEndpointClientWrapper{
+		endpoints:           make([]*endpointState, 0, len(args.Endpoints)),
+		strategy:            args.Strategy,
+		requestTimeout:      args.RequestTimeout,
+		healthCheckEndpoint: args.HealthCheckEndpoint,
+		cancel:              func() {},
+	}
+	for _, config := range args.Endpoints {
+		direct := NewHttpClientWrapper(args.Client, config.URL)
+		state := &endpointState{
+			url:      config.URL,
+			shardIDs: make(map[uint32]struct{}, len(config.ShardIDs)),
+			wrapper:  ApplyMiddlewares(direct, args.Middlewares...),
+			direct:   direct,
+			healthy:  true,
+		}
+		for _, shardID := range config.ShardIDs {
+			state.shardIDs[shardID] = struct{}{}
+		}
+		wrapper.endpoints = append(wrapper.endpoints, state)
+	}
+
+	if args.HealthCheckInterval > 0 {
+		var ctx context.Context
+		ctx, wrapper.cancel = context.WithCancel(context.Background())
+		go wrapper.healthCheckLoop(ctx, args.HealthCheckInterval)
+	}
+
+	return wrapper, nil
+}
+
+func checkArgsMultiEndpointClientWrapper(args ArgsMultiEndpointClientWrapper) error {
+	if len(args.Endpoints) == 0 {
+		return ErrNoEndpoints
+	}
+	for idx, config := range args.Endpoints {
+		if len(config.URL) == 0 {
+			return fmt.Errorf("%w at index %d", ErrEmptyURL, idx)
+		}
+	}
+	if args.Strategy != RoundRobinSelection && args.Strategy != LatencySelection {
+		return fmt.Errorf("%w for Strategy: %s", ErrInvalidValue, args.Strategy)
+	}
+	if args.RequestTimeout < 0 {
+		return fmt.Errorf("%w for RequestTimeout", ErrInvalidValue)
+	}
+	if args.HealthCheckInterval < 0 {
+		return fmt.Errorf("%w for HealthCheckInterval", ErrInvalidValue)
+	}
+	if args.HealthCheckInterval > 0 && len(args.HealthCheckEndpoint) == 0 {
+		return fmt.Errorf("%w for HealthCheckEndpoint", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint, failing over to the next endpoint on errors
+func (wrapper *multiEndpointClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.doWithFailover(ctx, func(ctx context.Context, state *endpointState) ([]byte, int, error) {
+		return state.wrapper.GetHTTP(ctx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the specified endpoint, failing over to the next endpoint on errors
+func (wrapper *multiEndpointClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return wrapper.doWithFailover(ctx, func(ctx context.Context, state *endpointState) ([]byte, int, error) {
+		return state.wrapper.PostHTTP(ctx, endpoint, data)
+	})
+}
+
+type requestHandler func(ctx context.Context, state *endpointState) ([]byte, int, error)
+
+func (wrapper *multiEndpointClientWrapper) doWithFailover(ctx context.Context, handler requestHandler) ([]byte, int, error) {
+	candidates, err := wrapper.selectCandidates(ctx)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	var buff []byte
+	var code int
+	for _, state := range candidates {
+		buff, code, err = wrapper.doRequest(ctx, state, handler)
+		if ctx.Err() != nil {
+			return buff, code, err
+		}
+		if err == nil && code < http.StatusInternalServerError {
+			state.setHealthy(true)
+			return buff, code, nil
+		}
+
+		log.Debug("multiEndpointClientWrapper: endpoint failed, trying the next one",
+			"url", state.url, "status code", code, "error", err)
+		state.setHealthy(false)
+	}
+
+	return buff, code, err
+}
+
+func (wrapper *multiEndpointClientWrapper) doRequest(ctx context.Context, state *endpointState, handler requestHandler) ([]byte, int, error) {
+	if wrapper.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, wrapper.requestTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	buff, code, err := handler(ctx, state)
+	if err == nil {
+		state.addLatency(time.Since(start))
+	}
+
+	return buff, code, err
+}
+
+// selectCandidates returns the endpoints in the order they should be tried and the healthy ones before the unhealthy
+// ones. A pinned request is routed to the observers dedicated to its shard before the generic endpoints, while a
+// request not pinned to a shard is routed to the generic endpoints before the observers, which can only serve the
+// data not bound to a shard, such as the network configuration
+func (wrapper *multiEndpointClientWrapper) selectCandidates(ctx context.Context) ([]*endpointState, error) {
+	shardID, isPinned := ShardIDFromContext(ctx)
+
+	groups := make([][]*endpointState, 4)
+	for _, state := range wrapper.endpoints {
+		groupIndex := 0
+		isGeneric := len(state.shardIDs) == 0
+		if isPinned {
+			_, servesShard := state.shardIDs[shardID]
+			if !servesShard && !isGeneric {
+				continue
+			}
+			if isGeneric {
+				groupIndex = 1
+			}
+		} else if !isGeneric {
+			groupIndex = 1
+		}
+		if !state.isHealthy() {
+			groupIndex += 2
+		}
+
+		groups[groupIndex] = append(groups[groupIndex], state)
+	}
+
+	candidates := make([]*endpointState, 0, len(wrapper.endpoints))
+	for idx, group := range groups {
+		if idx < 2 {
+			group = wrapper.orderByStrategy(group)
+		}
+		candidates = append(candidates, group...)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w %d", ErrNoEndpointForShard, shardID)
+	}
+
+	return candidates, nil
+}
+
+func (wrapper *multiEndpointClientWrapper) orderByStrategy(group []*endpointState) []*endpointState {
+	if len(group) < 2 {
+		return group
+	}
+
+	if wrapper.strategy == LatencySelection {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].getLatency() < group[j].getLatency()
+		})
+		return group
+	}
+
+	offset := int(atomic.AddUint64(&wrapper.counter, 1) % uint64(len(group)))
+	ordered := make([]*endpointState, 0, len(group))
+	ordered = append(ordered, group[offset:]...)
+
+	return append(ordered, group[:offset]...)
+}
+
+func (wrapper *multiEndpointClientWrapper) healthCheckLoop(ctx context.Context, interval time.Duration) {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			wrapper.checkEndpoints(ctx)
+			timer.Reset(interval)
+		}
+	}
+}
+
+func (wrapper *multiEndpointClientWrapper) checkEndpoints(ctx context.Context) {
+	for _, state := range wrapper.endpoints {
+		_, code, err := wrapper.doRequest(ctx, state, func(ctx context.Context, state *endpointState) ([]byte, int, error) {
+			return state.direct.GetHTTP(ctx, wrapper.healthCheckEndpoint)
+		})
+		isHealthy := err == nil && code == http.StatusOK
+		if !isHealthy {
+			log.Debug("multiEndpointClientWrapper: health check failed", "url", state.url, "status code", code, "error", err)
+		}
+
+		state.setHealthy(isHealthy)
+	}
+}
+
+// Close stops the health checks
+func (wrapper *multiEndpointClientWrapper) Close() error {
+	wrapper.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *multiEndpointClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
+
+func (state *endpointState) isHealthy() bool {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return state.healthy
+}
+
+func (state *endpointState) setHealthy(healthy bool) {
+	state.mut.Lock()
+	state.healthy = healthy
+	state.mut.Unlock()
+}
+
+func (state *endpointState) getLatency() time.Duration {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return state.latency
+}
+
+func (state *endpointState) addLatency(latency time.Duration) {
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	if state.latency == 0 {
+		state.latency = latency
+		return
+	}
+
+	state.latency = time.Duration(float64(state.latency)*(1-latencyWeight) + float64(latency)*latencyWeight)
+}
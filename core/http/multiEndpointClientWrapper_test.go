@@ -0,0 +1,261 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEndpoint struct {
+	server   *httptest.Server
+	numCalls int32
+	status   int32
+	delay    time.Duration
+}
+
+func newTestEndpoint(status int) *testEndpoint {
+	endpoint := &testEndpoint{status: int32(status)}
+	endpoint.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&endpoint.numCalls, 1)
+		time.Sleep(endpoint.delay)
+		rw.WriteHeader(int(atomic.LoadInt32(&endpoint.status)))
+		_, _ = rw.Write([]byte(endpoint.server.URL))
+	}))
+
+	return endpoint
+}
+
+func (endpoint *testEndpoint) calls() int32 {
+	return atomic.LoadInt32(&endpoint.numCalls)
+}
+
+func createMockArgsMultiEndpointClientWrapper(endpoints ...EndpointConfig) ArgsMultiEndpointClientWrapper {
+	return ArgsMultiEndpointClientWrapper{
+		Endpoints:      endpoints,
+		Strategy:       RoundRobinSelection,
+		RequestTimeout: time.Second,
+	}
+}
+
+func TestNewMultiEndpointClientWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper())
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, ErrNoEndpoints, err)
+	})
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(EndpointConfig{}))
+		assert.True(t, check.IfNil(wrapper))
+		assert.True(t, errors.Is(err, ErrEmptyURL))
+	})
+	t.Run("invalid strategy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: "http://localhost"})
+		args.Strategy = "random"
+		wrapper, err := NewMultiEndpointClientWrapper(args)
+		assert.True(t, check.IfNil(wrapper))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("health checks without endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: "http://localhost"})
+		args.HealthCheckInterval = time.Second
+		wrapper, err := NewMultiEndpointClientWrapper(args)
+		assert.True(t, check.IfNil(wrapper))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: "http://localhost"}))
+		assert.False(t, check.IfNil(wrapper))
+		assert.Nil(t, err)
+		assert.Nil(t, wrapper.Close())
+	})
+}
+
+func TestMultiEndpointClientWrapper_RoundRobin(t *testing.T) {
+	t.Parallel()
+
+	first := newTestEndpoint(http.StatusOK)
+	defer first.server.Close()
+	second := newTestEndpoint(http.StatusOK)
+	defer second.server.Close()
+
+	wrapper, _ := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(
+		EndpointConfig{URL: first.server.URL},
+		EndpointConfig{URL: second.server.URL},
+	))
+
+	for i := 0; i < 4; i++ {
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	}
+	assert.Equal(t, int32(2), first.calls())
+	assert.Equal(t, int32(2), second.calls())
+}
+
+func TestMultiEndpointClientWrapper_Failover(t *testing.T) {
+	t.Parallel()
+
+	t.Run("5xx should fail over and mark the endpoint as unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		failing := newTestEndpoint(http.StatusBadGateway)
+		defer failing.server.Close()
+		working := newTestEndpoint(http.StatusOK)
+		defer working.server.Close()
+
+		wrapper, _ := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(
+			EndpointConfig{URL: failing.server.URL},
+			EndpointConfig{URL: working.server.URL},
+		))
+
+		for i := 0; i < 3; i++ {
+			buff, code, err := wrapper.PostHTTP(context.Background(), "endpoint", []byte("data"))
+			require.Nil(t, err)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, working.server.URL, string(buff))
+		}
+		assert.Equal(t, int32(1), failing.calls(), "the unhealthy endpoint should not be tried first anymore")
+	})
+	t.Run("4xx should not fail over", func(t *testing.T) {
+		t.Parallel()
+
+		notFound := newTestEndpoint(http.StatusNotFound)
+		defer notFound.server.Close()
+		working := newTestEndpoint(http.StatusOK)
+		defer working.server.Close()
+
+		args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: notFound.server.URL}, EndpointConfig{URL: working.server.URL})
+		args.Strategy = LatencySelection
+		wrapper, _ := NewMultiEndpointClientWrapper(args)
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, int32(0), working.calls())
+	})
+	t.Run("timeout should fail over", func(t *testing.T) {
+		t.Parallel()
+
+		slow := newTestEndpoint(http.StatusOK)
+		slow.delay = time.Millisecond * 200
+		defer slow.server.Close()
+		working := newTestEndpoint(http.StatusOK)
+		defer working.server.Close()
+
+		args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: slow.server.URL}, EndpointConfig{URL: working.server.URL})
+		args.Strategy = LatencySelection
+		args.RequestTimeout = time.Millisecond * 50
+		wrapper, _ := NewMultiEndpointClientWrapper(args)
+
+		buff, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, working.server.URL, string(buff))
+	})
+	t.Run("all endpoints failing should return the last error", func(t *testing.T) {
+		t.Parallel()
+
+		first := newTestEndpoint(http.StatusServiceUnavailable)
+		defer first.server.Close()
+		second := newTestEndpoint(http.StatusInternalServerError)
+		defer second.server.Close()
+
+		args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: first.server.URL}, EndpointConfig{URL: second.server.URL})
+		args.Strategy = LatencySelection
+		wrapper, _ := NewMultiEndpointClientWrapper(args)
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, int32(1), first.calls())
+		assert.Equal(t, int32(1), second.calls())
+	})
+}
+
+func TestMultiEndpointClientWrapper_ShardRouting(t *testing.T) {
+	t.Parallel()
+
+	gateway := newTestEndpoint(http.StatusOK)
+	defer gateway.server.Close()
+	observerShard0 := newTestEndpoint(http.StatusOK)
+	defer observerShard0.server.Close()
+	observerShard1 := newTestEndpoint(http.StatusOK)
+	defer observerShard1.server.Close()
+
+	wrapper, _ := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(
+		EndpointConfig{URL: gateway.server.URL},
+		EndpointConfig{URL: observerShard0.server.URL, ShardIDs: []uint32{0}},
+		EndpointConfig{URL: observerShard1.server.URL, ShardIDs: []uint32{1}},
+	))
+
+	buff, _, err := wrapper.GetHTTP(WithShardID(context.Background(), 1), "endpoint")
+	require.Nil(t, err)
+	assert.Equal(t, observerShard1.server.URL, string(buff))
+
+	atomic.StoreInt32(&observerShard1.status, http.StatusInternalServerError)
+	buff, _, err = wrapper.GetHTTP(WithShardID(context.Background(), 1), "endpoint")
+	require.Nil(t, err)
+	assert.Equal(t, gateway.server.URL, string(buff), "should fall back on the generic endpoint")
+	assert.Equal(t, int32(0), observerShard0.calls())
+
+	t.Run("not pinned requests should prefer the generic endpoints", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			buff, _, err = wrapper.GetHTTP(context.Background(), "endpoint")
+			require.Nil(t, err)
+			assert.Equal(t, gateway.server.URL, string(buff))
+		}
+		assert.Equal(t, int32(0), observerShard0.calls())
+	})
+	t.Run("no endpoint for shard should error", func(t *testing.T) {
+		observersOnly, _ := NewMultiEndpointClientWrapper(createMockArgsMultiEndpointClientWrapper(
+			EndpointConfig{URL: observerShard0.server.URL, ShardIDs: []uint32{0}},
+		))
+
+		_, _, err = observersOnly.GetHTTP(WithShardID(context.Background(), 2), "endpoint")
+		assert.True(t, errors.Is(err, ErrNoEndpointForShard))
+	})
+}
+
+func TestMultiEndpointClientWrapper_HealthChecks(t *testing.T) {
+	t.Parallel()
+
+	endpoint := newTestEndpoint(http.StatusInternalServerError)
+	defer endpoint.server.Close()
+
+	args := createMockArgsMultiEndpointClientWrapper(EndpointConfig{URL: endpoint.server.URL})
+	args.HealthCheckInterval = time.Millisecond * 10
+	args.HealthCheckEndpoint = "network/config"
+	wrapper, _ := NewMultiEndpointClientWrapper(args)
+	defer func() {
+		_ = wrapper.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return !wrapper.endpoints[0].isHealthy()
+	}, time.Second, time.Millisecond*5)
+
+	atomic.StoreInt32(&endpoint.status, http.StatusOK)
+	require.Eventually(t, func() bool {
+		return wrapper.endpoints[0].isHealthy()
+	}, time.Second, time.Millisecond*5)
+}
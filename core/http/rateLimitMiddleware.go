@@ -0,0 +1,115 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ArgsRateLimiter is the DTO used in the rate limit middleware constructor
+type ArgsRateLimiter struct {
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be done at once after an idle period
+	Burst int
+}
+
+type rateLimitClientWrapper struct {
+	next ClientWrapper
+
+	mut        sync.Mutex
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateLimitMiddleware creates a middleware throttling the requests with a token bucket. Each client wrapper the
+// middleware is applied on gets its own bucket, so a bucket is kept per host
+func NewRateLimitMiddleware(args ArgsRateLimiter) (Middleware, error) {
+	if args.RequestsPerSecond <= 0 {
+		return nil, fmt.Errorf("%w for RequestsPerSecond", ErrInvalidValue)
+	}
+	if args.Burst < 1 {
+		return nil, fmt.Errorf("%w for Burst", ErrInvalidValue)
+	}
+
+	return func(next ClientWrapper) ClientWrapper {
+		return &rateLimitClientWrapper{
+			next:       next,
+			rate:       args.RequestsPerSecond,
+			burst:      float64(args.Burst),
+			tokens:     float64(args.Burst),
+			lastRefill: time.Now(),
+		}
+	}, nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint after waiting for a token
+func (wrapper *rateLimitClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	err := wrapper.wait(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return wrapper.next.GetHTTP(ctx, endpoint)
+}
+
+// PostHTTP does a POST method operation on the specified endpoint after waiting for a token
+func (wrapper *rateLimitClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	err := wrapper.wait(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return wrapper.next.PostHTTP(ctx, endpoint, data)
+}
+
+// wait reserves a token, blocking until it becomes available or the context is done
+func (wrapper *rateLimitClientWrapper) wait(ctx context.Context) error {
+	delay := wrapper.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		wrapper.release()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (wrapper *rateLimitClientWrapper) reserve() time.Duration {
+	wrapper.mut.Lock()
+	defer wrapper.mut.Unlock()
+
+	now := time.Now()
+	wrapper.tokens += now.Sub(wrapper.lastRefill).Seconds() * wrapper.rate
+	if wrapper.tokens > wrapper.burst {
+		wrapper.tokens = wrapper.burst
+	}
+	wrapper.lastRefill = now
+
+	wrapper.tokens--
+	if wrapper.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-wrapper.tokens / wrapper.rate * float64(time.Second))
+}
+
+func (wrapper *rateLimitClientWrapper) release() {
+	wrapper.mut.Lock()
+	wrapper.tokens++
+	wrapper.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *rateLimitClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid requests per second should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewRateLimitMiddleware(ArgsRateLimiter{RequestsPerSecond: 0, Burst: 1})
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewRateLimitMiddleware(ArgsRateLimiter{RequestsPerSecond: 1, Burst: 0})
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewRateLimitMiddleware(ArgsRateLimiter{RequestsPerSecond: 1, Burst: 1})
+		assert.NotNil(t, middleware)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRateLimitClientWrapper_Throttling(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewRateLimitMiddleware(ArgsRateLimiter{RequestsPerSecond: 20, Burst: 2})
+
+	t.Run("requests over the burst should wait for tokens", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{})
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			_, _, err := wrapper.GetHTTP(context.Background(), "endpoint")
+			require.Nil(t, err)
+		}
+		_, _, err := wrapper.PostHTTP(context.Background(), "endpoint", nil)
+		require.Nil(t, err)
+
+		// 2 requests from the burst, the next 3 at 50ms each
+		assert.True(t, time.Since(start) >= time.Millisecond*140)
+	})
+	t.Run("each wrapper should have its own bucket", func(t *testing.T) {
+		t.Parallel()
+
+		first := middleware(&testsCommon.HTTPClientWrapperStub{})
+		second := middleware(&testsCommon.HTTPClientWrapperStub{})
+
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			_, _, _ = first.GetHTTP(context.Background(), "endpoint")
+			_, _, _ = second.GetHTTP(context.Background(), "endpoint")
+		}
+		assert.True(t, time.Since(start) < time.Millisecond*40)
+	})
+	t.Run("context done while waiting should error", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				return nil, 0, nil
+			},
+		})
+		_, _, _ = wrapper.GetHTTP(context.Background(), "endpoint")
+		_, _, _ = wrapper.GetHTTP(context.Background(), "endpoint")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := wrapper.GetHTTP(ctx, "endpoint")
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 2, numCalls)
+	})
+}
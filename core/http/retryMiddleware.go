@@ -0,0 +1,165 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ArgsRetryPolicy is the DTO used in the retry middleware constructor
+type ArgsRetryPolicy struct {
+	// MaxRetries is the number of retries done after the first attempt
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Multiplier is the factor applied on the backoff after each retry, should be at least 1
+	Multiplier float64
+}
+
+// DefaultRetryPolicy returns a retry policy suitable for the public gateways
+func DefaultRetryPolicy() ArgsRetryPolicy {
+	return ArgsRetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond * 250,
+		MaxBackoff:     time.Second * 5,
+		Multiplier:     2,
+	}
+}
+
+type retryClientWrapper struct {
+	next   ClientWrapper
+	policy ArgsRetryPolicy
+
+	mutRandom sync.Mutex
+	random    *rand.Rand
+}
+
+// NewRetryMiddleware creates a middleware retrying the failed requests with an exponential backoff with jitter.
+// GET requests are idempotent so they are retried on transport errors, 429 and 5xx status codes. POST requests,
+// such as the transaction sends, are only retried when the request was certainly not processed: on 429 and 503
+// status codes and on errors raised while connecting
+func NewRetryMiddleware(policy ArgsRetryPolicy) (Middleware, error) {
+	err := checkArgsRetryPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(next ClientWrapper) ClientWrapper {
+		return &retryClientWrapper{
+			next:   next,
+			policy: policy,
+			random: rand.New(rand.NewSource(time.Now().UnixNano())),
+		}
+	}, nil
+}
+
+func checkArgsRetryPolicy(policy ArgsRetryPolicy) error {
+	if policy.MaxRetries < 0 {
+		return fmt.Errorf("%w for MaxRetries", ErrInvalidValue)
+	}
+	if policy.InitialBackoff <= 0 {
+		return fmt.Errorf("%w for InitialBackoff", ErrInvalidValue)
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		return fmt.Errorf("%w for MaxBackoff, should be at least InitialBackoff", ErrInvalidValue)
+	}
+	if policy.Multiplier < 1 {
+		return fmt.Errorf("%w for Multiplier", ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// GetHTTP does a GET method operation on the specified endpoint, retrying it on failures
+func (wrapper *retryClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.doWithRetries(ctx, shouldRetryGet, func(ctx context.Context) ([]byte, int, error) {
+		return wrapper.next.GetHTTP(ctx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the specified endpoint, retrying it only if it was not processed
+func (wrapper *retryClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return wrapper.doWithRetries(ctx, shouldRetryPost, func(ctx context.Context) ([]byte, int, error) {
+		return wrapper.next.PostHTTP(ctx, endpoint, data)
+	})
+}
+
+func (wrapper *retryClientWrapper) doWithRetries(
+	ctx context.Context,
+	shouldRetry func(code int, err error) bool,
+	request requestFunc,
+) ([]byte, int, error) {
+	backoff := wrapper.policy.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		buff, code, err := request(ctx)
+		if attempt >= wrapper.policy.MaxRetries || ctx.Err() != nil || !shouldRetry(code, err) {
+			return buff, code, err
+		}
+
+		log.Debug("retryClientWrapper: request failed, retrying",
+			"attempt", attempt+1, "status code", code, "error", err)
+
+		timer := time.NewTimer(wrapper.withJitter(backoff))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return buff, code, err
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * wrapper.policy.Multiplier)
+		if backoff > wrapper.policy.MaxBackoff {
+			backoff = wrapper.policy.MaxBackoff
+		}
+	}
+}
+
+// withJitter returns a random duration between half and the whole backoff, so that the clients throttled at the
+// same time do not retry at the same time
+func (wrapper *retryClientWrapper) withJitter(backoff time.Duration) time.Duration {
+	half := int64(backoff / 2)
+
+	wrapper.mutRandom.Lock()
+	jitter := wrapper.random.Int63n(half + 1)
+	wrapper.mutRandom.Unlock()
+
+	return time.Duration(half + jitter)
+}
+
+func shouldRetryGet(code int, err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+func shouldRetryPost(code int, err error) bool {
+	if err != nil {
+		return isDialError(err)
+	}
+
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+func isDialError(err error) bool {
+	opErr := &net.OpError{}
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial"
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *retryClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
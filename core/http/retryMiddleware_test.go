@@ -0,0 +1,249 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRetryPolicy() ArgsRetryPolicy {
+	return ArgsRetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond * 4,
+		Multiplier:     2,
+	}
+}
+
+func TestNewRetryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative max retries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRetryPolicy()
+		args.MaxRetries = -1
+		middleware, err := NewRetryMiddleware(args)
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRetryPolicy()
+		args.InitialBackoff = 0
+		middleware, err := NewRetryMiddleware(args)
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("max backoff lower than initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRetryPolicy()
+		args.MaxBackoff = args.InitialBackoff / 2
+		middleware, err := NewRetryMiddleware(args)
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid multiplier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRetryPolicy()
+		args.Multiplier = 0.5
+		middleware, err := NewRetryMiddleware(args)
+		assert.Nil(t, middleware)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		middleware, err := NewRetryMiddleware(createMockArgsRetryPolicy())
+		assert.NotNil(t, middleware)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRetryClientWrapper_GetHTTP(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewRetryMiddleware(createMockArgsRetryPolicy())
+
+	t.Run("should retry on 429 until success", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				if numCalls < 3 {
+					return nil, http.StatusTooManyRequests, nil
+				}
+				return []byte("ok"), http.StatusOK, nil
+			},
+		})
+
+		buff, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ok", string(buff))
+		assert.Equal(t, 3, numCalls)
+	})
+	t.Run("should return the last response when retries are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		expectedErr := errors.New("expected error")
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				return nil, http.StatusBadRequest, expectedErr
+			},
+		})
+
+		_, _, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 4, numCalls)
+	})
+	t.Run("should not retry on 4xx", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				return nil, http.StatusNotFound, nil
+			},
+		})
+
+		_, code, _ := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should not retry on open circuit", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				return nil, http.StatusServiceUnavailable, ErrCircuitOpen
+			},
+		})
+
+		_, _, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, ErrCircuitOpen, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should stop when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRetryPolicy()
+		args.InitialBackoff = time.Minute
+		args.MaxBackoff = time.Minute
+		slowMiddleware, _ := NewRetryMiddleware(args)
+
+		numCalls := 0
+		wrapper := slowMiddleware(&testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				numCalls++
+				return nil, http.StatusInternalServerError, nil
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		_, code, _ := wrapper.GetHTTP(ctx, "endpoint")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, 1, numCalls)
+	})
+}
+
+func TestRetryClientWrapper_PostHTTP(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewRetryMiddleware(createMockArgsRetryPolicy())
+
+	t.Run("should retry on 429 and 503", func(t *testing.T) {
+		t.Parallel()
+
+		codes := []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK}
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				numCalls++
+				return nil, codes[numCalls-1], nil
+			},
+		})
+
+		_, code, err := wrapper.PostHTTP(context.Background(), "endpoint", []byte("tx"))
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 3, numCalls)
+	})
+	t.Run("should retry on dial errors", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+			PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				numCalls++
+				if numCalls == 1 {
+					return nil, http.StatusBadRequest, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+				}
+				return nil, http.StatusOK, nil
+			},
+		})
+
+		_, code, err := wrapper.PostHTTP(context.Background(), "endpoint", []byte("tx"))
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should not retry when the request might have been processed", func(t *testing.T) {
+		t.Parallel()
+
+		responses := []struct {
+			code int
+			err  error
+		}{
+			{code: http.StatusInternalServerError},
+			{code: http.StatusBadGateway},
+			{code: http.StatusBadRequest, err: &net.OpError{Op: "read", Err: errors.New("connection reset")}},
+		}
+		for _, response := range responses {
+			numCalls := 0
+			currentResponse := response
+			wrapper := middleware(&testsCommon.HTTPClientWrapperStub{
+				PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+					numCalls++
+					return nil, currentResponse.code, currentResponse.err
+				},
+			})
+
+			_, code, _ := wrapper.PostHTTP(context.Background(), "endpoint", []byte("tx"))
+			assert.Equal(t, currentResponse.code, code)
+			assert.Equal(t, 1, numCalls)
+		}
+	})
+}
+
+func TestRetryClientWrapper_WithJitter(t *testing.T) {
+	t.Parallel()
+
+	middleware, _ := NewRetryMiddleware(createMockArgsRetryPolicy())
+	wrapper := middleware(&testsCommon.HTTPClientWrapperStub{}).(*retryClientWrapper)
+
+	backoff := time.Second
+	for i := 0; i < 100; i++ {
+		delay := wrapper.withJitter(backoff)
+		assert.True(t, delay >= backoff/2)
+		assert.True(t, delay <= backoff)
+	}
+}
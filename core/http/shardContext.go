@@ -0,0 +1,17 @@
+package http
+
+import "context"
+
+type shardIDContextKey struct{}
+
+// WithShardID returns a context marking the requests made with it as pinned to the provided shard
+func WithShardID(ctx context.Context, shardID uint32) context.Context {
+	return context.WithValue(ctx, shardIDContextKey{}, shardID)
+}
+
+// ShardIDFromContext returns the shard the requests made with the provided context are pinned to, if any
+func ShardIDFromContext(ctx context.Context) (uint32, bool) {
+	shardID, ok := ctx.Value(shardIDContextKey{}).(uint32)
+
+	return shardID, ok
+}
@@ -1,6 +1,10 @@
 package core
 
-import crypto "github.com/multiversx/mx-chain-crypto-go"
+import (
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
 
 // AddressHandler will handle different implementations of an address
 type AddressHandler interface {
@@ -19,3 +23,11 @@ type CryptoComponentsHolder interface {
 	GetAddressHandler() AddressHandler
 	IsInterfaceNil() bool
 }
+
+// MetricsHandler receives the instrumentation events of the SDK components
+type MetricsHandler interface {
+	ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration, err error)
+	IncrementCacheAccess(cacheName string, hit bool)
+	AddResentTransactions(address string, numTransactions int)
+	IsInterfaceNil() bool
+}
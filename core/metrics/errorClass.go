@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+)
+
+const (
+	errorClassNone        = "none"
+	errorClassTimeout     = "timeout"
+	errorClassCanceled    = "canceled"
+	errorClassConnection  = "connection"
+	errorClassCircuitOpen = "circuit_open"
+	errorClassOther       = "other"
+)
+
+// ErrorClass returns the class of the error reported on a request, used to keep the number of label values bounded
+func ErrorClass(err error) string {
+	if err == nil {
+		return errorClassNone
+	}
+	if errors.Is(err, sdkHttp.ErrCircuitOpen) {
+		return errorClassCircuitOpen
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errorClassTimeout
+	}
+	if errors.Is(err, context.Canceled) {
+		return errorClassCanceled
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return errorClassTimeout
+		}
+		return errorClassConnection
+	}
+
+	return errorClassOther
+}
@@ -0,0 +1,218 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	requestsTotalMetric           = "mx_sdk_http_requests_total"
+	requestDurationMetric         = "mx_sdk_http_request_duration_seconds"
+	cacheAccessesTotalMetric      = "mx_sdk_cache_accesses_total"
+	resentTransactionsTotalMetric = "mx_sdk_nonce_handler_resent_transactions_total"
+
+	contentTypeKey   = "Content-Type"
+	contentTypeValue = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// DefaultDurationBuckets are the upper bounds, in seconds, of the request duration histogram buckets
+var DefaultDurationBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type requestKey struct {
+	method     string
+	endpoint   string
+	statusCode int
+	errorClass string
+}
+
+type durationKey struct {
+	method   string
+	endpoint string
+}
+
+type histogram struct {
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+type cacheKey struct {
+	cacheName string
+	hit       bool
+}
+
+type prometheusMetricsHandler struct {
+	buckets []float64
+
+	mut                sync.RWMutex
+	requests           map[requestKey]uint64
+	durations          map[durationKey]*histogram
+	cacheAccesses      map[cacheKey]uint64
+	resentTransactions map[string]uint64
+}
+
+// NewPrometheusMetricsHandler creates a metrics handler keeping the metrics in memory and exposing them in the
+// Prometheus text format on ServeHTTP, so it can be mounted as the scrape endpoint of a web server
+func NewPrometheusMetricsHandler() *prometheusMetricsHandler {
+	return &prometheusMetricsHandler{
+		buckets:            DefaultDurationBuckets,
+		requests:           make(map[requestKey]uint64),
+		durations:          make(map[durationKey]*histogram),
+		cacheAccesses:      make(map[cacheKey]uint64),
+		resentTransactions: make(map[string]uint64),
+	}
+}
+
+// ObserveRequest records the status code, the error class and the duration of a request
+func (handler *prometheusMetricsHandler) ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration, err error) {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	handler.requests[requestKey{
+		method:     method,
+		endpoint:   endpoint,
+		statusCode: statusCode,
+		errorClass: ErrorClass(err),
+	}]++
+
+	key := durationKey{method: method, endpoint: endpoint}
+	hist, found := handler.durations[key]
+	if !found {
+		hist = &histogram{bucketCounts: make([]uint64, len(handler.buckets))}
+		handler.durations[key] = hist
+	}
+
+	seconds := duration.Seconds()
+	for idx, upperBound := range handler.buckets {
+		if seconds <= upperBound {
+			hist.bucketCounts[idx]++
+		}
+	}
+	hist.count++
+	hist.sum += seconds
+}
+
+// IncrementCacheAccess records a cache hit or miss
+func (handler *prometheusMetricsHandler) IncrementCacheAccess(cacheName string, hit bool) {
+	handler.mut.Lock()
+	handler.cacheAccesses[cacheKey{cacheName: cacheName, hit: hit}]++
+	handler.mut.Unlock()
+}
+
+// AddResentTransactions records the transactions resent by a nonce handler for an address
+func (handler *prometheusMetricsHandler) AddResentTransactions(address string, numTransactions int) {
+	if numTransactions <= 0 {
+		return
+	}
+
+	handler.mut.Lock()
+	handler.resentTransactions[address] += uint64(numTransactions)
+	handler.mut.Unlock()
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format
+func (handler *prometheusMetricsHandler) ServeHTTP(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set(contentTypeKey, contentTypeValue)
+	writer.WriteHeader(http.StatusOK)
+	handler.writeMetrics(writer)
+}
+
+func (handler *prometheusMetricsHandler) writeMetrics(writer io.Writer) {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	lines := make([]string, 0, len(handler.requests))
+	for key, value := range handler.requests {
+		lines = append(lines, sample(requestsTotalMetric, value,
+			"method", key.method, "endpoint", key.endpoint, "status", strconv.Itoa(key.statusCode), "error", key.errorClass))
+	}
+	sort.Strings(lines)
+	writeMetric(writer, requestsTotalMetric, "counter", "Number of HTTP requests done by the SDK", lines)
+
+	keys := make([]durationKey, 0, len(handler.durations))
+	for key := range handler.durations {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].endpoint != keys[j].endpoint {
+			return keys[i].endpoint < keys[j].endpoint
+		}
+		return keys[i].method < keys[j].method
+	})
+	lines = make([]string, 0, len(keys)*(len(handler.buckets)+3))
+	for _, key := range keys {
+		lines = append(lines, handler.histogramSamples(key, handler.durations[key])...)
+	}
+	writeMetric(writer, requestDurationMetric, "histogram", "Duration of the HTTP requests done by the SDK", lines)
+
+	lines = make([]string, 0, len(handler.cacheAccesses))
+	for key, value := range handler.cacheAccesses {
+		result := "miss"
+		if key.hit {
+			result = "hit"
+		}
+		lines = append(lines, sample(cacheAccessesTotalMetric, value, "cache", key.cacheName, "result", result))
+	}
+	sort.Strings(lines)
+	writeMetric(writer, cacheAccessesTotalMetric, "counter", "Number of cache accesses by result", lines)
+
+	lines = make([]string, 0, len(handler.resentTransactions))
+	for address, value := range handler.resentTransactions {
+		lines = append(lines, sample(resentTransactionsTotalMetric, value, "address", address))
+	}
+	sort.Strings(lines)
+	writeMetric(writer, resentTransactionsTotalMetric, "counter", "Number of transactions resent by the nonce handlers", lines)
+}
+
+func (handler *prometheusMetricsHandler) histogramSamples(key durationKey, hist *histogram) []string {
+	lines := make([]string, 0, len(handler.buckets)+3)
+	for idx, upperBound := range handler.buckets {
+		lines = append(lines, sample(requestDurationMetric+"_bucket", hist.bucketCounts[idx],
+			"method", key.method, "endpoint", key.endpoint, "le", strconv.FormatFloat(upperBound, 'g', -1, 64)))
+	}
+	lines = append(lines, sample(requestDurationMetric+"_bucket", hist.count,
+		"method", key.method, "endpoint", key.endpoint, "le", "+Inf"))
+	lines = append(lines, fmt.Sprintf("%s_sum%s %s", requestDurationMetric,
+		labels("method", key.method, "endpoint", key.endpoint), strconv.FormatFloat(hist.sum, 'g', -1, 64)))
+	lines = append(lines, sample(requestDurationMetric+"_count", hist.count,
+		"method", key.method, "endpoint", key.endpoint))
+
+	return lines
+}
+
+func writeMetric(writer io.Writer, name string, metricType string, help string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+	for _, line := range lines {
+		_, _ = fmt.Fprintln(writer, line)
+	}
+}
+
+func sample(name string, value uint64, labelPairs ...string) string {
+	return fmt.Sprintf("%s%s %d", name, labels(labelPairs...), value)
+}
+
+func labels(labelPairs ...string) string {
+	pairs := make([]string, 0, len(labelPairs)/2)
+	for i := 0; i+1 < len(labelPairs); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labelPairs[i], labelValueEscaper.Replace(labelPairs[i+1])))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *prometheusMetricsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPrometheusMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	assert.False(t, check.IfNil(handler))
+}
+
+func TestPrometheusMetricsHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	handler.ObserveRequest(http.MethodGet, "network/config", http.StatusOK, time.Millisecond*30, nil)
+	handler.ObserveRequest(http.MethodGet, "network/config", http.StatusOK, time.Second*20, nil)
+	handler.ObserveRequest(http.MethodPost, "transaction/send", http.StatusBadRequest, time.Millisecond, context.DeadlineExceeded)
+	handler.IncrementCacheAccess("networkConfig", true)
+	handler.IncrementCacheAccess("networkConfig", true)
+	handler.IncrementCacheAccess("networkConfig", false)
+	handler.AddResentTransactions("erd1address", 2)
+	handler.AddResentTransactions("erd1address", 0)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, contentTypeValue, recorder.Header().Get(contentTypeKey))
+
+	expectedLines := []string{
+		"# TYPE mx_sdk_http_requests_total counter",
+		`mx_sdk_http_requests_total{method="GET",endpoint="network/config",status="200",error="none"} 2`,
+		`mx_sdk_http_requests_total{method="POST",endpoint="transaction/send",status="400",error="timeout"} 1`,
+		"# TYPE mx_sdk_http_request_duration_seconds histogram",
+		`mx_sdk_http_request_duration_seconds_bucket{method="GET",endpoint="network/config",le="0.01"} 0`,
+		`mx_sdk_http_request_duration_seconds_bucket{method="GET",endpoint="network/config",le="0.05"} 1`,
+		`mx_sdk_http_request_duration_seconds_bucket{method="GET",endpoint="network/config",le="10"} 1`,
+		`mx_sdk_http_request_duration_seconds_bucket{method="GET",endpoint="network/config",le="+Inf"} 2`,
+		`mx_sdk_http_request_duration_seconds_sum{method="GET",endpoint="network/config"} 20.03`,
+		`mx_sdk_http_request_duration_seconds_count{method="GET",endpoint="network/config"} 2`,
+		`mx_sdk_cache_accesses_total{cache="networkConfig",result="hit"} 2`,
+		`mx_sdk_cache_accesses_total{cache="networkConfig",result="miss"} 1`,
+		`mx_sdk_nonce_handler_resent_transactions_total{address="erd1address"} 2`,
+	}
+	lines := strings.Split(recorder.Body.String(), "\n")
+	for _, expectedLine := range expectedLines {
+		assert.Contains(t, lines, expectedLine)
+	}
+}
+
+func TestPrometheusMetricsHandler_ShouldEscapeLabelValues(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	handler.IncrementCacheAccess("a\"b\\c\nd", true)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, recorder.Body.String(), `cache="a\"b\\c\nd"`)
+}
+
+func TestPrometheusMetricsHandler_NoMetricsShouldWriteNothing(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	NewPrometheusMetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "none", ErrorClass(nil))
+	assert.Equal(t, "timeout", ErrorClass(context.DeadlineExceeded))
+	assert.Equal(t, "canceled", ErrorClass(context.Canceled))
+	assert.Equal(t, "other", ErrorClass(errors.New("other")))
+}
@@ -8,4 +8,4 @@ import (
 var log = logger.GetOrCreate("mx-sdk-go/core")
 
 // AddressPublicKeyConverter represents the default address public key converter
-var AddressPublicKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(AddressBytesLen, log)
+var AddressPublicKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(AddressBytesLen, DefaultAddressPrefix)
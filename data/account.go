@@ -1,33 +1,44 @@
 package data
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
 )
 
+// esdtPropertyFrozen is the bit of the frozen flag in the first byte of the token properties
+const esdtPropertyFrozen = 1
+
 var errInvalidBalance = errors.New("invalid balance")
+var errInvalidProperties = errors.New("invalid token properties")
 
 // AccountResponse holds the account endpoint response
 type AccountResponse struct {
 	Data struct {
-		Account *Account `json:"account"`
+		Account   *Account       `json:"account"`
+		BlockInfo *api.BlockInfo `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
 }
 
-// Account holds an Account's information
+// Account holds an Account's information. The BlockInfo of the account, as of the other query results of this
+// package, is the block the data was read on, nil if not provided by the gateway or the observer
 type Account struct {
-	Address         string `json:"address"`
-	Nonce           uint64 `json:"nonce"`
-	Balance         string `json:"balance"`
-	Code            string `json:"code"`
-	CodeHash        []byte `json:"codeHash"`
-	RootHash        []byte `json:"rootHash"`
-	CodeMetadata    []byte `json:"codeMetadata"`
-	Username        string `json:"username"`
-	DeveloperReward string `json:"developerReward"`
-	OwnerAddress    string `json:"ownerAddress"`
+	Address         string         `json:"address"`
+	Nonce           uint64         `json:"nonce"`
+	Balance         string         `json:"balance"`
+	Code            string         `json:"code"`
+	CodeHash        []byte         `json:"codeHash"`
+	RootHash        []byte         `json:"rootHash"`
+	CodeMetadata    []byte         `json:"codeMetadata"`
+	Username        string         `json:"username"`
+	DeveloperReward string         `json:"developerReward"`
+	OwnerAddress    string         `json:"ownerAddress"`
+	BlockInfo       *api.BlockInfo `json:"blockInfo,omitempty"`
 }
 
 // GetBalance computes the float representation of the balance,
@@ -52,6 +63,7 @@ func (a *Account) GetBalance(decimals int) (float64, error) {
 type ESDTFungibleResponse struct {
 	Data struct {
 		TokenData *ESDTFungibleTokenData `json:"tokenData"`
+		BlockInfo *api.BlockInfo         `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -59,15 +71,17 @@ type ESDTFungibleResponse struct {
 
 // ESDTFungibleTokenData holds the ESDT (fungible) token data definition
 type ESDTFungibleTokenData struct {
-	TokenIdentifier string `json:"tokenIdentifier"`
-	Balance         string `json:"balance"`
-	Properties      string `json:"properties"`
+	TokenIdentifier string         `json:"tokenIdentifier"`
+	Balance         string         `json:"balance"`
+	Properties      string         `json:"properties"`
+	BlockInfo       *api.BlockInfo `json:"blockInfo,omitempty"`
 }
 
 // ESDTNFTResponse holds the NFT token data endpoint response
 type ESDTNFTResponse struct {
 	Data struct {
 		TokenData *ESDTNFTTokenData `json:"tokenData"`
+		BlockInfo *api.BlockInfo    `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -75,14 +89,86 @@ type ESDTNFTResponse struct {
 
 // ESDTNFTTokenData holds the ESDT (NDT, SFT or MetaESDT) token data definition
 type ESDTNFTTokenData struct {
-	TokenIdentifier string   `json:"tokenIdentifier"`
-	Balance         string   `json:"balance"`
-	Properties      string   `json:"properties,omitempty"`
-	Name            string   `json:"name,omitempty"`
-	Nonce           uint64   `json:"nonce,omitempty"`
-	Creator         string   `json:"creator,omitempty"`
-	Royalties       string   `json:"royalties,omitempty"`
-	Hash            []byte   `json:"hash,omitempty"`
-	URIs            [][]byte `json:"uris,omitempty"`
-	Attributes      []byte   `json:"attributes,omitempty"`
+	TokenIdentifier string         `json:"tokenIdentifier"`
+	Balance         string         `json:"balance"`
+	Properties      string         `json:"properties,omitempty"`
+	Name            string         `json:"name,omitempty"`
+	Nonce           uint64         `json:"nonce,omitempty"`
+	Creator         string         `json:"creator,omitempty"`
+	Royalties       string         `json:"royalties,omitempty"`
+	Hash            []byte         `json:"hash,omitempty"`
+	URIs            [][]byte       `json:"uris,omitempty"`
+	Attributes      []byte         `json:"attributes,omitempty"`
+	BlockInfo       *api.BlockInfo `json:"blockInfo,omitempty"`
+}
+
+// ESDTTokenProperties holds the decoded properties of a token held by an account
+type ESDTTokenProperties struct {
+	Frozen bool
+}
+
+// GetProperties decodes the hex encoded properties of the token. Empty properties are decoded as the default ones
+func (tokenData *ESDTFungibleTokenData) GetProperties() (*ESDTTokenProperties, error) {
+	return decodeESDTTokenProperties(tokenData.Properties)
+}
+
+// GetProperties decodes the hex encoded properties of the token. Empty properties are decoded as the default ones
+func (tokenData *ESDTNFTTokenData) GetProperties() (*ESDTTokenProperties, error) {
+	return decodeESDTTokenProperties(tokenData.Properties)
+}
+
+func decodeESDTTokenProperties(hexProperties string) (*ESDTTokenProperties, error) {
+	properties, err := hex.DecodeString(hexProperties)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidProperties, err.Error())
+	}
+	if len(properties) == 0 {
+		return &ESDTTokenProperties{}, nil
+	}
+
+	return &ESDTTokenProperties{
+		Frozen: properties[0]&esdtPropertyFrozen != 0,
+	}, nil
+}
+
+// AllESDTTokensResponse holds the endpoint response of all the tokens held by an address, keyed by token identifier
+type AllESDTTokensResponse struct {
+	Data struct {
+		Tokens    map[string]*ESDTNFTTokenData `json:"esdts"`
+		BlockInfo *api.BlockInfo               `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ESDTRolesResponse holds the endpoint response of the roles an address has, keyed by token identifier
+type ESDTRolesResponse struct {
+	Data struct {
+		Roles     map[string][]string `json:"roles"`
+		BlockInfo *api.BlockInfo      `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ESDTRoles holds the roles an address has, keyed by token identifier
+type ESDTRoles struct {
+	Roles     map[string][]string
+	BlockInfo *api.BlockInfo
+}
+
+// ESDTTokensListResponse holds the endpoints responses returning a list of token identifiers
+type ESDTTokensListResponse struct {
+	Data struct {
+		Tokens    []string       `json:"tokens"`
+		BlockInfo *api.BlockInfo `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// ESDTTokensList holds a list of token identifiers
+type ESDTTokensList struct {
+	Tokens    []string
+	BlockInfo *api.BlockInfo
 }
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const (
+	maxUint64StorageValueLength = 8
+	addressStorageValueLength   = 32
+)
+
+var errInvalidStorageValue = errors.New("invalid storage value")
+
+// AccountStorageValueResponse holds the account storage value endpoint response
+type AccountStorageValueResponse struct {
+	Data struct {
+		Value     string         `json:"value"`
+		BlockInfo *api.BlockInfo `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountKeysResponse holds the account keys endpoint response, the keys and the values being hex encoded
+type AccountKeysResponse struct {
+	Data struct {
+		Pairs     map[string]string `json:"pairs"`
+		BlockInfo *api.BlockInfo    `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// IterateAccountKeysRequest holds the request for a page of the account storage
+type IterateAccountKeysRequest struct {
+	Address       string   `json:"address"`
+	NumKeys       uint     `json:"numKeys"`
+	IteratorState [][]byte `json:"iteratorState"`
+}
+
+// IterateAccountKeysResponse holds the iterate account keys endpoint response
+type IterateAccountKeysResponse struct {
+	Data struct {
+		Pairs            map[string]string `json:"pairs"`
+		NewIteratorState [][]byte          `json:"newIteratorState"`
+		BlockInfo        *api.BlockInfo    `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// StorageKeyValuePair holds a key of an account storage along with its value
+type StorageKeyValuePair struct {
+	Key   []byte
+	Value StorageValue
+}
+
+// AccountStorageValue holds a value read from an account storage. A missing key has an empty value
+type AccountStorageValue struct {
+	Value     StorageValue
+	BlockInfo *api.BlockInfo
+}
+
+// AccountKeys holds all the keys and values of an account storage
+type AccountKeys struct {
+	Pairs     []*StorageKeyValuePair
+	BlockInfo *api.BlockInfo
+}
+
+// AccountKeysPage holds a page of the account storage. An empty iterator state signals the last page
+type AccountKeysPage struct {
+	Pairs         []*StorageKeyValuePair
+	IteratorState [][]byte
+	BlockInfo     *api.BlockInfo
+}
+
+// StorageValue is a value read from an account storage, holding the typed decoding helpers
+type StorageValue []byte
+
+// ToBigInt decodes the value as an unsigned big integer. An empty value is decoded as 0
+func (value StorageValue) ToBigInt() *big.Int {
+	return big.NewInt(0).SetBytes(value)
+}
+
+// ToUint64 decodes the value as an unsigned integer. An empty value is decoded as 0
+func (value StorageValue) ToUint64() (uint64, error) {
+	if len(value) > maxUint64StorageValueLength {
+		return 0, fmt.Errorf("%w: %d bytes can not be decoded as uint64", errInvalidStorageValue, len(value))
+	}
+
+	return value.ToBigInt().Uint64(), nil
+}
+
+// ToBool decodes the value as a boolean. An empty value is decoded as false
+func (value StorageValue) ToBool() (bool, error) {
+	if len(value) == 0 {
+		return false, nil
+	}
+	if len(value) == 1 && value[0] <= 1 {
+		return value[0] == 1, nil
+	}
+
+	return false, fmt.Errorf("%w: %s can not be decoded as bool", errInvalidStorageValue, value.ToHex())
+}
+
+// ToAddress decodes the value as an address
+func (value StorageValue) ToAddress() (core.AddressHandler, error) {
+	if len(value) != addressStorageValueLength {
+		return nil, fmt.Errorf("%w: %d bytes can not be decoded as address", errInvalidStorageValue, len(value))
+	}
+
+	return NewAddressFromBytes(value), nil
+}
+
+// ToString returns the value as a string
+func (value StorageValue) ToString() string {
+	return string(value)
+}
+
+// ToHex returns the value hex encoded
+func (value StorageValue) ToHex() string {
+	return hex.EncodeToString(value)
+}
+
+// NewStorageKeyValuePairs decodes the hex encoded keys and values returned by the account keys endpoints, sorting them by key
+func NewStorageKeyValuePairs(hexPairs map[string]string) ([]*StorageKeyValuePair, error) {
+	pairs := make([]*StorageKeyValuePair, 0, len(hexPairs))
+	for hexKey, hexValue := range hexPairs {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, hexKey)
+		}
+		value, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the value of key %s", err, hexKey)
+		}
+
+		pairs = append(pairs, &StorageKeyValuePair{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return string(pairs[i].Key) < string(pairs[j].Key)
+	})
+
+	return pairs, nil
+}
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageValue_ToBigInt(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, big.NewInt(0), StorageValue(nil).ToBigInt())
+	assert.Equal(t, big.NewInt(258), StorageValue{1, 2}.ToBigInt())
+}
+
+func TestStorageValue_ToUint64(t *testing.T) {
+	t.Parallel()
+
+	value, err := StorageValue(nil).ToUint64()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), value)
+
+	value, err = StorageValue{1, 0, 0, 0, 0, 0, 0, 0}.ToUint64()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1)<<56, value)
+
+	value, err = StorageValue(make([]byte, 9)).ToUint64()
+	assert.True(t, errors.Is(err, errInvalidStorageValue))
+	assert.Equal(t, uint64(0), value)
+}
+
+func TestStorageValue_ToBool(t *testing.T) {
+	t.Parallel()
+
+	value, err := StorageValue(nil).ToBool()
+	assert.Nil(t, err)
+	assert.False(t, value)
+
+	value, err = StorageValue{1}.ToBool()
+	assert.Nil(t, err)
+	assert.True(t, value)
+
+	_, err = StorageValue{2}.ToBool()
+	assert.True(t, errors.Is(err, errInvalidStorageValue))
+}
+
+func TestStorageValue_ToAddress(t *testing.T) {
+	t.Parallel()
+
+	_, err := StorageValue{1}.ToAddress()
+	assert.True(t, errors.Is(err, errInvalidStorageValue))
+
+	addressBytes := bytes.Repeat([]byte{1}, 32)
+	address, err := StorageValue(addressBytes).ToAddress()
+	assert.Nil(t, err)
+	assert.Equal(t, addressBytes, address.AddressBytes())
+}
+
+func TestStorageValue_ToStringAndHex(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "abc", StorageValue("abc").ToString())
+	assert.Equal(t, "616263", StorageValue("abc").ToHex())
+}
+
+func TestNewStorageKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewStorageKeyValuePairs(map[string]string{"6b6579": "not hex"})
+	assert.NotNil(t, err)
+
+	pairs, err := NewStorageKeyValuePairs(map[string]string{"62": "", "61": "0102"})
+	assert.Nil(t, err)
+	expectedPairs := []*StorageKeyValuePair{
+		{Key: []byte("a"), Value: StorageValue{1, 2}},
+		{Key: []byte("b"), Value: StorageValue{}},
+	}
+	assert.Equal(t, expectedPairs, pairs)
+}
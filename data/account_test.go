@@ -0,0 +1,55 @@
+package data
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestESDTNFTTokenData_GetProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hex should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenData := &ESDTNFTTokenData{Properties: "not hex"}
+		properties, err := tokenData.GetProperties()
+		assert.Nil(t, properties)
+		assert.True(t, errors.Is(err, errInvalidProperties))
+	})
+	t.Run("empty properties should return the default ones", func(t *testing.T) {
+		t.Parallel()
+
+		properties, err := (&ESDTNFTTokenData{}).GetProperties()
+		assert.Nil(t, err)
+		assert.Equal(t, &ESDTTokenProperties{}, properties)
+	})
+	t.Run("should decode the frozen flag", func(t *testing.T) {
+		t.Parallel()
+
+		properties, err := (&ESDTNFTTokenData{Properties: "0000"}).GetProperties()
+		assert.Nil(t, err)
+		assert.False(t, properties.Frozen)
+
+		properties, err = (&ESDTNFTTokenData{Properties: "0100"}).GetProperties()
+		assert.Nil(t, err)
+		assert.True(t, properties.Frozen)
+	})
+}
+
+func TestESDTFungibleTokenData_GetProperties(t *testing.T) {
+	t.Parallel()
+
+	properties, err := (&ESDTFungibleTokenData{Properties: "not hex"}).GetProperties()
+	assert.Nil(t, properties)
+	assert.True(t, errors.Is(err, errInvalidProperties))
+
+	properties, err = (&ESDTFungibleTokenData{}).GetProperties()
+	assert.Nil(t, err)
+	assert.Equal(t, &ESDTTokenProperties{}, properties)
+
+	properties, err = (&ESDTFungibleTokenData{Properties: "0100"}).GetProperties()
+	assert.Nil(t, err)
+	assert.True(t, properties.Frozen)
+}
@@ -29,4 +29,5 @@ type NetworkConfig struct {
 	Hysteresys               float32 `json:"erd_hysteresis,string"`
 	RoundsPerEpoch           uint32  `json:"erd_rounds_per_epoch"`
 	ExtraGasLimitGuardedTx   uint64  `json:"erd_extra_gas_limit_guarded_tx"`
+	GasPriceModifier         float64 `json:"erd_gas_price_modifier,string"`
 }
@@ -0,0 +1,65 @@
+package data
+
+// NotifierEventType defines the type of the values pushed by the events notifier
+type NotifierEventType string
+
+const (
+	// NotifierAllEvents is pushed with the log events of a block, the data being a list of NotifierEvent
+	NotifierAllEvents NotifierEventType = "all_events"
+	// NotifierBlockEvents is pushed with all the log events of a block, not filtered by address or identifier, the
+	// data being a NotifierBlockWithEvents
+	NotifierBlockEvents NotifierEventType = "block_events"
+	// NotifierRevertEvents is pushed when a block is reverted, the data being a NotifierRevertedBlock
+	NotifierRevertEvents NotifierEventType = "revert_events"
+	// NotifierFinalizedEvents is pushed when a block becomes final, the data being a NotifierFinalizedBlock
+	NotifierFinalizedEvents NotifierEventType = "finalized_events"
+)
+
+// NotifierMessage is the envelope of the values pushed by the events notifier, the data being the JSON encoded value
+type NotifierMessage struct {
+	Type NotifierEventType `json:"type,omitempty"`
+	Data []byte            `json:"data,omitempty"`
+}
+
+// NotifierSubscribe holds the subscription sent to the events notifier after connecting
+type NotifierSubscribe struct {
+	SubscriptionEntries []NotifierSubscription `json:"subscriptionEntries"`
+}
+
+// NotifierSubscription selects the pushed values. An empty event type stands for the log events, which are filtered
+// by address and identifier, the empty fields matching any value
+type NotifierSubscription struct {
+	EventType  NotifierEventType `json:"eventType,omitempty"`
+	Address    string            `json:"address,omitempty"`
+	Identifier string            `json:"identifier,omitempty"`
+}
+
+// NotifierFinalizedBlock holds a block that became final
+type NotifierFinalizedBlock struct {
+	Hash string `json:"hash"`
+}
+
+// NotifierRevertedBlock holds a reverted block
+type NotifierRevertedBlock struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+}
+
+// NotifierBlockWithEvents holds the log events of a block
+type NotifierBlockWithEvents struct {
+	Hash      string          `json:"hash"`
+	ShardID   uint32          `json:"shardId"`
+	TimeStamp uint64          `json:"timestamp"`
+	Events    []NotifierEvent `json:"events"`
+}
+
+// NotifierEvent holds a log event
+type NotifierEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	TxHash     string   `json:"txHash"`
+}
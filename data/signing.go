@@ -0,0 +1,13 @@
+package data
+
+// SignRequest defines the request sent to a remote signing backend. Both fields are hex encoded
+type SignRequest struct {
+	PublicKey string `json:"publicKey"`
+	Message   string `json:"message"`
+}
+
+// SignResponse defines the response of a remote signing backend. The signature is hex encoded
+type SignResponse struct {
+	Signature string `json:"signature"`
+	Error     string `json:"error"`
+}
@@ -0,0 +1,18 @@
+package data
+
+import "math/big"
+
+// TokenDeposit holds an ESDT, SFT or NFT transfer received by a tracked address, either directly from a transaction
+// or through a smart contract result
+type TokenDeposit struct {
+	Sender          string
+	Receiver        string
+	TokenIdentifier string
+	Nonce           uint64
+	Amount          *big.Int
+	// TxHash is the hash of the transaction or of the smart contract result carrying the transfer
+	TxHash string
+	// OriginalTxHash is the hash of the transaction originating the transfer, equal to TxHash for direct transfers
+	OriginalTxHash  string
+	HyperBlockNonce uint64
+}
@@ -0,0 +1,19 @@
+package data
+
+import "math/big"
+
+// TokenPayment holds the token, the nonce and the amount transferred in an ESDT payment. A 0 nonce denotes a
+// fungible token while a nonce greater than 0 denotes an NFT, SFT or Meta-ESDT
+type TokenPayment struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Amount          *big.Int
+}
+
+// TokenTransfer holds the built in function, the destination and the payments of an ESDTTransfer, ESDTNFTTransfer or
+// MultiESDTNFTTransfer call
+type TokenTransfer struct {
+	Function    string
+	Destination string
+	Payments    []*TokenPayment
+}
@@ -76,6 +76,8 @@ type TransactionOnNetwork struct {
 	NotarizedAtSourceInMetaHash       string                                `json:"NotarizedAtSourceInMetaHash,omitempty"`
 	NotarizedAtDestinationInMetaNonce uint64                                `json:"notarizedAtDestinationInMetaNonce,omitempty"`
 	NotarizedAtDestinationInMetaHash  string                                `json:"notarizedAtDestinationInMetaHash,omitempty"`
+	PreviousTransactionHash           string                                `json:"previousTransactionHash,omitempty"`
+	OriginalTransactionHash           string                                `json:"originalTransactionHash,omitempty"`
 	ScResults                         []*transaction.ApiSmartContractResult `json:"smartContractResults,omitempty"`
 	Logs                              *transaction.ApiLogs                  `json:"logs,omitempty"`
 }
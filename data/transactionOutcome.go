@@ -0,0 +1,60 @@
+package data
+
+// TransactionExecutionStatus defines the overall outcome of a transaction execution
+type TransactionExecutionStatus string
+
+const (
+	// TransactionExecutionSuccess marks a transaction that was fully executed without errors
+	TransactionExecutionSuccess TransactionExecutionStatus = "success"
+	// TransactionExecutionFailed marks a transaction that was executed with errors or was considered invalid
+	TransactionExecutionFailed TransactionExecutionStatus = "failed"
+	// TransactionExecutionPending marks a transaction that was not yet fully executed
+	TransactionExecutionPending TransactionExecutionStatus = "pending"
+)
+
+// TransactionOutcome holds the interpreted results of a transaction execution
+type TransactionOutcome struct {
+	Hash          string
+	Status        TransactionExecutionStatus
+	ReturnCode    string
+	ReturnMessage string
+	ReturnData    [][]byte
+	Errors        []string
+	Events        []*TransactionOutcomeEvent
+}
+
+// TransactionOutcomeEvent holds an event emitted while executing a transaction or one of its smart contract results
+type TransactionOutcomeEvent struct {
+	Address    string
+	Identifier string
+	Topics     [][]byte
+	Data       []byte
+	SourceHash string
+}
+
+// IsSuccess returns true if the transaction was successfully executed
+func (outcome *TransactionOutcome) IsSuccess() bool {
+	return outcome.Status == TransactionExecutionSuccess
+}
+
+// IsFailed returns true if the transaction was executed with errors or was considered invalid
+func (outcome *TransactionOutcome) IsFailed() bool {
+	return outcome.Status == TransactionExecutionFailed
+}
+
+// IsPending returns true if the transaction was not yet fully executed
+func (outcome *TransactionOutcome) IsPending() bool {
+	return outcome.Status == TransactionExecutionPending
+}
+
+// FindEvents returns all the events with the provided identifier
+func (outcome *TransactionOutcome) FindEvents(identifier string) []*TransactionOutcomeEvent {
+	events := make([]*TransactionOutcomeEvent, 0)
+	for _, event := range outcome.Events {
+		if event.Identifier == identifier {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
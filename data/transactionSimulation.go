@@ -0,0 +1,101 @@
+package data
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// ResponseTransactionSimulation holds the transaction simulation endpoint response
+type ResponseTransactionSimulation struct {
+	Data struct {
+		Result TransactionSimulationResults `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// SimulationResults holds the results of a transaction simulated in one shard
+type SimulationResults struct {
+	Status     transaction.TxStatus                           `json:"status,omitempty"`
+	FailReason string                                         `json:"failReason,omitempty"`
+	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+	Logs       *transaction.ApiLogs                           `json:"logs,omitempty"`
+	Hash       string                                         `json:"hash,omitempty"`
+}
+
+// IsSuccessful returns true if the transaction did not fail in the shard
+func (results *SimulationResults) IsSuccessful() bool {
+	return len(results.FailReason) == 0 &&
+		results.Status != transaction.TxStatusFail &&
+		results.Status != transaction.TxStatusInvalid
+}
+
+// TransactionSimulationResults holds the results of a transaction simulation. The observers and the gateways, for
+// the intra-shard transactions, return the results of a single shard while the gateways return the results of both
+// the sender and the receiver shards for the cross-shard transactions
+type TransactionSimulationResults struct {
+	SimulationResults
+	SenderShard   *SimulationResults `json:"senderShard,omitempty"`
+	ReceiverShard *SimulationResults `json:"receiverShard,omitempty"`
+}
+
+// ShardsResults returns the results of each simulated shard, the sender shard first
+func (results *TransactionSimulationResults) ShardsResults() []*SimulationResults {
+	if results.SenderShard == nil && results.ReceiverShard == nil {
+		return []*SimulationResults{&results.SimulationResults}
+	}
+
+	shardsResults := make([]*SimulationResults, 0, 2)
+	if results.SenderShard != nil {
+		shardsResults = append(shardsResults, results.SenderShard)
+	}
+	if results.ReceiverShard != nil {
+		shardsResults = append(shardsResults, results.ReceiverShard)
+	}
+
+	return shardsResults
+}
+
+// FailReason returns the reason the transaction failed in one of the simulated shards, or an empty string if the
+// transaction was successful
+func (results *TransactionSimulationResults) FailReason() string {
+	for _, shardResults := range results.ShardsResults() {
+		if shardResults.IsSuccessful() {
+			continue
+		}
+		if len(shardResults.FailReason) > 0 {
+			return shardResults.FailReason
+		}
+
+		return string(shardResults.Status)
+	}
+
+	return ""
+}
+
+// AllScResults returns the smart contract results generated in all the simulated shards, keyed by hash
+func (results *TransactionSimulationResults) AllScResults() map[string]*transaction.ApiSmartContractResult {
+	scResults := make(map[string]*transaction.ApiSmartContractResult)
+	for _, shardResults := range results.ShardsResults() {
+		for hash, scResult := range shardResults.ScResults {
+			scResults[hash] = scResult
+		}
+	}
+
+	return scResults
+}
+
+// TransactionEstimation holds the gas and the fee estimated for a transaction, along with its simulation results
+type TransactionEstimation struct {
+	// GasUnits is the gas consumed by the transaction, as reported by the transaction cost endpoint
+	GasUnits uint64
+	// GasLimit is the gas limit set on the transaction, including the safety margin
+	GasLimit uint64
+	// Fee is the fee of the consumed gas units
+	Fee *big.Int
+	// MaxFee is the fee of the gas limit, paid when the transaction is executed, before the unused gas is refunded
+	MaxFee     *big.Int
+	Simulation *TransactionSimulationResults
+}
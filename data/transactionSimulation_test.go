@@ -0,0 +1,68 @@
+package data
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionSimulationResults_IntraShard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful transaction", func(t *testing.T) {
+		t.Parallel()
+
+		results := &TransactionSimulationResults{}
+		err := json.Unmarshal([]byte(`{"status":"success","scResults":{"aa":{"data":"@6f6b"}},"hash":"bb"}`), results)
+		require.Nil(t, err)
+
+		assert.Equal(t, []*SimulationResults{&results.SimulationResults}, results.ShardsResults())
+		assert.Empty(t, results.FailReason())
+		scResults := results.AllScResults()
+		require.Len(t, scResults, 1)
+		assert.Equal(t, "@6f6b", scResults["aa"].Data)
+	})
+	t.Run("failed transaction should return the fail reason", func(t *testing.T) {
+		t.Parallel()
+
+		results := &TransactionSimulationResults{}
+		err := json.Unmarshal([]byte(`{"status":"fail","failReason":"insufficient funds"}`), results)
+		require.Nil(t, err)
+
+		assert.Equal(t, "insufficient funds", results.FailReason())
+	})
+	t.Run("invalid transaction without a fail reason should return the status", func(t *testing.T) {
+		t.Parallel()
+
+		results := &TransactionSimulationResults{
+			SimulationResults: SimulationResults{Status: transaction.TxStatusInvalid},
+		}
+
+		assert.Equal(t, string(transaction.TxStatusInvalid), results.FailReason())
+	})
+}
+
+func TestTransactionSimulationResults_CrossShard(t *testing.T) {
+	t.Parallel()
+
+	results := &TransactionSimulationResults{}
+	err := json.Unmarshal([]byte(`{
+		"senderShard":{"status":"success","scResults":{"aa":{"data":"@6f6b"}}},
+		"receiverShard":{"status":"fail","failReason":"user error","scResults":{"cc":{"data":"@75736572206572726f72"}}}
+	}`), results)
+	require.Nil(t, err)
+
+	shardsResults := results.ShardsResults()
+	require.Len(t, shardsResults, 2)
+	assert.Equal(t, results.SenderShard, shardsResults[0])
+	assert.Equal(t, results.ReceiverShard, shardsResults[1])
+	assert.Equal(t, "user error", results.FailReason())
+
+	scResults := results.AllScResults()
+	require.Len(t, scResults, 2)
+	assert.Equal(t, "@6f6b", scResults["aa"].Data)
+	assert.Equal(t, "@75736572206572726f72", scResults["cc"].Data)
+}
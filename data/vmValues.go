@@ -1,12 +1,14 @@
 package data
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // VmValuesResponseData follows the format of the data field in an API response for a VM values query
 type VmValuesResponseData struct {
-	Data *vm.VMOutputApi `json:"data"`
+	Data      *vm.VMOutputApi `json:"data"`
+	BlockInfo *api.BlockInfo  `json:"blockInfo,omitempty"`
 }
 
 // ResponseVmValue defines a wrapper over string containing returned data in hex format
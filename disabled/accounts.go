@@ -22,18 +22,13 @@ func (a *Accounts) StartSnapshotIfNeeded() error {
 	return nil
 }
 
-// RecreateTrieFromEpoch returns nil
-func (a *Accounts) RecreateTrieFromEpoch(_ common.RootHashHolder) error {
-	return nil
-}
-
 // GetStackDebugFirstEntry return nil
 func (a *Accounts) GetStackDebugFirstEntry() []byte {
 	return nil
 }
 
 // SnapshotState does nothing
-func (a *Accounts) SnapshotState(_ []byte) {
+func (a *Accounts) SnapshotState(_ []byte, _ uint32) {
 }
 
 // GetTrie returns nil trie and nil error
@@ -67,7 +62,7 @@ func (a *Accounts) SaveAccount(_ vmcommon.AccountHandler) error {
 }
 
 // GetAllLeaves returns nil
-func (a *Accounts) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context, _ []byte) error {
+func (a *Accounts) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context, _ []byte, _ common.TrieLeafParser) error {
 	return nil
 }
 
@@ -107,7 +102,7 @@ func (a *Accounts) RootHash() ([]byte, error) {
 }
 
 // RecreateTrie returns nil
-func (a *Accounts) RecreateTrie(_ []byte) error {
+func (a *Accounts) RecreateTrie(_ common.RootHashHolder) error {
 	return nil
 }
 
@@ -119,20 +114,11 @@ func (a *Accounts) PruneTrie(_ []byte, _ state.TriePruningIdentifier, _ state.Pr
 func (a *Accounts) CancelPrune(_ []byte, _ state.TriePruningIdentifier) {
 }
 
-// SetStateCheckpoint does nothing
-func (a *Accounts) SetStateCheckpoint(_ []byte) {
-}
-
 // IsPruningEnabled returns false
 func (a *Accounts) IsPruningEnabled() bool {
 	return false
 }
 
-// GetNumCheckpoints returns 0
-func (a *Accounts) GetNumCheckpoints() uint32 {
-	return 0
-}
-
 // GetAccountFromBytes returns a nil account and nil error
 func (a *Accounts) GetAccountFromBytes(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
 	return nil, nil
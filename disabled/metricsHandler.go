@@ -0,0 +1,24 @@
+package disabled
+
+import "time"
+
+// MetricsHandler is a disabled implementation of MetricsHandler interface
+type MetricsHandler struct {
+}
+
+// ObserveRequest does nothing
+func (handler *MetricsHandler) ObserveRequest(_ string, _ string, _ int, _ time.Duration, _ error) {
+}
+
+// IncrementCacheAccess does nothing
+func (handler *MetricsHandler) IncrementCacheAccess(_ string, _ bool) {
+}
+
+// AddResentTransactions does nothing
+func (handler *MetricsHandler) AddResentTransactions(_ string, _ int) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *MetricsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
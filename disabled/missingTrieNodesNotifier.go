@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// MissingTrieNodesNotifier is a disabled implementation of MissingTrieNodesNotifier interface
+type MissingTrieNodesNotifier struct {
+}
+
+// RegisterHandler returns nil
+func (mtnn *MissingTrieNodesNotifier) RegisterHandler(_ common.StateSyncNotifierSubscriber) error {
+	return nil
+}
+
+// AsyncNotifyMissingTrieNode does nothing
+func (mtnn *MissingTrieNodesNotifier) AsyncNotifyMissingTrieNode(_ []byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mtnn *MissingTrieNodesNotifier) IsInterfaceNil() bool {
+	return mtnn == nil
+}
@@ -0,0 +1,222 @@
+// Code generated by abigen from the Adder ABI. DO NOT EDIT.
+
+package adder
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/serde"
+)
+
+const abiJSON = `{
+    "name": "Adder",
+    "constructor": {
+        "inputs": [
+            {
+                "name": "initial_value",
+                "type": "BigUint"
+            }
+        ],
+        "outputs": []
+    },
+    "endpoints": [
+        {
+            "name": "getSum",
+            "mutability": "readonly",
+            "inputs": [],
+            "outputs": [
+                {
+                    "type": "BigUint"
+                }
+            ]
+        },
+        {
+            "docs": [
+                "Add desired amount to the storage variable."
+            ],
+            "name": "add",
+            "mutability": "mutable",
+            "inputs": [
+                {
+                    "name": "value",
+                    "type": "BigUint"
+                }
+            ],
+            "outputs": []
+        }
+    ],
+    "events": [],
+    "types": {}
+}
+`
+
+var (
+	// ErrNilContractAddress signals that a nil contract address was provided
+	ErrNilContractAddress = errors.New("nil contract address")
+	// ErrNilQueryExecutor signals that a nil query executor was provided
+	ErrNilQueryExecutor = errors.New("nil query executor")
+	// ErrNilSender signals that a nil sender was provided
+	ErrNilSender = errors.New("nil sender")
+	// ErrNilNetworkConfig signals that a nil network config was provided
+	ErrNilNetworkConfig = errors.New("nil network config")
+)
+
+// QueryExecutor defines the component able to execute VM queries, such as the one created by blockchain.NewVmQueryGetter
+type QueryExecutor interface {
+	ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// CallArgs holds the fields of the transactions calling the endpoints of the Adder contract
+type CallArgs struct {
+	Sender core.AddressHandler
+	// Value is the EGLD value sent to the endpoint, nil meaning no value
+	Value *big.Int
+	// GasLimit is the gas needed for the execution of the endpoint, the gas needed for the data field being added
+	GasLimit      uint64
+	NetworkConfig *data.NetworkConfig
+}
+
+// Client is a typed client of the Adder contract. Views are executed as VM queries while the other endpoints
+// return the transactions calling them
+type Client struct {
+	contract      core.AddressHandler
+	queryExecutor QueryExecutor
+	codec         serde.AbiCodec
+}
+
+// NewClient creates a new typed client of the Adder contract deployed at the provided address
+func NewClient(contract core.AddressHandler, queryExecutor QueryExecutor) (*Client, error) {
+	if check.IfNil(contract) {
+		return nil, ErrNilContractAddress
+	}
+	if check.IfNil(queryExecutor) {
+		return nil, ErrNilQueryExecutor
+	}
+
+	codec, err := newCodec()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		contract:      contract,
+		queryExecutor: queryExecutor,
+		codec:         codec,
+	}, nil
+}
+
+func newCodec() (serde.AbiCodec, error) {
+	abi, err := serde.NewAbiFromJSON([]byte(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return serde.NewAbiCodec(abi)
+}
+
+// Address returns the address of the contract
+func (client *Client) Address() core.AddressHandler {
+	return client.contract
+}
+
+func (client *Client) query(ctx context.Context, endpointName string, values []interface{}) ([][]byte, error) {
+	args, err := client.codec.EncodeEndpointArguments(endpointName, values)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewVMQueryBuilder().
+		Address(client.contract).
+		Function(endpointName)
+	for _, arg := range args {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	return client.queryExecutor.ExecuteQueryFromBuilder(ctx, builder)
+}
+
+func (client *Client) call(args CallArgs, endpointName string, values []interface{}) (*transaction.FrontendTransaction, error) {
+	if check.IfNil(args.Sender) {
+		return nil, ErrNilSender
+	}
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	encodedArgs, err := client.codec.EncodeEndpointArguments(endpointName, values)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewTxDataBuilder().Function(endpointName)
+	for _, arg := range encodedArgs {
+		builder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	dataField, err := builder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value := "0"
+	if args.Value != nil {
+		value = args.Value.String()
+	}
+
+	return &transaction.FrontendTransaction{
+		Value:    value,
+		Receiver: client.contract.AddressAsBech32String(),
+		Sender:   args.Sender.AddressAsBech32String(),
+		GasPrice: args.NetworkConfig.MinGasPrice,
+		GasLimit: args.NetworkConfig.MinGasLimit + args.NetworkConfig.GasPerDataByte*uint64(len(dataField)) + args.GasLimit,
+		Data:     dataField,
+		ChainID:  args.NetworkConfig.ChainID,
+		Version:  args.NetworkConfig.MinTransactionVersion,
+	}, nil
+}
+
+// EncodeConstructorArguments encodes the arguments of the contract constructor as used in the deploy transaction
+func EncodeConstructorArguments(initialValue *big.Int) ([][]byte, error) {
+	codec, err := newCodec()
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.EncodeConstructorArguments([]interface{}{initialValue})
+}
+
+// GetSum executes the getSum view
+func (client *Client) GetSum(ctx context.Context) (*big.Int, error) {
+	returnData, err := client.query(ctx, "getSum", []interface{}{})
+	if err != nil {
+		var result *big.Int
+		return result, err
+	}
+
+	return client.DecodeGetSumOutputs(returnData)
+}
+
+// DecodeGetSumOutputs decodes the return data of the getSum endpoint
+func (client *Client) DecodeGetSumOutputs(returnData [][]byte) (*big.Int, error) {
+	var result *big.Int
+	err := client.codec.DecodeEndpointOutputsInto("getSum", returnData, &result)
+
+	return result, err
+}
+
+// Add creates the transaction calling the add endpoint. The returned transaction will not be signed and will
+// have the nonce set to 0
+//
+// Add desired amount to the storage variable.
+func (client *Client) Add(args CallArgs, value *big.Int) (*transaction.FrontendTransaction, error) {
+	return client.call(args, "add", []interface{}{value})
+}
@@ -0,0 +1,87 @@
+package main
+
+//go:generate go run ../../cmd/abigen -abi adder/adder.abi.json -package adder -out adder/adder.go
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/examples"
+	"github.com/multiversx/mx-sdk-go/examples/examplesAbigen/adder"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/examples/examplesAbigen")
+
+func main() {
+	args := blockchain.ArgsProxy{
+		ProxyURL:            examples.TestnetGateway,
+		Client:              nil,
+		SameScState:         false,
+		ShouldBeSynced:      false,
+		FinalityCheck:       false,
+		CacheExpirationTime: time.Minute,
+		EntityType:          core.Proxy,
+	}
+	ep, err := blockchain.NewProxy(args)
+	if err != nil {
+		log.Error("error creating proxy", "error", err)
+		return
+	}
+
+	queryGetter, err := blockchain.NewVmQueryGetter(blockchain.ArgsVmQueryGetter{
+		Proxy: ep,
+		Log:   log,
+	})
+	if err != nil {
+		log.Error("error creating VM query getter", "error", err)
+		return
+	}
+
+	contractAddress, err := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx")
+	if err != nil {
+		log.Error("error decoding the contract address", "error", err)
+		return
+	}
+
+	client, err := adder.NewClient(contractAddress, queryGetter)
+	if err != nil {
+		log.Error("error creating the adder client", "error", err)
+		return
+	}
+
+	sum, err := client.GetSum(context.Background())
+	if err != nil {
+		log.Error("error executing the getSum view", "error", err)
+		return
+	}
+	log.Info("getSum", "result", sum.String())
+
+	networkConfig, err := ep.GetNetworkConfig(context.Background())
+	if err != nil {
+		log.Error("error getting the network config", "error", err)
+		return
+	}
+
+	sender, err := data.NewAddressFromBech32String("erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th")
+	if err != nil {
+		log.Error("error decoding the sender address", "error", err)
+		return
+	}
+
+	// the returned transaction should have its nonce set and be signed by the sender before being sent
+	tx, err := client.Add(adder.CallArgs{
+		Sender:        sender,
+		GasLimit:      5000000,
+		NetworkConfig: networkConfig,
+	}, big.NewInt(10))
+	if err != nil {
+		log.Error("error building the add transaction", "error", err)
+		return
+	}
+	log.Info("add", "receiver", tx.Receiver, "gas limit", tx.GasLimit, "data", string(tx.Data))
+}
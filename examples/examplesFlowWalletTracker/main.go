@@ -15,12 +15,18 @@ import (
 	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/examples"
-	"github.com/multiversx/mx-sdk-go/examples/examplesFlowWalletTracker/mock"
 	"github.com/multiversx/mx-sdk-go/interactors"
 	"github.com/multiversx/mx-sdk-go/workflows"
+	"github.com/multiversx/mx-sdk-go/workflows/storage"
 )
 
-const timeToExecuteRequest = time.Second
+const (
+	timeToExecuteRequest = time.Second
+	stateFilename        = "walletTrackerState.json"
+	// the private keys of the tracked addresses are stored encrypted with this password, in a real application it
+	// should be read from a secure location
+	keysPassword = "example password"
+)
 
 var log = logger.GetOrCreate("mx-sdk-go/examples/examplesFlowWalletTracker")
 
@@ -37,6 +43,10 @@ type walletTracker interface {
 	GetLatestTrackedAddresses() []string
 }
 
+type trackableAddressesStore interface {
+	AddPrivateKey(privateKey []byte) (string, error)
+}
+
 func main() {
 	_ = logger.SetLogLevel("*:DEBUG")
 
@@ -65,9 +75,39 @@ func runApp() error {
 		return err
 	}
 
-	tap := mock.NewTrackableAddressProviderMock()
-	mnt := &mock.MemoryNonceTracker{}
-	err = setTestParams(ep, tap, mnt)
+	// the processed nonce and the tracked addresses are kept in a file, so a restarted application resumes with the
+	// hyper block following the last processed one
+	store, err := storage.NewFileStore(stateFilename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	tap, err := storage.NewAddressesStore(storage.ArgsAddressesStore{
+		Store:    store,
+		Password: keysPassword,
+	})
+	if err != nil {
+		return err
+	}
+	if len(tap.AllTrackableAddresses()) == 0 {
+		err = addTestAddresses(tap)
+		if err != nil {
+			return err
+		}
+	}
+
+	latestNonce, err := ep.GetLatestHyperBlockNonce(context.Background())
+	if err != nil {
+		return err
+	}
+	// the initial nonce is used only on the first run, so the example does not request ancient blocks
+	mnt, err := storage.NewNonceHandler(storage.ArgsNonceHandler{
+		Store:        store,
+		InitialNonce: latestNonce,
+	})
 	if err != nil {
 		return err
 	}
@@ -179,26 +219,18 @@ func generateMoveBalanceTransactionsAndSendThem(
 	}
 }
 
-func setTestParams(
-	ep workflows.ProxyHandler,
-	trackableAddresses *mock.TrackableAddressProviderMock,
-	tracker *mock.MemoryNonceTracker,
-) error {
-
-	nonce, err := ep.GetLatestHyperBlockNonce(context.Background())
-	if err != nil {
-		return err
+func addTestAddresses(trackableAddresses trackableAddressesStore) error {
+	// add 2 trackable addresses for demo purposes, the keys being persisted encrypted after the first run
+	for _, hexPrivateKey := range []string{
+		"45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6", // erd1j84k44nsqsme8r6e5aawutx0z2cd6cyx3wprkzdh73x2cf0kqvksa3snnq
+		"6babe6936d8b089a1f3b464a2050376462769782239b31dca4311e379b0391f3", // erd1kjjl7lssufpmml2yy4x6cklvnxdd40c4ym3dpw93vrflwchydt3q749v2z
+	} {
+		sk, _ := hex.DecodeString(hexPrivateKey)
+		_, err := trackableAddresses.AddPrivateKey(sk)
+		if err != nil {
+			return err
+		}
 	}
 
-	// since this is an example, and we are using a memory tracker, we need this to be executed each time as to not request ancient blocks
-	tracker.ProcessedNonce(nonce)
-
-	// add 2 trackable addresses for demo purposes
-	sk, _ := hex.DecodeString("45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6")
-	trackableAddresses.AddTrackableAddress("erd1j84k44nsqsme8r6e5aawutx0z2cd6cyx3wprkzdh73x2cf0kqvksa3snnq", sk)
-
-	sk, _ = hex.DecodeString("6babe6936d8b089a1f3b464a2050376462769782239b31dca4311e379b0391f3")
-	trackableAddresses.AddTrackableAddress("erd1kjjl7lssufpmml2yy4x6cklvnxdd40c4ym3dpw93vrflwchydt3q749v2z", sk)
-
 	return nil
 }
@@ -34,3 +34,30 @@ var ErrGapNonce = errors.New("gap nonce detected")
 
 // ErrNilAddressNonceHandlerCreator signals that a nil AddressNonceHandlerCreator was provided
 var ErrNilAddressNonceHandlerCreator = errors.New("nil AddressNonceHandlerCreator")
+
+// ErrNilTransactionInfo signals that a nil transaction info was provided
+var ErrNilTransactionInfo = errors.New("nil transaction info")
+
+// ErrNilTransactionCondition signals that a nil transaction condition was provided
+var ErrNilTransactionCondition = errors.New("nil transaction condition")
+
+// ErrTransactionEventNotFound signals that the transaction completed without emitting the awaited event
+var ErrTransactionEventNotFound = errors.New("transaction event not found")
+
+// ErrNilNetworkConfig signals that a nil network config was provided
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrInsufficientGasLimit signals that the gas limit does not cover the gas needed to move the balance
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrInvalidRelayedTransaction signals that the data field of a relayed transaction could not be decoded
+var ErrInvalidRelayedTransaction = errors.New("invalid relayed transaction")
+
+// ErrTransactionCostFailed signals that the transaction cost endpoint could not estimate the gas of the transaction
+var ErrTransactionCostFailed = errors.New("transaction cost failed")
+
+// ErrTransactionSimulationFailed signals that the transaction failed when simulated
+var ErrTransactionSimulationFailed = errors.New("transaction simulation failed")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
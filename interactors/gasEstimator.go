@@ -0,0 +1,115 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const percentDivisor = 100
+
+// ArgsGasEstimator is the DTO used in the gas estimator constructor
+type ArgsGasEstimator struct {
+	Proxy GasEstimatorProxy
+	// GasLimitMarginPercent is the safety margin added to the gas consumed by the execution of the transaction. The
+	// gas needed for moving the balance and for the data bytes is exact and does not receive the margin
+	GasLimitMarginPercent uint64
+}
+
+type gasEstimator struct {
+	proxy                 GasEstimatorProxy
+	gasLimitMarginPercent uint64
+}
+
+// NewGasEstimator creates a component able to estimate the gas limit and the fee of transactions
+func NewGasEstimator(args ArgsGasEstimator) (*gasEstimator, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &gasEstimator{
+		proxy:                 args.Proxy,
+		gasLimitMarginPercent: args.GasLimitMarginPercent,
+	}, nil
+}
+
+// EstimateTransaction sets the gas limit of the provided transaction from the gas units returned by the transaction
+// cost endpoint, including the safety margin, and simulates the transaction in order to catch the failures before
+// broadcasting it. The relayed transactions keep their gas limit as the cost endpoint can not estimate them.
+// The simulation does not check the signature, so the transaction can be signed after the estimation
+func (estimator *gasEstimator) EstimateTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TransactionEstimation, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	networkConfig, err := estimator.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUnits := tx.GasLimit
+	if !isRelayedTransaction(tx) {
+		gasUnits, err = estimator.requestGasUnits(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		tx.GasLimit = estimator.applyGasLimitMargin(networkConfig, tx, gasUnits)
+	}
+
+	simulation, err := estimator.proxy.SimulateTransaction(ctx, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	failReason := simulation.FailReason()
+	if len(failReason) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionSimulationFailed, failReason)
+	}
+
+	fee, err := computeTxFeeForGas(networkConfig, tx, gasUnits)
+	if err != nil {
+		return nil, err
+	}
+	maxFee, err := ComputeTxFee(networkConfig, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.TransactionEstimation{
+		GasUnits:   gasUnits,
+		GasLimit:   tx.GasLimit,
+		Fee:        fee,
+		MaxFee:     maxFee,
+		Simulation: simulation,
+	}, nil
+}
+
+func (estimator *gasEstimator) requestGasUnits(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+	cost, err := estimator.proxy.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(cost.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrTransactionCostFailed, cost.RetMessage)
+	}
+
+	return cost.TxCost, nil
+}
+
+func (estimator *gasEstimator) applyGasLimitMargin(networkConfig *data.NetworkConfig, tx *transaction.FrontendTransaction, gasUnits uint64) uint64 {
+	moveBalanceGas := computeMoveBalanceGas(networkConfig, tx.Data, isGuardedTransaction(tx.Version, tx.Options))
+	if gasUnits <= moveBalanceGas {
+		return gasUnits
+	}
+
+	executionGas := gasUnits - moveBalanceGas
+
+	return moveBalanceGas + executionGas*(percentDivisor+estimator.gasLimitMarginPercent)/percentDivisor
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (estimator *gasEstimator) IsInterfaceNil() bool {
+	return estimator == nil
+}
@@ -0,0 +1,207 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGasEstimator() ArgsGasEstimator {
+	return ArgsGasEstimator{
+		Proxy: &testsCommon.ProxyStub{
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return createTestNetworkConfig(), nil
+			},
+		},
+		GasLimitMarginPercent: 10,
+	}
+}
+
+func createTestContractCall() *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Value:    "0",
+		GasPrice: 1000000000,
+		Data:     []byte(testContractCallData),
+	}
+}
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy = nil
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, err := NewGasEstimator(createMockArgsGasEstimator())
+		assert.False(t, check.IfNil(estimator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGasEstimator_EstimateTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		estimation, err := estimator.EstimateTransaction(context.Background(), nil)
+		assert.Nil(t, estimation)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("network config error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).GetNetworkConfigCalled = func() (*data.NetworkConfig, error) {
+			return nil, expectedErr
+		}
+		estimator, _ := NewGasEstimator(args)
+		estimation, err := estimator.EstimateTransaction(context.Background(), createTestContractCall())
+		assert.Nil(t, estimation)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("transaction cost errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return nil, expectedErr
+		}
+		estimator, _ := NewGasEstimator(args)
+		estimation, err := estimator.EstimateTransaction(context.Background(), createTestContractCall())
+		assert.Nil(t, estimation)
+		assert.Equal(t, expectedErr, err)
+
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{RetMessage: "function not found"}, nil
+		}
+		estimator, _ = NewGasEstimator(args)
+		estimation, err = estimator.EstimateTransaction(context.Background(), createTestContractCall())
+		assert.Nil(t, estimation)
+		assert.True(t, errors.Is(err, ErrTransactionCostFailed))
+		assert.Contains(t, err.Error(), "function not found")
+	})
+	t.Run("simulation errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 1057500}, nil
+		}
+		args.Proxy.(*testsCommon.ProxyStub).SimulateTransactionCalled = func(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error) {
+			return nil, expectedErr
+		}
+		estimator, _ := NewGasEstimator(args)
+		estimation, err := estimator.EstimateTransaction(context.Background(), createTestContractCall())
+		assert.Nil(t, estimation)
+		assert.Equal(t, expectedErr, err)
+
+		args.Proxy.(*testsCommon.ProxyStub).SimulateTransactionCalled = func(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error) {
+			return &data.TransactionSimulationResults{
+				ReceiverShard: &data.SimulationResults{
+					Status:     transaction.TxStatusFail,
+					FailReason: "not enough funds",
+				},
+			}, nil
+		}
+		estimator, _ = NewGasEstimator(args)
+		estimation, err = estimator.EstimateTransaction(context.Background(), createTestContractCall())
+		assert.Nil(t, estimation)
+		assert.True(t, errors.Is(err, ErrTransactionSimulationFailed))
+		assert.Contains(t, err.Error(), "not enough funds")
+	})
+	t.Run("should add the margin to the execution gas", func(t *testing.T) {
+		t.Parallel()
+
+		simulation := &data.TransactionSimulationResults{
+			SimulationResults: data.SimulationResults{
+				Status: transaction.TxStatusSuccess,
+				ScResults: map[string]*transaction.ApiSmartContractResult{
+					"aa": {Data: "@6f6b"},
+				},
+			},
+		}
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 57500 + 1000000}, nil
+		}
+		args.Proxy.(*testsCommon.ProxyStub).SimulateTransactionCalled = func(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error) {
+			assert.False(t, checkSignature)
+			assert.Equal(t, uint64(57500+1100000), tx.GasLimit)
+
+			return simulation, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+		tx := createTestContractCall()
+		estimation, err := estimator.EstimateTransaction(context.Background(), tx)
+		require.Nil(t, err)
+
+		expectedEstimation := &data.TransactionEstimation{
+			GasUnits:   57500 + 1000000,
+			GasLimit:   57500 + 1100000,
+			Fee:        big.NewInt(57500*1000000000 + 1000000*10000000),
+			MaxFee:     big.NewInt(57500*1000000000 + 1100000*10000000),
+			Simulation: simulation,
+		}
+		assert.Equal(t, expectedEstimation, estimation)
+		assert.Equal(t, uint64(57500+1100000), tx.GasLimit)
+	})
+	t.Run("move balance transaction should not receive the margin", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{TxCost: 50000}, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+		tx := &transaction.FrontendTransaction{
+			Value:    "1",
+			GasPrice: 1000000000,
+		}
+		estimation, err := estimator.EstimateTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(50000), estimation.GasLimit)
+		assert.Equal(t, big.NewInt(50000000000000), estimation.Fee)
+		assert.Equal(t, estimation.Fee, estimation.MaxFee)
+	})
+	t.Run("relayed transaction should keep its gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.Proxy.(*testsCommon.ProxyStub).RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			assert.Fail(t, "should not have been called")
+			return nil, expectedErr
+		}
+		estimator, _ := NewGasEstimator(args)
+		tx := &transaction.FrontendTransaction{
+			GasPrice: 1000000000,
+			Data:     createTestRelayedTxV2Data([]byte(testContractCallData)),
+		}
+		gasLimit := uint64(50000+1500*len(tx.Data)) + 57500 + 1000000
+		tx.GasLimit = gasLimit
+
+		estimation, err := estimator.EstimateTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		assert.Equal(t, gasLimit, estimation.GasUnits)
+		assert.Equal(t, gasLimit, estimation.GasLimit)
+		assert.Equal(t, estimation.Fee, estimation.MaxFee)
+	})
+}
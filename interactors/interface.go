@@ -55,3 +55,26 @@ type AddressNonceHandlerCreator interface {
 	Create(proxy Proxy, address core.AddressHandler) (AddressNonceHandler, error)
 	IsInterfaceNil() bool
 }
+
+// TransactionOutcomeParser defines the component able to interpret the results and the logs of a transaction
+type TransactionOutcomeParser interface {
+	ParseTransactionInfo(info *data.TransactionInfo) (*data.TransactionOutcome, error)
+	ParseTransactionOnNetwork(tx *data.TransactionOnNetwork) (*data.TransactionOutcome, error)
+	IsInterfaceNil() bool
+}
+
+// AwaiterProxy holds the proxy functions used to follow the execution of a transaction
+type AwaiterProxy interface {
+	ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	IsInterfaceNil() bool
+}
+
+// GasEstimatorProxy holds the proxy functions used to estimate the gas and the fee of a transaction
+type GasEstimatorProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error)
+	IsInterfaceNil() bool
+}
@@ -56,10 +56,11 @@ func (anh *addressNonceHandler) getNonceUpdatingCurrent(ctx context.Context) (ui
 	return core.MaxUint64(anh.computedNonce, account.Nonce), nil
 }
 
-func (anh *addressNonceHandler) reSendTransactionsIfRequired(ctx context.Context) error {
+// reSendTransactionsIfRequired returns the number of transactions resent
+func (anh *addressNonceHandler) reSendTransactionsIfRequired(ctx context.Context) (int, error) {
 	account, err := anh.proxy.GetAccount(ctx, anh.address)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	anh.mut.Lock()
@@ -67,7 +68,7 @@ func (anh *addressNonceHandler) reSendTransactionsIfRequired(ctx context.Context
 		anh.transactions = make(map[uint64]*transaction.FrontendTransaction)
 		anh.mut.Unlock()
 
-		return nil
+		return 0, nil
 	}
 
 	resendableTxs := make([]*transaction.FrontendTransaction, 0, len(anh.transactions))
@@ -82,17 +83,17 @@ func (anh *addressNonceHandler) reSendTransactionsIfRequired(ctx context.Context
 	anh.mut.Unlock()
 
 	if len(resendableTxs) == 0 {
-		return nil
+		return 0, nil
 	}
 
 	hashes, err := anh.proxy.SendTransactions(ctx, resendableTxs)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	log.Debug("resent transactions", "address", anh.address.AddressAsBech32String(), "total txs", len(resendableTxs), "received hashes", len(hashes))
 
-	return nil
+	return len(resendableTxs), nil
 }
 
 func (anh *addressNonceHandler) sendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
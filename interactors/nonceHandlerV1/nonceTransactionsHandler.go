@@ -11,6 +11,7 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/disabled"
 	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
@@ -33,6 +34,7 @@ type nonceTransactionsHandlerV1 struct {
 	checkForDuplicates bool
 	cancelFunc         func()
 	intervalToResend   time.Duration
+	metricsHandler     core.MetricsHandler
 }
 
 // NewNonceTransactionHandlerV1 will create a new instance of the nonceTransactionsHandlerV1. It requires a Proxy implementation
@@ -51,6 +53,7 @@ func NewNonceTransactionHandlerV1(proxy interactors.Proxy, intervalToResend time
 		handlers:           make(map[string]*addressNonceHandler),
 		intervalToResend:   intervalToResend,
 		checkForDuplicates: checkForDuplicates,
+		metricsHandler:     &disabled.MetricsHandler{},
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -140,10 +143,27 @@ func (nth *nonceTransactionsHandlerV1) resendTransactions(ctx context.Context) {
 		}
 
 		resendCtx, cancel := context.WithTimeout(ctx, nth.intervalToResend)
-		err := anh.reSendTransactionsIfRequired(resendCtx)
+		numResent, err := anh.reSendTransactionsIfRequired(resendCtx)
 		log.LogIfError(err)
 		cancel()
+
+		if numResent > 0 {
+			nth.metricsHandler.AddResentTransactions(anh.address.AddressAsBech32String(), numResent)
+		}
+	}
+}
+
+// SetMetricsHandler sets the component receiving the resent transactions counts
+func (nth *nonceTransactionsHandlerV1) SetMetricsHandler(metricsHandler core.MetricsHandler) error {
+	if check.IfNil(metricsHandler) {
+		return interactors.ErrNilMetricsHandler
 	}
+
+	nth.mutHandlers.Lock()
+	nth.metricsHandler = metricsHandler
+	nth.mutHandlers.Unlock()
+
+	return nil
 }
 
 // ForceNonceReFetch will mark the addressNonceHandler to re-fetch its nonce from the blockchain account.
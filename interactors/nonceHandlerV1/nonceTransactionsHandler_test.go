@@ -469,3 +469,61 @@ func TestNonceTransactionsHandlerV1_ForceNonceReFetch(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.Equal(t, atomic.LoadUint64(&currentNonce), newNonce)
 }
+
+func TestNonceTransactionsHandlerV1_SetMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		nth, _ := NewNonceTransactionHandlerV1(&testsCommon.ProxyStub{}, time.Minute, false)
+		defer func() {
+			_ = nth.Close()
+		}()
+
+		assert.Equal(t, interactors.ErrNilMetricsHandler, nth.SetMetricsHandler(nil))
+	})
+	t.Run("resent transactions should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		testAddress, _ := data.NewAddressFromBech32String("erd1zptg3eu7uw0qvzhnu009lwxupcn6ntjxptj5gaxt8curhxjqr9tsqpsnht")
+		currentNonce := uint64(664)
+		proxy := &testsCommon.ProxyStub{
+			GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{
+					Nonce: currentNonce,
+				}, nil
+			},
+			SendTransactionsCalled: func(txs []*transaction.FrontendTransaction) ([]string, error) {
+				return make([]string, len(txs)), nil
+			},
+		}
+
+		reported := make(map[string]int)
+		metricsHandler := &testsCommon.MetricsHandlerStub{
+			AddResentTransactionsCalled: func(address string, numTransactions int) {
+				reported[address] += numTransactions
+			},
+		}
+
+		nth, _ := NewNonceTransactionHandlerV1(proxy, time.Minute, false)
+		defer func() {
+			_ = nth.Close()
+		}()
+		require.Nil(t, nth.SetMetricsHandler(metricsHandler))
+
+		numTxs := 5
+		txs := createMockTransactions(testAddress, numTxs, currentNonce)
+		for i := 0; i < numTxs; i++ {
+			_, err := nth.SendTransaction(context.Background(), txs[i])
+			require.Nil(t, err)
+		}
+
+		nth.resendTransactions(context.Background())
+		assert.Equal(t, map[string]int{testAddress.AddressAsBech32String(): numTxs - 1}, reported)
+
+		currentNonce += uint64(numTxs)
+		nth.resendTransactions(context.Background())
+		assert.Equal(t, map[string]int{testAddress.AddressAsBech32String(): numTxs - 1}, reported)
+	})
+}
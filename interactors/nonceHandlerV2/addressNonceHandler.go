@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/disabled"
 	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
@@ -32,10 +33,15 @@ type addressNonceHandler struct {
 	gasPrice               uint64
 	nonceUntilGasIncreased uint64
 	transactions           map[uint64]*transaction.FrontendTransaction
+	metricsHandler         sdkCore.MetricsHandler
 }
 
 // NewAddressNonceHandler returns a new instance of a addressNonceHandler
 func NewAddressNonceHandler(proxy interactors.Proxy, address sdkCore.AddressHandler) (interactors.AddressNonceHandler, error) {
+	return newAddressNonceHandler(proxy, address)
+}
+
+func newAddressNonceHandler(proxy interactors.Proxy, address sdkCore.AddressHandler) (*addressNonceHandler, error) {
 	if check.IfNil(proxy) {
 		return nil, interactors.ErrNilProxy
 	}
@@ -43,9 +49,10 @@ func NewAddressNonceHandler(proxy interactors.Proxy, address sdkCore.AddressHand
 		return nil, interactors.ErrNilAddress
 	}
 	return &addressNonceHandler{
-		address:      address,
-		proxy:        proxy,
-		transactions: make(map[uint64]*transaction.FrontendTransaction),
+		address:        address,
+		proxy:          proxy,
+		transactions:   make(map[uint64]*transaction.FrontendTransaction),
+		metricsHandler: &disabled.MetricsHandler{},
 	}, nil
 }
 
@@ -161,6 +168,7 @@ func (anh *addressNonceHandler) ReSendTransactionsIfRequired(ctx context.Context
 	}
 
 	log.Debug("resent transactions", "address", anh.address.AddressAsBech32String(), "total txs", len(resendableTxs), "received hashes", len(hashes))
+	anh.metricsHandler.AddResentTransactions(anh.address.AddressAsBech32String(), len(resendableTxs))
 
 	return nil
 }
@@ -1,16 +1,28 @@
 package nonceHandlerV2
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
 // AddressNonceHandlerCreator is used to create addressNonceHandler instances
-type AddressNonceHandlerCreator struct{}
+type AddressNonceHandlerCreator struct {
+	// MetricsHandler is optional, receiving the resent transactions counts of the created handlers when provided
+	MetricsHandler core.MetricsHandler
+}
 
 // Create will create
 func (anhc *AddressNonceHandlerCreator) Create(proxy interactors.Proxy, address core.AddressHandler) (interactors.AddressNonceHandler, error) {
-	return NewAddressNonceHandler(proxy, address)
+	anh, err := newAddressNonceHandler(proxy, address)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(anhc.MetricsHandler) {
+		anh.metricsHandler = anhc.MetricsHandler
+	}
+
+	return anh, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
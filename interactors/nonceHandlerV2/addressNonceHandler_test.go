@@ -331,6 +331,41 @@ func TestAddressNonceHandler_ReSendTransactionsIfRequired(t *testing.T) {
 		require.Equal(t, 1, len(anh.transactions))
 		require.Nil(t, err)
 	})
+	t.Run("should report the resent transactions", func(t *testing.T) {
+		t.Parallel()
+
+		blockchainNonce := uint64(100)
+		proxy := &testsCommon.ProxyStub{
+			GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: blockchainNonce - 1}, nil
+			},
+			SendTransactionsCalled: func(txs []*transaction.FrontendTransaction) ([]string, error) {
+				return make([]string, len(txs)), nil
+			},
+		}
+		numResent := 0
+		creator := &AddressNonceHandlerCreator{
+			MetricsHandler: &testsCommon.MetricsHandlerStub{
+				AddResentTransactionsCalled: func(address string, numTransactions int) {
+					assert.Equal(t, testAddress.AddressAsBech32String(), address)
+					numResent += numTransactions
+				},
+			},
+		}
+		handler, _ := creator.Create(proxy, testAddress)
+		anh := handler.(*addressNonceHandler)
+		for nonce := blockchainNonce; nonce < blockchainNonce+2; nonce++ {
+			tx := createDefaultTx()
+			tx.Nonce = nonce
+			_, err := anh.SendTransaction(context.Background(), &tx)
+			require.Nil(t, err)
+		}
+
+		anh.computedNonce = blockchainNonce + 2
+		err := anh.ReSendTransactionsIfRequired(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, 2, numResent)
+	})
 }
 
 func TestAddressNonceHandler_fetchGasPriceIfRequired(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/disabled"
 	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
@@ -18,6 +19,7 @@ type singleTransactionAddressNonceHandler struct {
 	gasPrice               uint64
 	nonceUntilGasIncreased uint64
 	proxy                  interactors.Proxy
+	metricsHandler         sdkCore.MetricsHandler
 }
 
 // NewSingleTransactionAddressNonceHandler returns a new instance of a singleTransactionAddressNonceHandler
@@ -29,8 +31,9 @@ func NewSingleTransactionAddressNonceHandler(proxy interactors.Proxy, address sd
 		return nil, interactors.ErrNilAddress
 	}
 	return &singleTransactionAddressNonceHandler{
-		address: address,
-		proxy:   proxy,
+		address:        address,
+		proxy:          proxy,
+		metricsHandler: &disabled.MetricsHandler{},
 	}, nil
 }
 
@@ -92,6 +95,7 @@ func (anh *singleTransactionAddressNonceHandler) ReSendTransactionsIfRequired(ct
 	}
 
 	log.Debug("resent transaction", "address", anh.address.AddressAsBech32String(), "hash", hash)
+	anh.metricsHandler.AddResentTransactions(anh.address.AddressAsBech32String(), 1)
 
 	return nil
 }
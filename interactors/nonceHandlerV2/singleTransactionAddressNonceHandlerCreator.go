@@ -1,16 +1,28 @@
 package nonceHandlerV2
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
 // SingleTransactionAddressNonceHandlerCreator is used to create singleTransactionAddressNonceHandler instances
-type SingleTransactionAddressNonceHandlerCreator struct{}
+type SingleTransactionAddressNonceHandlerCreator struct {
+	// MetricsHandler is optional, receiving the resent transactions counts of the created handlers when provided
+	MetricsHandler core.MetricsHandler
+}
 
 // Create will create
 func (anhc *SingleTransactionAddressNonceHandlerCreator) Create(proxy interactors.Proxy, address core.AddressHandler) (interactors.AddressNonceHandler, error) {
-	return NewSingleTransactionAddressNonceHandler(proxy, address)
+	anh, err := NewSingleTransactionAddressNonceHandler(proxy, address)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(anhc.MetricsHandler) {
+		anh.metricsHandler = anhc.MetricsHandler
+	}
+
+	return anh, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,244 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minimumAwaiterPollingInterval = time.Millisecond
+
+// TransactionCondition defines a predicate evaluated on the transaction fetched with its results
+type TransactionCondition func(tx *data.TransactionOnNetwork) bool
+
+// ArgsTransactionAwaiter is the DTO used in the transaction awaiter constructor
+type ArgsTransactionAwaiter struct {
+	Proxy           AwaiterProxy
+	PollingInterval time.Duration
+	// Timeout bounds each await call, 0 meaning that only the provided context can stop it
+	Timeout time.Duration
+	// PatienceBlocks is the number of blocks to wait on the destination shard after the completion condition holds
+	PatienceBlocks uint64
+}
+
+type transactionAwaiter struct {
+	proxy           AwaiterProxy
+	pollingInterval time.Duration
+	timeout         time.Duration
+	patienceBlocks  uint64
+}
+
+// NewTransactionAwaiter creates a component able to wait for transactions to reach a certain state
+func NewTransactionAwaiter(args ArgsTransactionAwaiter) (*transactionAwaiter, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if args.PollingInterval < minimumAwaiterPollingInterval {
+		return nil, fmt.Errorf("%w for PollingInterval", ErrInvalidValue)
+	}
+	if args.Timeout < 0 {
+		return nil, fmt.Errorf("%w for Timeout", ErrInvalidValue)
+	}
+
+	return &transactionAwaiter{
+		proxy:           args.Proxy,
+		pollingInterval: args.PollingInterval,
+		timeout:         args.Timeout,
+		patienceBlocks:  args.PatienceBlocks,
+	}, nil
+}
+
+// AwaitCompleted waits until the transaction reaches a terminal processed status (success, fail or invalid)
+// and returns the transaction with its results
+func (awaiter *transactionAwaiter) AwaitCompleted(ctx context.Context, txHash string) (*data.TransactionOnNetwork, error) {
+	return awaiter.await(ctx, txHash, func(ctx context.Context) (*data.TransactionOnNetwork, bool, error) {
+		completed, err := awaiter.isCompleted(ctx, txHash)
+		if err != nil || !completed {
+			return nil, false, err
+		}
+
+		tx, err := awaiter.getTransaction(ctx, txHash)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return tx, true, nil
+	})
+}
+
+// AwaitCondition waits until the provided condition holds for the transaction fetched with its results
+func (awaiter *transactionAwaiter) AwaitCondition(ctx context.Context, txHash string, condition TransactionCondition) (*data.TransactionOnNetwork, error) {
+	if condition == nil {
+		return nil, ErrNilTransactionCondition
+	}
+
+	return awaiter.await(ctx, txHash, func(ctx context.Context) (*data.TransactionOnNetwork, bool, error) {
+		tx, err := awaiter.getTransaction(ctx, txHash)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return tx, condition(tx), nil
+	})
+}
+
+// AwaitEvent waits until an event with the provided identifier shows up in the logs of the transaction or of its
+// smart contract results. An error is returned if the transaction completes without emitting the event
+func (awaiter *transactionAwaiter) AwaitEvent(ctx context.Context, txHash string, identifier string) (*data.TransactionOnNetwork, error) {
+	return awaiter.await(ctx, txHash, func(ctx context.Context) (*data.TransactionOnNetwork, bool, error) {
+		completed, err := awaiter.isCompleted(ctx, txHash)
+		if err != nil {
+			return nil, false, err
+		}
+
+		tx, err := awaiter.getTransaction(ctx, txHash)
+		if err != nil {
+			return nil, false, err
+		}
+		if hasEvent(tx, identifier) {
+			return tx, true, nil
+		}
+		if completed {
+			return nil, false, fmt.Errorf("%w: %s in transaction %s", ErrTransactionEventNotFound, identifier, txHash)
+		}
+
+		return nil, false, nil
+	})
+}
+
+type awaitCheck func(ctx context.Context) (*data.TransactionOnNetwork, bool, error)
+
+func (awaiter *transactionAwaiter) await(ctx context.Context, txHash string, checkHandler awaitCheck) (*data.TransactionOnNetwork, error) {
+	if awaiter.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, awaiter.timeout)
+		defer cancel()
+	}
+
+	for {
+		tx, done, err := checkHandler(ctx)
+		if errors.Is(err, ErrTransactionEventNotFound) {
+			return nil, err
+		}
+		if err != nil {
+			log.Debug("transactionAwaiter: error checking transaction, will retry", "hash", txHash, "error", err)
+		}
+		if done {
+			return awaiter.waitPatience(ctx, tx)
+		}
+
+		err = awaiter.sleep(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitPatience waits for the configured number of blocks on the transaction's destination shard and then
+// returns the transaction refetched with its results
+func (awaiter *transactionAwaiter) waitPatience(ctx context.Context, tx *data.TransactionOnNetwork) (*data.TransactionOnNetwork, error) {
+	if awaiter.patienceBlocks == 0 {
+		return tx, nil
+	}
+
+	targetNonce := uint64(0)
+	for {
+		status, err := awaiter.proxy.GetNetworkStatus(ctx, tx.DestinationShard)
+		if err != nil {
+			log.Debug("transactionAwaiter: error getting the network status, will retry", "shard", tx.DestinationShard, "error", err)
+		} else {
+			if targetNonce == 0 {
+				targetNonce = status.Nonce + awaiter.patienceBlocks
+			}
+			if status.Nonce >= targetNonce {
+				break
+			}
+		}
+
+		err = awaiter.sleep(ctx, tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	refreshed, err := awaiter.getTransaction(ctx, tx.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshed, nil
+}
+
+func (awaiter *transactionAwaiter) sleep(ctx context.Context, txHash string) error {
+	timer := time.NewTimer(awaiter.pollingInterval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("%w while awaiting transaction %s", ctx.Err(), txHash)
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (awaiter *transactionAwaiter) isCompleted(ctx context.Context, txHash string) (bool, error) {
+	status, err := awaiter.proxy.ProcessTransactionStatus(ctx, txHash)
+	if err != nil {
+		return false, err
+	}
+
+	switch status {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (awaiter *transactionAwaiter) getTransaction(ctx context.Context, txHash string) (*data.TransactionOnNetwork, error) {
+	info, err := awaiter.proxy.GetTransactionInfoWithResults(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, ErrNilTransactionInfo
+	}
+
+	return &info.Data.Transaction, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (awaiter *transactionAwaiter) IsInterfaceNil() bool {
+	return awaiter == nil
+}
+
+func hasEvent(tx *data.TransactionOnNetwork, identifier string) bool {
+	if logsHaveEvent(tx.Logs, identifier) {
+		return true
+	}
+	for _, scr := range tx.ScResults {
+		if scr != nil && logsHaveEvent(scr.Logs, identifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func logsHaveEvent(logs *transaction.ApiLogs, identifier string) bool {
+	if logs == nil {
+		return false
+	}
+	for _, event := range logs.Events {
+		if event != nil && event.Identifier == identifier {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,251 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTransactionAwaiter() ArgsTransactionAwaiter {
+	return ArgsTransactionAwaiter{
+		Proxy:           &testsCommon.ProxyStub{},
+		PollingInterval: time.Millisecond,
+		Timeout:         time.Second,
+	}
+}
+
+func createTransactionInfoWithEvent(hash string, identifier string) *data.TransactionInfo {
+	info := &data.TransactionInfo{}
+	info.Data.Transaction.Hash = hash
+	if len(identifier) > 0 {
+		info.Data.Transaction.ScResults = []*transaction.ApiSmartContractResult{
+			{
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{{Identifier: identifier}},
+				},
+			},
+		}
+	}
+
+	return info
+}
+
+func TestNewTransactionAwaiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = nil
+		awaiter, err := NewTransactionAwaiter(args)
+		assert.True(t, check.IfNil(awaiter))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.PollingInterval = 0
+		awaiter, err := NewTransactionAwaiter(args)
+		assert.True(t, check.IfNil(awaiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("negative timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Timeout = -1
+		awaiter, err := NewTransactionAwaiter(args)
+		assert.True(t, check.IfNil(awaiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		awaiter, err := NewTransactionAwaiter(createMockArgsTransactionAwaiter())
+		assert.False(t, check.IfNil(awaiter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTransactionAwaiter_AwaitCompleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should wait for a terminal status and retry on errors", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				switch atomic.AddInt32(&numCalls, 1) {
+				case 1:
+					return transaction.TxStatusFail, errors.New("transaction not found")
+				case 2:
+					return transaction.TxStatusPending, nil
+				default:
+					return transaction.TxStatusFail, nil
+				}
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfoWithEvent(hash, ""), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitCompleted(context.Background(), "hash")
+		require.Nil(t, err)
+		assert.Equal(t, "hash", tx.Hash)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Timeout = time.Millisecond * 20
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				return transaction.TxStatusPending, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitCompleted(context.Background(), "hash")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+	t.Run("context cancellation should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Timeout = 0
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				return transaction.TxStatusPending, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			cancel()
+		}()
+
+		tx, err := awaiter.AwaitCompleted(ctx, "hash")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+	t.Run("patience should wait for the extra blocks", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(10)
+		numInfoCalls := int32(0)
+		args := createMockArgsTransactionAwaiter()
+		args.PatienceBlocks = 2
+		args.Proxy = &testsCommon.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				info := createTransactionInfoWithEvent(hash, "")
+				info.Data.Transaction.DestinationShard = 1
+				info.Data.Transaction.Nonce = uint64(atomic.AddInt32(&numInfoCalls, 1))
+				return info, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				assert.Equal(t, uint32(1), shardID)
+				nonce++
+				return &data.NetworkStatus{Nonce: nonce}, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitCompleted(context.Background(), "hash")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(13), nonce)
+		assert.Equal(t, uint64(2), tx.Nonce, "the transaction should be fetched again after the patience blocks")
+	})
+}
+
+func TestTransactionAwaiter_AwaitCondition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil condition should error", func(t *testing.T) {
+		t.Parallel()
+
+		awaiter, _ := NewTransactionAwaiter(createMockArgsTransactionAwaiter())
+		tx, err := awaiter.AwaitCondition(context.Background(), "hash", nil)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilTransactionCondition, err)
+	})
+	t.Run("should wait for the condition", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint64(0)
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				info := createTransactionInfoWithEvent(hash, "")
+				info.Data.Transaction.BlockNonce = atomic.AddUint64(&numCalls, 1)
+				return info, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitCondition(context.Background(), "hash", func(tx *data.TransactionOnNetwork) bool {
+			return tx.BlockNonce >= 3
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), tx.BlockNonce)
+	})
+}
+
+func TestTransactionAwaiter_AwaitEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("event emitted by a smart contract result should work", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				return transaction.TxStatusPending, nil
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				if atomic.AddInt32(&numCalls, 1) < 3 {
+					return createTransactionInfoWithEvent(hash, ""), nil
+				}
+				return createTransactionInfoWithEvent(hash, "deposit"), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitEvent(context.Background(), "hash", "deposit")
+		require.Nil(t, err)
+		assert.True(t, hasEvent(tx, "deposit"))
+	})
+	t.Run("completed transaction without the event should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfoWithEvent(hash, "other"), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		tx, err := awaiter.AwaitEvent(context.Background(), "hash", "deposit")
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrTransactionEventNotFound))
+	})
+}
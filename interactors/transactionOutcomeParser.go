@@ -0,0 +1,215 @@
+package interactors
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	resultsSeparator = "@"
+	returnCodeOk     = "ok"
+	userErrorCode    = "user error"
+
+	signalErrorEvent      = "signalError"
+	internalVMErrorsEvent = "internalVMErrors"
+	writeLogEvent         = "writeLog"
+
+	txStatusReceived          = "received"
+	txStatusPartiallyExecuted = "partially-executed"
+)
+
+type transactionOutcomeParser struct{}
+
+// NewTransactionOutcomeParser creates a component able to interpret the smart contract results and the logs
+// of a transaction fetched with its results
+func NewTransactionOutcomeParser() *transactionOutcomeParser {
+	return &transactionOutcomeParser{}
+}
+
+// ParseTransactionInfo interprets the transaction info fetched through the GetTransactionInfoWithResults call
+func (parser *transactionOutcomeParser) ParseTransactionInfo(info *data.TransactionInfo) (*data.TransactionOutcome, error) {
+	if info == nil {
+		return nil, ErrNilTransactionInfo
+	}
+
+	return parser.ParseTransactionOnNetwork(&info.Data.Transaction)
+}
+
+// ParseTransactionOnNetwork interprets the status, the smart contract results and the logs of the provided transaction.
+// The return code and return data are extracted from the `@<return code>@<data>...` smart contract result, or from the
+// writeLog event for the intra-shard calls, while the error messages are extracted from the signalError and
+// internalVMErrors events
+func (parser *transactionOutcomeParser) ParseTransactionOnNetwork(tx *data.TransactionOnNetwork) (*data.TransactionOutcome, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	outcome := &data.TransactionOutcome{
+		Hash:       tx.Hash,
+		ReturnData: make([][]byte, 0),
+		Errors:     make([]string, 0),
+		Events:     parser.collectEvents(tx),
+	}
+
+	parser.parseResults(tx, outcome)
+	parser.parseErrorEvents(outcome)
+	outcome.Status = parser.computeStatus(tx.Status, outcome)
+
+	return outcome, nil
+}
+
+func (parser *transactionOutcomeParser) collectEvents(tx *data.TransactionOnNetwork) []*data.TransactionOutcomeEvent {
+	events := make([]*data.TransactionOutcomeEvent, 0)
+	events = appendEvents(events, tx.Logs, tx.Hash)
+	for _, scr := range tx.ScResults {
+		if scr == nil {
+			continue
+		}
+		events = appendEvents(events, scr.Logs, scr.Hash)
+	}
+
+	return events
+}
+
+func appendEvents(events []*data.TransactionOutcomeEvent, logs *transaction.ApiLogs, sourceHash string) []*data.TransactionOutcomeEvent {
+	if logs == nil {
+		return events
+	}
+
+	for _, event := range logs.Events {
+		if event == nil {
+			continue
+		}
+		events = append(events, &data.TransactionOutcomeEvent{
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Topics:     event.Topics,
+			Data:       event.Data,
+			SourceHash: sourceHash,
+		})
+	}
+
+	return events
+}
+
+// parseResults looks for the smart contract result holding the return code, preferring the ones that are not
+// gas refunds, and falls back to the writeLog event emitted by the intra-shard contract calls
+func (parser *transactionOutcomeParser) parseResults(tx *data.TransactionOnNetwork, outcome *data.TransactionOutcome) {
+	var refund *transaction.ApiSmartContractResult
+	for _, scr := range tx.ScResults {
+		if scr == nil {
+			continue
+		}
+		_, _, isResult := parseResultsData(scr.Data)
+		if !isResult {
+			continue
+		}
+		if scr.IsRefund {
+			if refund == nil {
+				refund = scr
+			}
+			continue
+		}
+
+		parser.setResults(outcome, scr.Data, scr.ReturnMessage)
+		return
+	}
+
+	for _, event := range outcome.Events {
+		if event.Identifier != writeLogEvent {
+			continue
+		}
+		_, _, isResult := parseResultsData(string(event.Data))
+		if isResult {
+			parser.setResults(outcome, string(event.Data), "")
+			return
+		}
+	}
+
+	if refund != nil {
+		parser.setResults(outcome, refund.Data, "")
+	}
+}
+
+func (parser *transactionOutcomeParser) setResults(outcome *data.TransactionOutcome, resultsData string, returnMessage string) {
+	returnCode, returnData, _ := parseResultsData(resultsData)
+	outcome.ReturnCode = returnCode
+	outcome.ReturnData = returnData
+	if returnCode != returnCodeOk {
+		outcome.ReturnMessage = returnMessage
+	}
+}
+
+func (parser *transactionOutcomeParser) parseErrorEvents(outcome *data.TransactionOutcome) {
+	for _, event := range outcome.Events {
+		switch event.Identifier {
+		case signalErrorEvent:
+			message := ""
+			if len(event.Topics) > 1 {
+				message = string(event.Topics[1])
+			}
+			outcome.Errors = append(outcome.Errors, message)
+
+			if len(outcome.ReturnCode) == 0 || outcome.ReturnCode == returnCodeOk {
+				outcome.ReturnCode = userErrorCode
+				returnCode, _, isResult := parseResultsData(string(event.Data))
+				if isResult {
+					outcome.ReturnCode = returnCode
+				}
+			}
+			if len(outcome.ReturnMessage) == 0 {
+				outcome.ReturnMessage = message
+			}
+		case internalVMErrorsEvent:
+			outcome.Errors = append(outcome.Errors, strings.TrimSpace(string(event.Data)))
+		}
+	}
+}
+
+func (parser *transactionOutcomeParser) computeStatus(txStatus string, outcome *data.TransactionOutcome) data.TransactionExecutionStatus {
+	switch transaction.TxStatus(txStatus) {
+	case transaction.TxStatusPending, txStatusReceived, txStatusPartiallyExecuted:
+		return data.TransactionExecutionPending
+	case transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return data.TransactionExecutionFailed
+	}
+
+	hasErrorCode := len(outcome.ReturnCode) > 0 && outcome.ReturnCode != returnCodeOk
+	if hasErrorCode || len(outcome.Errors) > 0 {
+		return data.TransactionExecutionFailed
+	}
+
+	return data.TransactionExecutionSuccess
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (parser *transactionOutcomeParser) IsInterfaceNil() bool {
+	return parser == nil
+}
+
+// parseResultsData splits a `@<hex return code>@<hex data>@...` string in the return code and the decoded data
+func parseResultsData(resultsData string) (string, [][]byte, bool) {
+	if !strings.HasPrefix(resultsData, resultsSeparator) {
+		return "", nil, false
+	}
+
+	parts := strings.Split(resultsData[len(resultsSeparator):], resultsSeparator)
+	returnCode, err := hex.DecodeString(parts[0])
+	if err != nil || len(returnCode) == 0 {
+		return "", nil, false
+	}
+
+	returnData := make([][]byte, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		decoded, errDecode := hex.DecodeString(part)
+		if errDecode != nil {
+			return "", nil, false
+		}
+		returnData = append(returnData, decoded)
+	}
+
+	return string(returnCode), returnData, true
+}
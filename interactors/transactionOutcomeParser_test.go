@@ -0,0 +1,209 @@
+package interactors
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testContractAddress = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	testCallerAddress   = "erd1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5sx3xr8r"
+)
+
+func createTransactionInfo(status string, scrs []*transaction.ApiSmartContractResult, events ...*transaction.Events) *data.TransactionInfo {
+	info := &data.TransactionInfo{}
+	info.Data.Transaction = data.TransactionOnNetwork{
+		Hash:      "tx hash",
+		Status:    status,
+		ScResults: scrs,
+	}
+	if len(events) > 0 {
+		info.Data.Transaction.Logs = &transaction.ApiLogs{
+			Address: testContractAddress,
+			Events:  events,
+		}
+	}
+
+	return info
+}
+
+func TestNewTransactionOutcomeParser(t *testing.T) {
+	t.Parallel()
+
+	parser := NewTransactionOutcomeParser()
+	assert.False(t, check.IfNil(parser))
+}
+
+func TestTransactionOutcomeParser_ParseTransactionInfo(t *testing.T) {
+	t.Parallel()
+
+	parser := NewTransactionOutcomeParser()
+
+	t.Run("nil transaction info should error", func(t *testing.T) {
+		t.Parallel()
+
+		outcome, err := parser.ParseTransactionInfo(nil)
+		assert.Nil(t, outcome)
+		assert.Equal(t, ErrNilTransactionInfo, err)
+
+		outcome, err = parser.ParseTransactionOnNetwork(nil)
+		assert.Nil(t, outcome)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("successful cross-shard call should return the decoded results", func(t *testing.T) {
+		t.Parallel()
+
+		scrs := []*transaction.ApiSmartContractResult{
+			{
+				Hash:     "refund hash",
+				Data:     "@6f6b",
+				IsRefund: true,
+			},
+			{
+				Hash: "transfer hash",
+				Data: "ESDTTransfer@544b4e2d313233343536@0a",
+			},
+			{
+				Hash: "results hash",
+				Data: "@6f6b@0a@@74776f",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Address: testCallerAddress, Identifier: "completedTxEvent", Topics: [][]byte{[]byte("tx hash")}},
+					},
+				},
+			},
+		}
+		info := createTransactionInfo("success", scrs, &transaction.Events{
+			Address:    testContractAddress,
+			Identifier: "deposit",
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+		})
+
+		outcome, err := parser.ParseTransactionInfo(info)
+		require.Nil(t, err)
+		assert.True(t, outcome.IsSuccess())
+		assert.Equal(t, "tx hash", outcome.Hash)
+		assert.Equal(t, "ok", outcome.ReturnCode)
+		assert.Equal(t, [][]byte{{10}, {}, []byte("two")}, outcome.ReturnData)
+		assert.Empty(t, outcome.Errors)
+		require.Equal(t, 2, len(outcome.Events))
+		assert.Equal(t, "deposit", outcome.Events[0].Identifier)
+		assert.Equal(t, "tx hash", outcome.Events[0].SourceHash)
+		assert.Equal(t, "results hash", outcome.Events[1].SourceHash)
+		assert.Equal(t, 1, len(outcome.FindEvents("completedTxEvent")))
+		assert.Empty(t, outcome.FindEvents("missing"))
+	})
+	t.Run("successful intra-shard call should return the results from the writeLog event", func(t *testing.T) {
+		t.Parallel()
+
+		scrs := []*transaction.ApiSmartContractResult{
+			{
+				Data:     "@6f6b",
+				IsRefund: true,
+			},
+		}
+		info := createTransactionInfo("success", scrs, &transaction.Events{
+			Address:    testCallerAddress,
+			Identifier: "writeLog",
+			Data:       []byte("@6f6b@2a"),
+		})
+
+		outcome, err := parser.ParseTransactionInfo(info)
+		require.Nil(t, err)
+		assert.True(t, outcome.IsSuccess())
+		assert.Equal(t, "ok", outcome.ReturnCode)
+		assert.Equal(t, [][]byte{{42}}, outcome.ReturnData)
+	})
+	t.Run("only a refund should return an empty results list", func(t *testing.T) {
+		t.Parallel()
+
+		scrs := []*transaction.ApiSmartContractResult{
+			{
+				Data:     "@6f6b",
+				IsRefund: true,
+			},
+		}
+
+		outcome, err := parser.ParseTransactionInfo(createTransactionInfo("success", scrs))
+		require.Nil(t, err)
+		assert.True(t, outcome.IsSuccess())
+		assert.Equal(t, "ok", outcome.ReturnCode)
+		assert.Empty(t, outcome.ReturnData)
+	})
+	t.Run("signalError should mark the transaction as failed", func(t *testing.T) {
+		t.Parallel()
+
+		info := createTransactionInfo("success", nil, &transaction.Events{
+			Address:    testContractAddress,
+			Identifier: "signalError",
+			Topics:     [][]byte{[]byte("caller"), []byte("insufficient funds")},
+			Data:       []byte("@75736572206572726f72"),
+		})
+
+		outcome, err := parser.ParseTransactionInfo(info)
+		require.Nil(t, err)
+		assert.True(t, outcome.IsFailed())
+		assert.Equal(t, "user error", outcome.ReturnCode)
+		assert.Equal(t, "insufficient funds", outcome.ReturnMessage)
+		assert.Equal(t, []string{"insufficient funds"}, outcome.Errors)
+	})
+	t.Run("failed result should keep the return message", func(t *testing.T) {
+		t.Parallel()
+
+		scrs := []*transaction.ApiSmartContractResult{
+			{
+				Data:          "@6f7574206f6620676173",
+				ReturnMessage: "not enough gas",
+			},
+		}
+		info := createTransactionInfo("fail", scrs, &transaction.Events{
+			Identifier: "internalVMErrors",
+			Data:       []byte("\n\truntime.go:831 [out of gas]\n"),
+		})
+
+		outcome, err := parser.ParseTransactionInfo(info)
+		require.Nil(t, err)
+		assert.True(t, outcome.IsFailed())
+		assert.Equal(t, "out of gas", outcome.ReturnCode)
+		assert.Equal(t, "not enough gas", outcome.ReturnMessage)
+		assert.Equal(t, []string{"runtime.go:831 [out of gas]"}, outcome.Errors)
+	})
+	t.Run("invalid transaction should be failed", func(t *testing.T) {
+		t.Parallel()
+
+		outcome, err := parser.ParseTransactionInfo(createTransactionInfo("invalid", nil))
+		require.Nil(t, err)
+		assert.True(t, outcome.IsFailed())
+		assert.Empty(t, outcome.ReturnCode)
+	})
+	t.Run("pending transaction should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		for _, status := range []string{"pending", "received", "partially-executed"} {
+			outcome, err := parser.ParseTransactionInfo(createTransactionInfo(status, nil))
+			require.Nil(t, err)
+			assert.True(t, outcome.IsPending(), status)
+		}
+	})
+	t.Run("malformed results data should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		scrs := []*transaction.ApiSmartContractResult{
+			{Data: "@zz@0a"},
+			{Data: "@6f6b@not hex"},
+			{Data: "@"},
+		}
+
+		outcome, err := parser.ParseTransactionInfo(createTransactionInfo("success", scrs))
+		require.Nil(t, err)
+		assert.True(t, outcome.IsSuccess())
+		assert.Empty(t, outcome.ReturnCode)
+		assert.Empty(t, outcome.ReturnData)
+	})
+}
@@ -0,0 +1,136 @@
+package interactors
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	relayedTxV1Prefix       = chainCore.RelayedTransaction + "@"
+	relayedTxV2Prefix       = chainCore.RelayedTransactionV2 + "@"
+	relayedTxV2NumArguments = 4
+	relayedTxV2DataArgIndex = 2
+	minGuardedTxVersion     = 2
+	defaultGasPriceModifier = 1.0
+)
+
+// ComputeTxFee computes the maximum fee of the transaction, paid for its whole gas limit. The gas needed for moving
+// the balance and for the data bytes is paid at the full gas price, while the rest of the gas, used by the execution,
+// is paid at the gas price adjusted by the network's gas price modifier. For the relayed transactions, the relayer
+// pays the move balance gas of the relayed transaction while the rest of the gas is computed as the inner transaction's fee
+func ComputeTxFee(networkConfig *data.NetworkConfig, tx *transaction.FrontendTransaction) (*big.Int, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	return computeTxFeeForGas(networkConfig, tx, tx.GasLimit)
+}
+
+func computeTxFeeForGas(networkConfig *data.NetworkConfig, tx *transaction.FrontendTransaction, gasUsed uint64) (*big.Int, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	moveBalanceGas := computeMoveBalanceGas(networkConfig, tx.Data, isGuardedTransaction(tx.Version, tx.Options))
+	if gasUsed < moveBalanceGas {
+		return nil, fmt.Errorf("%w: %d provided, at least %d needed", ErrInsufficientGasLimit, gasUsed, moveBalanceGas)
+	}
+
+	innerTx, isRelayed, err := extractRelayedInnerTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+	if !isRelayed {
+		return computeFeeForProcessing(networkConfig, tx.GasPrice, moveBalanceGas, gasUsed), nil
+	}
+
+	relayerFee := computeFeeForProcessing(networkConfig, tx.GasPrice, moveBalanceGas, moveBalanceGas)
+	innerMoveBalanceGas := computeMoveBalanceGas(networkConfig, innerTx.Data, isGuardedTransaction(innerTx.Version, innerTx.Options))
+	innerGasUsed := gasUsed - moveBalanceGas
+	if innerGasUsed < innerMoveBalanceGas {
+		return nil, fmt.Errorf("%w for the inner transaction: %d provided, at least %d needed",
+			ErrInsufficientGasLimit, innerGasUsed, innerMoveBalanceGas)
+	}
+
+	innerFee := computeFeeForProcessing(networkConfig, innerTx.GasPrice, innerMoveBalanceGas, innerGasUsed)
+
+	return relayerFee.Add(relayerFee, innerFee), nil
+}
+
+func computeMoveBalanceGas(networkConfig *data.NetworkConfig, dataField []byte, isGuarded bool) uint64 {
+	gasLimit := networkConfig.MinGasLimit + networkConfig.GasPerDataByte*uint64(len(dataField))
+	if isGuarded {
+		gasLimit += networkConfig.ExtraGasLimitGuardedTx
+	}
+
+	return gasLimit
+}
+
+func computeFeeForProcessing(networkConfig *data.NetworkConfig, gasPrice uint64, moveBalanceGas uint64, gasUsed uint64) *big.Int {
+	gasPriceModifier := networkConfig.GasPriceModifier
+	if gasPriceModifier <= 0 {
+		gasPriceModifier = defaultGasPriceModifier
+	}
+	gasPriceForProcessing := uint64(float64(gasPrice) * gasPriceModifier)
+
+	moveBalanceFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(gasPrice))
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(gasUsed-moveBalanceGas), big.NewInt(0).SetUint64(gasPriceForProcessing))
+
+	return moveBalanceFee.Add(moveBalanceFee, processingFee)
+}
+
+func isGuardedTransaction(version uint32, options uint32) bool {
+	return version >= minGuardedTxVersion && options&transaction.MaskGuardedTransaction > 0
+}
+
+func isRelayedTransaction(tx *transaction.FrontendTransaction) bool {
+	dataField := string(tx.Data)
+
+	return strings.HasPrefix(dataField, relayedTxV1Prefix) || strings.HasPrefix(dataField, relayedTxV2Prefix)
+}
+
+// extractRelayedInnerTransaction returns the inner transaction fields relevant for the fee computation. The relayed
+// v2 inner transactions share the gas price of the relayed transaction and can not be guarded
+func extractRelayedInnerTransaction(tx *transaction.FrontendTransaction) (*transaction.Transaction, bool, error) {
+	dataField := string(tx.Data)
+	switch {
+	case strings.HasPrefix(dataField, relayedTxV2Prefix):
+		arguments := strings.Split(strings.TrimPrefix(dataField, relayedTxV2Prefix), "@")
+		if len(arguments) != relayedTxV2NumArguments {
+			return nil, false, fmt.Errorf("%w: relayed v2 transaction with %d arguments", ErrInvalidRelayedTransaction, len(arguments))
+		}
+		innerData, err := hex.DecodeString(arguments[relayedTxV2DataArgIndex])
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %s for the inner transaction data", ErrInvalidRelayedTransaction, err.Error())
+		}
+
+		return &transaction.Transaction{
+			GasPrice: tx.GasPrice,
+			Data:     innerData,
+		}, true, nil
+	case strings.HasPrefix(dataField, relayedTxV1Prefix):
+		innerTxJson, err := hex.DecodeString(strings.TrimPrefix(dataField, relayedTxV1Prefix))
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %s for the inner transaction", ErrInvalidRelayedTransaction, err.Error())
+		}
+		innerTx := &transaction.Transaction{}
+		err = json.Unmarshal(innerTxJson, innerTx)
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %s for the inner transaction", ErrInvalidRelayedTransaction, err.Error())
+		}
+
+		return innerTx, true, nil
+	default:
+		return nil, false, nil
+	}
+}
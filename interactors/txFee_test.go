@@ -0,0 +1,195 @@
+package interactors
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractCallData = "claim"
+
+func createTestNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:                "T",
+		GasPerDataByte:         1500,
+		MinGasLimit:            50000,
+		MinGasPrice:            1000000000,
+		MinTransactionVersion:  1,
+		ExtraGasLimitGuardedTx: 50000,
+		GasPriceModifier:       0.01,
+	}
+}
+
+func createTestRelayedTxV2Data(innerData []byte) []byte {
+	arguments := []string{
+		hex.EncodeToString(make([]byte, 32)),
+		"01",
+		hex.EncodeToString(innerData),
+		"abcd",
+	}
+
+	return []byte(relayedTxV2Prefix + strings.Join(arguments, "@"))
+}
+
+func createTestRelayedTxV1Data(t *testing.T, innerTx *transaction.Transaction) []byte {
+	innerTxJson, err := json.Marshal(innerTx)
+	require.Nil(t, err)
+
+	return []byte(relayedTxV1Prefix + hex.EncodeToString(innerTxJson))
+}
+
+func TestComputeTxFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		fee, err := ComputeTxFee(createTestNetworkConfig(), nil)
+		assert.Nil(t, fee)
+		assert.Equal(t, ErrNilTransaction, err)
+
+		fee, err = ComputeTxFee(nil, &transaction.FrontendTransaction{})
+		assert.Nil(t, fee)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("gas limit not covering the data bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 50000,
+			GasPrice: 1000000000,
+			Data:     []byte(testContractCallData),
+		}
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, fee)
+		assert.True(t, errors.Is(err, ErrInsufficientGasLimit))
+	})
+	t.Run("move balance transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 50000,
+			GasPrice: 1000000000,
+		}
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(50000000000000), fee)
+	})
+	t.Run("contract call should apply the gas price modifier on the execution gas", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 57500 + 1000000,
+			GasPrice: 1000000000,
+			Data:     []byte(testContractCallData),
+		}
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(57500*1000000000+1000000*10000000), fee)
+	})
+	t.Run("missing gas price modifier should use the full gas price", func(t *testing.T) {
+		t.Parallel()
+
+		networkConfig := createTestNetworkConfig()
+		networkConfig.GasPriceModifier = 0
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 57500 + 1000000,
+			GasPrice: 1000000000,
+			Data:     []byte(testContractCallData),
+		}
+		fee, err := ComputeTxFee(networkConfig, tx)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1057500*1000000000), fee)
+	})
+	t.Run("guarded transaction should pay the extra gas at the full gas price", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 107500 + 1000000,
+			GasPrice: 1000000000,
+			Data:     []byte(testContractCallData),
+			Version:  2,
+			Options:  transaction.MaskGuardedTransaction,
+		}
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(107500*1000000000+1000000*10000000), fee)
+	})
+	t.Run("relayed v1 transaction should use the inner transaction's data and gas price", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.Transaction{
+			GasPrice: 2000000000,
+			Data:     []byte(testContractCallData),
+		}
+		tx := &transaction.FrontendTransaction{
+			GasPrice: 1000000000,
+			Data:     createTestRelayedTxV1Data(t, innerTx),
+		}
+		relayerGas := uint64(50000 + 1500*len(tx.Data))
+		tx.GasLimit = relayerGas + 57500 + 1000000
+
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, err)
+		expectedFee := big.NewInt(0).SetUint64(relayerGas * 1000000000)
+		expectedFee.Add(expectedFee, big.NewInt(57500*2000000000+1000000*20000000))
+		assert.Equal(t, expectedFee, fee)
+	})
+	t.Run("relayed v2 transaction should use the inner transaction's data", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasPrice: 1000000000,
+			Data:     createTestRelayedTxV2Data([]byte(testContractCallData)),
+		}
+		relayerGas := uint64(50000 + 1500*len(tx.Data))
+		tx.GasLimit = relayerGas + 57500 + 1000000
+
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, err)
+		expectedFee := big.NewInt(0).SetUint64(relayerGas * 1000000000)
+		expectedFee.Add(expectedFee, big.NewInt(57500*1000000000+1000000*10000000))
+		assert.Equal(t, expectedFee, fee)
+	})
+	t.Run("relayed transaction not covering the inner data bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.FrontendTransaction{
+			GasPrice: 1000000000,
+			Data:     createTestRelayedTxV2Data([]byte(testContractCallData)),
+		}
+		tx.GasLimit = uint64(50000+1500*len(tx.Data)) + 50000
+
+		fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+		assert.Nil(t, fee)
+		assert.True(t, errors.Is(err, ErrInsufficientGasLimit))
+	})
+	t.Run("invalid relayed transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidData := []string{
+			relayedTxV1Prefix + "zz",
+			relayedTxV1Prefix + hex.EncodeToString([]byte("not json")),
+			relayedTxV2Prefix + "aa@01",
+			relayedTxV2Prefix + "aa@01@zz@abcd",
+		}
+		for _, dataField := range invalidData {
+			tx := &transaction.FrontendTransaction{
+				GasLimit: 100000000,
+				GasPrice: 1000000000,
+				Data:     []byte(dataField),
+			}
+			fee, err := ComputeTxFee(createTestNetworkConfig(), tx)
+			assert.Nil(t, fee)
+			assert.True(t, errors.Is(err, ErrInvalidRelayedTransaction), dataField)
+		}
+	})
+}
@@ -173,8 +173,13 @@ func (w *wallet) LoadPrivateKeyFromJsonFile(filename string, password string) ([
 		return nil, err
 	}
 
+	return w.DecryptPrivateKey(buff, password)
+}
+
+// DecryptPrivateKey decrypts a private key from its password encrypted .json format
+func (w *wallet) DecryptPrivateKey(buff []byte, password string) ([]byte, error) {
 	key := &encryptedKeyJSONV4{}
-	err = json.Unmarshal(buff, key)
+	err := json.Unmarshal(buff, key)
 	if err != nil {
 		return nil, err
 	}
@@ -259,27 +264,37 @@ func (w *wallet) secretKeyFromMnemonic(mnemonic []byte) []byte {
 
 // SavePrivateKeyToJsonFile saves a password encrypted private key to a .json file
 func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, filename string) error {
+	buff, err := w.EncryptPrivateKey(privateKey, password)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, buff, 0644)
+}
+
+// EncryptPrivateKey encrypts a private key with the password, returning it in the .json format
+func (w *wallet) EncryptPrivateKey(privateKey []byte, password string) ([]byte, error) {
 	salt := make([]byte, 32)
 	_, err := io.ReadFull(rand.Reader, salt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	derivedKey, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, scryptDKLen)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	encryptKey := derivedKey[:16]
 	iv := make([]byte, aes.BlockSize) // 16
 	_, err = io.ReadFull(rand.Reader, iv)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	aesBlock, err := aes.NewCipher(encryptKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	stream := cipher.NewCTR(aesBlock, iv)
@@ -289,14 +304,14 @@ func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, fi
 	hash := hmac.New(sha256.New, derivedKey[16:32])
 	_, err = hash.Write(cipherText)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	mac := hash.Sum(nil)
 
 	address, err := w.GetAddressFromPrivateKey(privateKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	keystoreJson := &encryptedKeyJSONV4{
@@ -316,12 +331,7 @@ func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, fi
 	keystoreJson.Crypto.KDFParams.DkLen = scryptDKLen
 	keystoreJson.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
 
-	buff, err := json.Marshal(keystoreJson)
-	if err != nil {
-		return err
-	}
-
-	return ioutil.WriteFile(filename, buff, 0644)
+	return json.Marshal(keystoreJson)
 }
 
 // LoadPrivateKeyFromPemFile loads a private key from a .pem file
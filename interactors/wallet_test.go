@@ -146,6 +146,28 @@ func TestWallet_LoadPrivateKeyFromPemFile(t *testing.T) {
 	assert.Equal(t, expectedBech32Address, address.AddressAsBech32String())
 }
 
+func TestWallet_EncryptDecryptPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	hexPrivKey := "15cfe2140ee9821f706423036ba58d1e6ec13dbc4ebf206732ad40b5236af403"
+	privKey, err := hex.DecodeString(hexPrivKey)
+	require.Nil(t, err)
+	password := "pAssword1~"
+
+	w := NewWallet()
+	buff, err := w.EncryptPrivateKey(privKey, password)
+	require.Nil(t, err)
+	assert.NotContains(t, string(buff), hexPrivKey)
+
+	recoveredSk, err := w.DecryptPrivateKey(buff, password)
+	require.Nil(t, err)
+	assert.Equal(t, privKey, recoveredSk)
+
+	recoveredSk, err = w.DecryptPrivateKey(buff, "wrong password")
+	assert.Equal(t, ErrWrongPassword, err)
+	assert.Nil(t, recoveredSk)
+}
+
 func TestWallet_SavePrivateKeyToPemFile(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,18 @@
+package notifier
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilEventsHandler signals that a nil events handler was provided
+var ErrNilEventsHandler = errors.New("nil events handler")
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilHyperBlock signals that a nil hyper block was fetched
+var ErrNilHyperBlock = errors.New("nil hyper block")
@@ -0,0 +1,34 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// EventsHandler receives the values pushed by the events notifier, along with the log events backfilled from the
+// hyper blocks notarized while the client was disconnected
+type EventsHandler interface {
+	HandleEvents(events []data.NotifierEvent) error
+	HandleRevertedBlock(block *data.NotifierRevertedBlock) error
+	HandleFinalizedBlock(block *data.NotifierFinalizedBlock) error
+	IsInterfaceNil() bool
+}
+
+// HyperBlocksProxy holds the proxy functions used to backfill the log events missed while disconnected
+type HyperBlocksProxy interface {
+	GetLatestHyperBlockNonce(ctx context.Context) (uint64, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error)
+	IsInterfaceNil() bool
+}
+
+// Proxy holds the proxy functions used by the workflows, answered by the push adapter
+type Proxy interface {
+	GetLatestHyperBlockNonce(ctx context.Context) (uint64, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error)
+	GetDefaultTransactionArguments(ctx context.Context, address sdkCore.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error)
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,111 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsPushProxyHandler is the DTO used in the push proxy handler constructor
+type ArgsPushProxyHandler struct {
+	Proxy Proxy
+}
+
+type pushProxyHandler struct {
+	proxy Proxy
+
+	mut          sync.Mutex
+	latestNonce  uint64
+	shouldUpdate bool
+}
+
+// NewPushProxyHandler creates an adapter letting the workflows be driven by the events notifier instead of polling
+// the network. It should be set as the handler of a websocket client subscribed to the finalized events and provided
+// to the workflows instead of the proxy: the latest hyper block nonce is asked from the proxy only after a value was
+// pushed, so the workflows checking it often reach the network only when new blocks are available
+func NewPushProxyHandler(args ArgsPushProxyHandler) (*pushProxyHandler, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+
+	return &pushProxyHandler{
+		proxy:        args.Proxy,
+		shouldUpdate: true,
+	}, nil
+}
+
+// HandleEvents marks the latest hyper block nonce as outdated
+func (handler *pushProxyHandler) HandleEvents(_ []data.NotifierEvent) error {
+	handler.markOutdated()
+	return nil
+}
+
+// HandleRevertedBlock marks the latest hyper block nonce as outdated
+func (handler *pushProxyHandler) HandleRevertedBlock(_ *data.NotifierRevertedBlock) error {
+	handler.markOutdated()
+	return nil
+}
+
+// HandleFinalizedBlock marks the latest hyper block nonce as outdated
+func (handler *pushProxyHandler) HandleFinalizedBlock(_ *data.NotifierFinalizedBlock) error {
+	handler.markOutdated()
+	return nil
+}
+
+func (handler *pushProxyHandler) markOutdated() {
+	handler.mut.Lock()
+	handler.shouldUpdate = true
+	handler.mut.Unlock()
+}
+
+// GetLatestHyperBlockNonce returns the latest hyper block nonce, asking the proxy only if a value was pushed since the
+// previous request
+func (handler *pushProxyHandler) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
+	handler.mut.Lock()
+	latestNonce, shouldUpdate := handler.latestNonce, handler.shouldUpdate
+	handler.shouldUpdate = false
+	handler.mut.Unlock()
+	if !shouldUpdate {
+		return latestNonce, nil
+	}
+
+	latestNonce, err := handler.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		handler.markOutdated()
+		return 0, err
+	}
+
+	handler.mut.Lock()
+	handler.latestNonce = latestNonce
+	handler.mut.Unlock()
+
+	return latestNonce, nil
+}
+
+// GetHyperBlockByNonce asks the proxy for the hyper block
+func (handler *pushProxyHandler) GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+	return handler.proxy.GetHyperBlockByNonce(ctx, nonce)
+}
+
+// GetDefaultTransactionArguments asks the proxy for the default transaction arguments
+func (handler *pushProxyHandler) GetDefaultTransactionArguments(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	networkConfigs *data.NetworkConfig,
+) (transaction.FrontendTransaction, string, error) {
+	return handler.proxy.GetDefaultTransactionArguments(ctx, address, networkConfigs)
+}
+
+// GetNetworkConfig asks the proxy for the network config
+func (handler *pushProxyHandler) GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	return handler.proxy.GetNetworkConfig(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *pushProxyHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,117 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/multiversx/mx-sdk-go/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ workflows.ProxyHandler = (*pushProxyHandler)(nil)
+var _ EventsHandler = (*pushProxyHandler)(nil)
+
+func createMockArgsPushProxyHandler() ArgsPushProxyHandler {
+	return ArgsPushProxyHandler{
+		Proxy: &testsCommon.ProxyStub{},
+	}
+}
+
+func TestNewPushProxyHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPushProxyHandler()
+		args.Proxy = nil
+		handler, err := NewPushProxyHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewPushProxyHandler(createMockArgsPushProxyHandler())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPushProxyHandler_GetLatestHyperBlockNonceShouldAskTheProxyOnlyAfterAPush(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	var proxyErr error
+	numProxyCalls := 0
+	args := createMockArgsPushProxyHandler()
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			numProxyCalls++
+			if proxyErr != nil {
+				return 0, proxyErr
+			}
+
+			return uint64(100 + numProxyCalls), nil
+		},
+	}
+	handler, _ := NewPushProxyHandler(args)
+
+	checkLatestNonce := func(expectedNonce uint64, expectedNumProxyCalls int) {
+		latestNonce, err := handler.GetLatestHyperBlockNonce(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, expectedNonce, latestNonce)
+		assert.Equal(t, expectedNumProxyCalls, numProxyCalls)
+	}
+
+	checkLatestNonce(101, 1)
+	checkLatestNonce(101, 1)
+
+	require.Nil(t, handler.HandleFinalizedBlock(&data.NotifierFinalizedBlock{Hash: "hash"}))
+	checkLatestNonce(102, 2)
+	checkLatestNonce(102, 2)
+
+	require.Nil(t, handler.HandleEvents([]data.NotifierEvent{{Identifier: "ESDTTransfer"}}))
+	checkLatestNonce(103, 3)
+
+	require.Nil(t, handler.HandleRevertedBlock(&data.NotifierRevertedBlock{Nonce: 103}))
+	proxyErr = expectedErr
+	latestNonce, err := handler.GetLatestHyperBlockNonce(context.Background())
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, uint64(0), latestNonce)
+
+	proxyErr = nil
+	checkLatestNonce(105, 5)
+	checkLatestNonce(105, 5)
+}
+
+func TestPushProxyHandler_ShouldDelegateToTheProxy(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &data.HyperBlock{Nonce: 37}
+	expectedConfig := &data.NetworkConfig{ChainID: "T"}
+	args := createMockArgsPushProxyHandler()
+	args.Proxy = &testsCommon.ProxyStub{
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return expectedBlock, nil
+		},
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return expectedConfig, nil
+		},
+	}
+	handler, _ := NewPushProxyHandler(args)
+
+	block, err := handler.GetHyperBlockByNonce(context.Background(), 37)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedBlock, block)
+
+	config, err := handler.GetNetworkConfig(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedConfig, config)
+}
@@ -0,0 +1,354 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/notifier")
+
+const minReconnectInterval = time.Millisecond * 10
+
+// ArgsWsClient is the DTO used in the websocket client constructor
+type ArgsWsClient struct {
+	// URL of the events notifier stream, like ws://127.0.0.1:5000/hub/ws
+	URL               string
+	ReconnectInterval time.Duration
+	// Subscriptions are sent after each connection. The revert and finalized events are pushed only if subscribed
+	// with the matching event type
+	Subscriptions []data.NotifierSubscription
+	// Proxy is used to backfill the log events missed while disconnected, the events notifier not resuming the stream
+	Proxy HyperBlocksProxy
+	// FromNonce is the first hyper block backfilled on the first connection, usually the value returned by NextNonce
+	// before a restart. When 0, only the events pushed after the first connection are delivered
+	FromNonce uint64
+	Handler   EventsHandler
+}
+
+type wsClient struct {
+	url               string
+	reconnectInterval time.Duration
+	subscriptions     []data.NotifierSubscription
+	proxy             HyperBlocksProxy
+	handler           EventsHandler
+	dialer            *websocket.Dialer
+
+	mut       sync.RWMutex
+	nextNonce uint64
+	conn      *websocket.Conn
+	cancel    func()
+	closed    chan struct{}
+}
+
+// NewWsClient creates a client of the events notifier stream delivering the pushed values to the handler. The client
+// connects in the background and reconnects whenever the connection is lost. Since the events notifier does not
+// resume the stream, the log events of the hyper blocks notarized since the connection was lost are fetched through
+// the proxy and delivered before the pushed ones. The backfill is done at hyper block granularity, so the events
+// emitted around a disconnection might be delivered twice
+func NewWsClient(args ArgsWsClient) (*wsClient, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if args.ReconnectInterval < minReconnectInterval {
+		return nil, fmt.Errorf("%w for ReconnectInterval, minimum %v, provided %v", ErrInvalidValue, minReconnectInterval, args.ReconnectInterval)
+	}
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(args.Handler) {
+		return nil, ErrNilEventsHandler
+	}
+
+	client := &wsClient{
+		url:               args.URL,
+		reconnectInterval: args.ReconnectInterval,
+		subscriptions:     args.Subscriptions,
+		proxy:             args.Proxy,
+		handler:           args.Handler,
+		dialer:            websocket.DefaultDialer,
+		nextNonce:         args.FromNonce,
+		closed:            make(chan struct{}),
+	}
+
+	var ctx context.Context
+	ctx, client.cancel = context.WithCancel(context.Background())
+	go client.run(ctx)
+
+	return client, nil
+}
+
+func (client *wsClient) run(ctx context.Context) {
+	defer close(client.closed)
+
+	for {
+		err := client.connectAndListen(ctx)
+		if ctx.Err() != nil {
+			log.Debug("finishing wsClient.run...")
+			return
+		}
+		log.Debug("notifier connection lost, reconnecting", "url", client.url, "error", err)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("finishing wsClient.run...")
+			return
+		case <-time.After(client.reconnectInterval):
+		}
+	}
+}
+
+func (client *wsClient) connectAndListen(ctx context.Context) error {
+	conn, _, err := client.dialer.DialContext(ctx, client.url, nil)
+	if err != nil {
+		return err
+	}
+	if !client.setConnection(ctx, conn) {
+		return ctx.Err()
+	}
+	defer client.closeConnection()
+
+	err = conn.WriteJSON(data.NotifierSubscribe{
+		SubscriptionEntries: client.subscriptions,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the values pushed meanwhile are buffered by the connection, so they are delivered after the backfilled ones
+	err = client.backfill(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		message := data.NotifierMessage{}
+		err = conn.ReadJSON(&message)
+		if err != nil {
+			return err
+		}
+
+		client.handleMessage(message)
+		if message.Type == data.NotifierAllEvents || message.Type == data.NotifierBlockEvents {
+			client.recordPushedHyperBlocks(ctx)
+		}
+	}
+}
+
+// backfill delivers the log events of the hyper blocks notarized since the connection was lost. The first connection
+// only sets the starting point if no FromNonce was provided
+func (client *wsClient) backfill(ctx context.Context) error {
+	latestNonce, err := client.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return err
+	}
+
+	nonce := client.NextNonce()
+	if nonce == 0 {
+		client.setNextNonce(latestNonce + 1)
+		return nil
+	}
+
+	for ; nonce <= latestNonce; nonce++ {
+		block, errGet := client.proxy.GetHyperBlockByNonce(ctx, nonce)
+		if errGet != nil {
+			return errGet
+		}
+		if block == nil {
+			return fmt.Errorf("%w for nonce %d", ErrNilHyperBlock, nonce)
+		}
+
+		events := client.extractEvents(block)
+		if len(events) > 0 {
+			err = client.handler.HandleEvents(events)
+			if err != nil {
+				return fmt.Errorf("%w while handling the events of hyper block %d", err, nonce)
+			}
+		}
+
+		client.setNextNonce(nonce + 1)
+	}
+
+	return nil
+}
+
+// recordPushedHyperBlocks moves the backfill starting point after the hyper blocks notarized when the log events were
+// pushed, the events of these hyper blocks being already delivered. The events pushed are notarized by the next hyper
+// blocks, so they might be delivered again by the backfill after a disconnection
+func (client *wsClient) recordPushedHyperBlocks(ctx context.Context) {
+	latestNonce, err := client.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		log.Debug("wsClient: can not get the latest hyper block nonce, the backfill will start earlier", "error", err)
+		return
+	}
+
+	client.mut.Lock()
+	if latestNonce+1 > client.nextNonce {
+		client.nextNonce = latestNonce + 1
+	}
+	client.mut.Unlock()
+}
+
+func (client *wsClient) extractEvents(block *data.HyperBlock) []data.NotifierEvent {
+	events := make([]data.NotifierEvent, 0)
+	for _, tx := range block.Transactions {
+		events = client.appendMatchingEvents(events, tx.Hash, tx.Logs)
+		for _, scr := range tx.ScResults {
+			if scr != nil {
+				events = client.appendMatchingEvents(events, scr.Hash, scr.Logs)
+			}
+		}
+	}
+
+	return events
+}
+
+func (client *wsClient) appendMatchingEvents(events []data.NotifierEvent, txHash string, logs *transaction.ApiLogs) []data.NotifierEvent {
+	if logs == nil {
+		return events
+	}
+
+	for _, event := range logs.Events {
+		if event == nil || !client.isSubscribed(event) {
+			continue
+		}
+
+		events = append(events, data.NotifierEvent{
+			Address:    event.Address,
+			Identifier: event.Identifier,
+			Topics:     event.Topics,
+			Data:       event.Data,
+			TxHash:     txHash,
+		})
+	}
+
+	return events
+}
+
+// isSubscribed applies the filters of the events notifier: no subscription and the block events subscription match
+// all log events, otherwise one of the log events subscriptions has to match the address and the identifier, the
+// empty fields matching any value
+func (client *wsClient) isSubscribed(event *transaction.Events) bool {
+	if len(client.subscriptions) == 0 {
+		return true
+	}
+
+	for _, subscription := range client.subscriptions {
+		if subscription.EventType == data.NotifierBlockEvents {
+			return true
+		}
+		if len(subscription.EventType) > 0 && subscription.EventType != data.NotifierAllEvents {
+			continue
+		}
+		if len(subscription.Address) > 0 && subscription.Address != event.Address {
+			continue
+		}
+		if len(subscription.Identifier) > 0 && subscription.Identifier != event.Identifier {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// handleMessage delivers the pushed value to the handler. The events notifier expecting no acknowledgement, the
+// errors are only logged
+func (client *wsClient) handleMessage(message data.NotifierMessage) {
+	var err error
+	switch message.Type {
+	case data.NotifierAllEvents:
+		events := make([]data.NotifierEvent, 0)
+		err = json.Unmarshal(message.Data, &events)
+		if err == nil {
+			err = client.handler.HandleEvents(events)
+		}
+	case data.NotifierBlockEvents:
+		block := &data.NotifierBlockWithEvents{}
+		err = json.Unmarshal(message.Data, block)
+		if err == nil {
+			err = client.handler.HandleEvents(block.Events)
+		}
+	case data.NotifierRevertEvents:
+		revertedBlock := &data.NotifierRevertedBlock{}
+		err = json.Unmarshal(message.Data, revertedBlock)
+		if err == nil {
+			err = client.handler.HandleRevertedBlock(revertedBlock)
+		}
+	case data.NotifierFinalizedEvents:
+		finalizedBlock := &data.NotifierFinalizedBlock{}
+		err = json.Unmarshal(message.Data, finalizedBlock)
+		if err == nil {
+			err = client.handler.HandleFinalizedBlock(finalizedBlock)
+		}
+	default:
+		log.Debug("wsClient: unknown message type, ignoring", "type", message.Type)
+	}
+
+	if err != nil {
+		log.Warn("wsClient: error handling message", "type", message.Type, "error", err)
+	}
+}
+
+func (client *wsClient) setNextNonce(nonce uint64) {
+	client.mut.Lock()
+	client.nextNonce = nonce
+	client.mut.Unlock()
+}
+
+// setConnection stores the connection so Close can interrupt the blocking reads. The check of the context is done
+// under the same lock as in closeConnection, so a connection established while closing is not leaked
+func (client *wsClient) setConnection(ctx context.Context, conn *websocket.Conn) bool {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+
+	if ctx.Err() != nil {
+		_ = conn.Close()
+		return false
+	}
+
+	client.conn = conn
+	return true
+}
+
+func (client *wsClient) closeConnection() {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+
+	if client.conn != nil {
+		_ = client.conn.Close()
+		client.conn = nil
+	}
+}
+
+// NextNonce returns the first hyper block to be backfilled on the next connection. Persisting it allows delivering
+// the log events emitted while the application was stopped
+func (client *wsClient) NextNonce() uint64 {
+	client.mut.RLock()
+	defer client.mut.RUnlock()
+
+	return client.nextNonce
+}
+
+// Close stops the client and closes the connection
+func (client *wsClient) Close() error {
+	client.cancel()
+	client.closeConnection()
+	<-client.closed
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *wsClient) IsInterfaceNil() bool {
+	return client == nil
+}
@@ -0,0 +1,421 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/multiversx/mx-sdk-go/testsCommon/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTimeout      = time.Second * 5
+	testPollInterval = time.Millisecond * 10
+)
+
+type eventsCollector struct {
+	mut      sync.Mutex
+	txHashes [][]string
+}
+
+func (collector *eventsCollector) add(events []data.NotifierEvent) {
+	txHashes := make([]string, 0, len(events))
+	for _, event := range events {
+		txHashes = append(txHashes, event.TxHash)
+	}
+
+	collector.mut.Lock()
+	collector.txHashes = append(collector.txHashes, txHashes)
+	collector.mut.Unlock()
+}
+
+func (collector *eventsCollector) get() [][]string {
+	collector.mut.Lock()
+	defer collector.mut.Unlock()
+
+	return append(make([][]string, 0, len(collector.txHashes)), collector.txHashes...)
+}
+
+func createMockArgsWsClient(url string) ArgsWsClient {
+	return ArgsWsClient{
+		URL:               url,
+		ReconnectInterval: minReconnectInterval,
+		Subscriptions: []data.NotifierSubscription{
+			{
+				Identifier: "ESDTTransfer",
+			},
+		},
+		Proxy:   &testsCommon.ProxyStub{},
+		Handler: &testsCommon.EventsHandlerStub{},
+	}
+}
+
+func createHyperBlockWithEvents(nonce uint64, identifiers ...string) *data.HyperBlock {
+	logs := &transaction.ApiLogs{}
+	for _, identifier := range identifiers {
+		logs.Events = append(logs.Events, &transaction.Events{
+			Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+			Identifier: identifier,
+		})
+	}
+
+	return &data.HyperBlock{
+		Nonce: nonce,
+		Transactions: []data.TransactionOnNetwork{
+			{
+				Hash: "tx" + identifiers[0],
+				Logs: logs,
+				ScResults: []*transaction.ApiSmartContractResult{
+					nil,
+					{
+						Hash: "scr" + identifiers[0],
+						Logs: logs,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewWsClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("")
+		client, err := NewWsClient(args)
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid reconnect interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://127.0.0.1:1/hub/ws")
+		args.ReconnectInterval = time.Millisecond
+		client, err := NewWsClient(args)
+		assert.True(t, check.IfNil(client))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "ReconnectInterval")
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://127.0.0.1:1/hub/ws")
+		args.Proxy = nil
+		client, err := NewWsClient(args)
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://127.0.0.1:1/hub/ws")
+		args.Handler = nil
+		client, err := NewWsClient(args)
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilEventsHandler, err)
+	})
+	t.Run("should work and close while the stream is unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWsClient("ws://127.0.0.1:1/hub/ws")
+		args.FromNonce = 7
+		client, err := NewWsClient(args)
+		assert.False(t, check.IfNil(client))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), client.NextNonce())
+
+		time.Sleep(minReconnectInterval * 3)
+		assert.Nil(t, client.Close())
+	})
+}
+
+func TestWsClient_ShouldDeliverThePushedValues(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := server.NewFakeNotifier()
+	require.Nil(t, err)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	collector := &eventsCollector{}
+	finalized := make(chan *data.NotifierFinalizedBlock, 1)
+	reverted := make(chan *data.NotifierRevertedBlock, 1)
+
+	args := createMockArgsWsClient(notifier.URL())
+	args.Subscriptions = append(args.Subscriptions,
+		data.NotifierSubscription{EventType: data.NotifierRevertEvents},
+		data.NotifierSubscription{EventType: data.NotifierFinalizedEvents},
+	)
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 10, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			assert.Fail(t, "should have not backfilled on the first connection")
+			return nil, nil
+		},
+	}
+	args.Handler = &testsCommon.EventsHandlerStub{
+		HandleEventsCalled: func(events []data.NotifierEvent) error {
+			collector.add(events)
+			return nil
+		},
+		HandleRevertedBlockCalled: func(block *data.NotifierRevertedBlock) error {
+			reverted <- block
+			return nil
+		},
+		HandleFinalizedBlockCalled: func(block *data.NotifierFinalizedBlock) error {
+			finalized <- block
+			return nil
+		},
+	}
+	client, err := NewWsClient(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return notifier.NumSubscribers() == 1 && client.NextNonce() == 11
+	}, testTimeout, testPollInterval)
+	subscriptions := notifier.Subscriptions()
+	require.Equal(t, 1, len(subscriptions))
+	assert.Equal(t, args.Subscriptions, subscriptions[0].SubscriptionEntries)
+
+	require.Nil(t, notifier.PushEvents([]data.NotifierEvent{
+		{
+			Address:    "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN-123456"), {}, {0x01}},
+			TxHash:     "txHash1",
+		},
+		{
+			Identifier: "writeLog",
+			TxHash:     "txHash2",
+		},
+	}))
+	require.Eventually(t, func() bool {
+		return len(collector.get()) == 1
+	}, testTimeout, testPollInterval)
+	assert.Equal(t, [][]string{{"txHash1"}}, collector.get())
+
+	expectedReverted := &data.NotifierRevertedBlock{Hash: "hash2", Nonce: 2, Round: 2, Epoch: 1}
+	require.Nil(t, notifier.PushRevertedBlock(expectedReverted))
+	assert.Equal(t, expectedReverted, <-reverted)
+
+	expectedFinalized := &data.NotifierFinalizedBlock{Hash: "hash1"}
+	require.Nil(t, notifier.PushFinalizedBlock(expectedFinalized))
+	assert.Equal(t, expectedFinalized, <-finalized)
+	assert.Equal(t, uint64(11), client.NextNonce())
+}
+
+func TestWsClient_ShouldBackfillTheEventsMissedWhileDisconnected(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := server.NewFakeNotifier()
+	require.Nil(t, err)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	// the latest hyper block nonce seen on the first connection, when the connection is lost and on reconnection
+	latestNonces := []uint64{10, 12, 14}
+	numLatestNonceCalls := int32(0)
+	mutRequested := sync.Mutex{}
+	requestedNonces := make([]uint64, 0)
+	collector := &eventsCollector{}
+
+	args := createMockArgsWsClient(notifier.URL())
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			idx := int(atomic.AddInt32(&numLatestNonceCalls, 1)) - 1
+			if idx >= len(latestNonces) {
+				idx = len(latestNonces) - 1
+			}
+
+			return latestNonces[idx], nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			mutRequested.Lock()
+			requestedNonces = append(requestedNonces, nonce)
+			mutRequested.Unlock()
+
+			if nonce == 13 {
+				return createHyperBlockWithEvents(nonce, "ESDTTransfer", "writeLog"), nil
+			}
+			return &data.HyperBlock{Nonce: nonce}, nil
+		},
+	}
+	args.Handler = &testsCommon.EventsHandlerStub{
+		HandleEventsCalled: func(events []data.NotifierEvent) error {
+			collector.add(events)
+			return nil
+		},
+	}
+	client, err := NewWsClient(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return notifier.NumSubscribers() == 1 && client.NextNonce() == 11
+	}, testTimeout, testPollInterval)
+	require.Nil(t, notifier.PushEvents([]data.NotifierEvent{{Identifier: "ESDTTransfer", TxHash: "live"}}))
+	require.Eventually(t, func() bool {
+		return len(collector.get()) == 1
+	}, testTimeout, testPollInterval)
+
+	notifier.DropConnections()
+
+	require.Eventually(t, func() bool {
+		return client.NextNonce() == 15
+	}, testTimeout, testPollInterval)
+	assert.Equal(t, [][]string{{"live"}, {"txESDTTransfer", "scrESDTTransfer"}}, collector.get())
+	mutRequested.Lock()
+	assert.Equal(t, []uint64{13, 14}, requestedNonces)
+	mutRequested.Unlock()
+
+	require.Eventually(t, func() bool {
+		return notifier.NumSubscribers() == 1 && len(notifier.Subscriptions()) == 2
+	}, testTimeout, testPollInterval)
+	require.Nil(t, notifier.PushEvents([]data.NotifierEvent{{Identifier: "ESDTTransfer", TxHash: "live after reconnection"}}))
+	require.Eventually(t, func() bool {
+		return len(collector.get()) == 3
+	}, testTimeout, testPollInterval)
+	assert.Equal(t, []string{"live after reconnection"}, collector.get()[2])
+}
+
+func TestWsClient_ShouldBackfillFromTheHyperBlocksNotarizedAtTheLastPush(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := server.NewFakeNotifier()
+	require.Nil(t, err)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	latestNonce := uint64(10)
+	mutRequested := sync.Mutex{}
+	requestedNonces := make([]uint64, 0)
+	collector := &eventsCollector{}
+
+	args := createMockArgsWsClient(notifier.URL())
+	args.Subscriptions = []data.NotifierSubscription{{EventType: data.NotifierBlockEvents}}
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return atomic.LoadUint64(&latestNonce), nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			mutRequested.Lock()
+			requestedNonces = append(requestedNonces, nonce)
+			mutRequested.Unlock()
+
+			return createHyperBlockWithEvents(nonce, "writeLog"), nil
+		},
+	}
+	args.Handler = &testsCommon.EventsHandlerStub{
+		HandleEventsCalled: func(events []data.NotifierEvent) error {
+			collector.add(events)
+			return nil
+		},
+	}
+	client, err := NewWsClient(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return notifier.NumSubscribers() == 1 && client.NextNonce() == 11
+	}, testTimeout, testPollInterval)
+
+	atomic.StoreUint64(&latestNonce, 11)
+	require.Nil(t, notifier.PushBlockEvents(&data.NotifierBlockWithEvents{
+		Hash:   "hash",
+		Events: []data.NotifierEvent{{Identifier: "writeLog", TxHash: "live"}},
+	}))
+	require.Eventually(t, func() bool {
+		return client.NextNonce() == 12
+	}, testTimeout, testPollInterval)
+
+	// the hyper blocks notarized after the last push, before the connection is lost, should be backfilled
+	atomic.StoreUint64(&latestNonce, 13)
+	notifier.DropConnections()
+
+	require.Eventually(t, func() bool {
+		return client.NextNonce() == 14
+	}, testTimeout, testPollInterval)
+	assert.Equal(t, [][]string{{"live"}, {"txwriteLog", "scrwriteLog"}, {"txwriteLog", "scrwriteLog"}}, collector.get())
+	mutRequested.Lock()
+	assert.Equal(t, []uint64{12, 13}, requestedNonces)
+	mutRequested.Unlock()
+}
+
+func TestWsClient_BackfillHandlerErrorShouldRetryFromTheFailedBlock(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := server.NewFakeNotifier()
+	require.Nil(t, err)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	expectedErr := errors.New("expected error")
+	collector := &eventsCollector{}
+	failed := false
+	args := createMockArgsWsClient(notifier.URL())
+	args.FromNonce = 5
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 6, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			if nonce == 5 {
+				return createHyperBlockWithEvents(nonce, "ESDTTransfer"), nil
+			}
+			return createHyperBlockWithEvents(nonce, "ESDTTransfer", "ESDTNFTTransfer"), nil
+		},
+	}
+	args.Handler = &testsCommon.EventsHandlerStub{
+		HandleEventsCalled: func(events []data.NotifierEvent) error {
+			if len(events) == 4 && !failed {
+				failed = true
+				return expectedErr
+			}
+
+			collector.add(events)
+			return nil
+		},
+	}
+	args.Subscriptions = []data.NotifierSubscription{}
+	client, err := NewWsClient(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = client.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return client.NextNonce() == 7
+	}, testTimeout, testPollInterval)
+	expectedTxHashes := [][]string{
+		{"txESDTTransfer", "scrESDTTransfer"},
+		{"txESDTTransfer", "txESDTTransfer", "scrESDTTransfer", "scrESDTTransfer"},
+	}
+	assert.Equal(t, expectedTxHashes, collector.get())
+	require.Eventually(t, func() bool {
+		return len(notifier.Subscriptions()) == 2
+	}, testTimeout, testPollInterval)
+}
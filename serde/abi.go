@@ -0,0 +1,132 @@
+package serde
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+const (
+	// AbiTypeStruct is the ABI kind of custom struct types
+	AbiTypeStruct = "struct"
+	// AbiTypeEnum is the ABI kind of custom enum types
+	AbiTypeEnum = "enum"
+	// AbiMutabilityReadonly marks an endpoint that does not alter the contract state (a view)
+	AbiMutabilityReadonly = "readonly"
+	// AbiMutabilityMutable marks an endpoint that can alter the contract state
+	AbiMutabilityMutable = "mutable"
+)
+
+// Abi holds the definition of a smart contract as exported in the `.abi.json` file
+type Abi struct {
+	Name               string                        `json:"name"`
+	Constructor        *AbiEndpoint                  `json:"constructor,omitempty"`
+	UpgradeConstructor *AbiEndpoint                  `json:"upgradeConstructor,omitempty"`
+	Endpoints          []*AbiEndpoint                `json:"endpoints"`
+	Events             []*AbiEvent                   `json:"events,omitempty"`
+	Types              map[string]*AbiTypeDefinition `json:"types"`
+}
+
+// AbiEndpoint holds the definition of a contract endpoint, view or constructor
+type AbiEndpoint struct {
+	Name            string      `json:"name"`
+	Docs            []string    `json:"docs,omitempty"`
+	OnlyOwner       bool        `json:"onlyOwner,omitempty"`
+	Mutability      string      `json:"mutability,omitempty"`
+	PayableInTokens []string    `json:"payableInTokens,omitempty"`
+	Inputs          []*AbiParam `json:"inputs"`
+	Outputs         []*AbiParam `json:"outputs"`
+}
+
+// IsReadonly returns true if the endpoint is a view
+func (endpoint *AbiEndpoint) IsReadonly() bool {
+	return endpoint.Mutability == AbiMutabilityReadonly
+}
+
+// AbiParam holds the definition of an endpoint input or output
+type AbiParam struct {
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type"`
+	MultiArg    bool   `json:"multi_arg,omitempty"`
+	MultiResult bool   `json:"multi_result,omitempty"`
+}
+
+// AbiEvent holds the definition of an event emitted by the contract
+type AbiEvent struct {
+	Identifier string           `json:"identifier"`
+	Inputs     []*AbiEventInput `json:"inputs"`
+}
+
+// AbiEventInput holds the definition of an event topic or of the event data
+type AbiEventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+// AbiTypeDefinition holds the definition of a custom struct or enum type
+type AbiTypeDefinition struct {
+	Type     string            `json:"type"`
+	Docs     []string          `json:"docs,omitempty"`
+	Fields   []*AbiField       `json:"fields,omitempty"`
+	Variants []*AbiEnumVariant `json:"variants,omitempty"`
+}
+
+// AbiField holds the definition of a struct field or of an enum variant field
+type AbiField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AbiEnumVariant holds the definition of an enum variant
+type AbiEnumVariant struct {
+	Name         string      `json:"name"`
+	Discriminant uint8       `json:"discriminant"`
+	Fields       []*AbiField `json:"fields,omitempty"`
+}
+
+// LoadAbiFromFile reads and parses the `.abi.json` file found at the provided path
+func LoadAbiFromFile(path string) (*Abi, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAbiFromJSON(buff)
+}
+
+// NewAbiFromJSON parses the provided `.abi.json` contents
+func NewAbiFromJSON(buff []byte) (*Abi, error) {
+	abi := &Abi{}
+	err := json.Unmarshal(buff, abi)
+	if err != nil {
+		return nil, err
+	}
+	if abi.Types == nil {
+		abi.Types = make(map[string]*AbiTypeDefinition)
+	}
+
+	return abi, nil
+}
+
+// GetEndpoint returns the endpoint or view with the provided name
+func (abi *Abi) GetEndpoint(name string) (*AbiEndpoint, error) {
+	for _, endpoint := range abi.Endpoints {
+		if endpoint.Name == name {
+			return endpoint, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+}
+
+// GetEvent returns the event with the provided identifier or nil if the event is not defined
+func (abi *Abi) GetEvent(identifier string) *AbiEvent {
+	for _, event := range abi.Events {
+		if event.Identifier == identifier {
+			return event
+		}
+	}
+
+	return nil
+}
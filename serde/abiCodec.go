@@ -0,0 +1,1119 @@
+package serde
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	addressLength      = 32
+	codeMetadataLength = 2
+)
+
+// EnumValue holds the value of an ABI enum. When encoding, the variant is selected by name if it is set,
+// otherwise by discriminant. Fields are indexed by their ABI name (tuple-like variants use "0", "1", ...)
+type EnumValue struct {
+	Name         string
+	Discriminant uint8
+	Fields       map[string]interface{}
+}
+
+// abiCodec is able to encode arguments and decode results for all the types declared in a contract ABI.
+// The Go representation of the ABI types is:
+//   - u8...u64, usize: uint8...uint64, uint32 (any Go integer, *big.Int or decimal string is accepted when encoding)
+//   - i8...i64, isize: int8...int64, int32 (any Go integer, *big.Int or decimal string is accepted when encoding)
+//   - BigUint, BigInt: *big.Int
+//   - bool: bool
+//   - Address: core.AddressHandler ([]byte is accepted when encoding)
+//   - bytes, BoxedBytes, ManagedBuffer, H256, CodeMetadata: []byte
+//   - utf-8 string, TokenIdentifier, EgldOrEsdtTokenIdentifier: string
+//   - Option<T>, optional<T>: nil or the value of T
+//   - List<T>, arrayN<T>, tuple<...>, variadic<T>, counted-variadic<T>, multi<...>: []interface{}
+//   - struct: map[string]interface{} indexed by the field name
+//   - enum: EnumValue
+//
+// When encoding, typed Go values are accepted as well: pointers are dereferenced (a nil pointer stands for a missing
+// Option or optional value), Go structs can stand for ABI structs (fields matched by the `abi` tag or by name) and for
+// tuples or multi-values (fields matched by position) and any Go integer can stand for an enum discriminant
+type abiCodec struct {
+	abi *Abi
+}
+
+// NewAbiCodec creates a new codec instance able to work with the types of the provided contract ABI
+func NewAbiCodec(abi *Abi) (*abiCodec, error) {
+	if abi == nil {
+		return nil, ErrNilAbi
+	}
+
+	return &abiCodec{
+		abi: abi,
+	}, nil
+}
+
+// EncodeEndpointArguments encodes the provided values as the arguments of the named endpoint
+func (codec *abiCodec) EncodeEndpointArguments(endpointName string, values []interface{}) ([][]byte, error) {
+	endpoint, err := codec.abi.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.EncodeArguments(endpoint.Inputs, values)
+}
+
+// EncodeConstructorArguments encodes the provided values as the arguments of the contract constructor
+func (codec *abiCodec) EncodeConstructorArguments(values []interface{}) ([][]byte, error) {
+	if codec.abi.Constructor == nil {
+		return nil, fmt.Errorf("%w: constructor", ErrEndpointNotFound)
+	}
+
+	return codec.EncodeArguments(codec.abi.Constructor.Inputs, values)
+}
+
+// DecodeEndpointOutputs decodes the return data of the named endpoint
+func (codec *abiCodec) DecodeEndpointOutputs(endpointName string, returnData [][]byte) ([]interface{}, error) {
+	endpoint, err := codec.abi.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.DecodeOutputs(endpoint.Outputs, returnData)
+}
+
+// EncodeArguments encodes the provided values as top-level arguments described by the provided params
+func (codec *abiCodec) EncodeArguments(params []*AbiParam, values []interface{}) ([][]byte, error) {
+	if len(params) != len(values) {
+		return nil, fmt.Errorf("%w, expected: %d, provided: %d", ErrWrongNumberOfArguments, len(params), len(values))
+	}
+
+	args := make([][]byte, 0, len(values))
+	for i, param := range params {
+		t, err := ParseAbiType(param.Type)
+		if err != nil {
+			return nil, err
+		}
+		if t.Name == typeVariadic && i != len(params)-1 {
+			return nil, fmt.Errorf("%w: argument %s", ErrMultiValueNotLast, param.Name)
+		}
+
+		args, err = codec.encodeMulti(t, values[i], args)
+		if err != nil {
+			return nil, fmt.Errorf("%w for argument %d (%s)", err, i, param.Name)
+		}
+	}
+
+	return args, nil
+}
+
+// DecodeOutputs decodes the provided top-level return data as described by the provided params
+func (codec *abiCodec) DecodeOutputs(params []*AbiParam, returnData [][]byte) ([]interface{}, error) {
+	index := 0
+	results := make([]interface{}, 0, len(params))
+	for i, param := range params {
+		t, err := ParseAbiType(param.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := codec.decodeMulti(t, returnData, &index)
+		if err != nil {
+			return nil, fmt.Errorf("%w for output %d", err, i)
+		}
+		results = append(results, value)
+	}
+	if index != len(returnData) {
+		return nil, fmt.Errorf("%w: %d unused results", ErrWrongNumberOfArguments, len(returnData)-index)
+	}
+
+	return results, nil
+}
+
+// EncodeTopLevel encodes the provided value using the top-level encoding of the provided ABI type expression
+func (codec *abiCodec) EncodeTopLevel(typeExpression string, value interface{}) ([]byte, error) {
+	t, err := ParseAbiType(typeExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.encodeTopLevel(t, value)
+}
+
+// EncodeNested encodes the provided value using the nested encoding of the provided ABI type expression
+func (codec *abiCodec) EncodeNested(typeExpression string, value interface{}) ([]byte, error) {
+	t, err := ParseAbiType(typeExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	buff := &bytes.Buffer{}
+	err = codec.encodeNested(t, value, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// DecodeTopLevel decodes the provided top-level encoded bytes as the provided ABI type expression
+func (codec *abiCodec) DecodeTopLevel(typeExpression string, buff []byte) (interface{}, error) {
+	t, err := ParseAbiType(typeExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.decodeTopLevel(t, buff)
+}
+
+// DecodeNested decodes the provided nested encoded bytes as the provided ABI type expression.
+// All the provided bytes should be consumed
+func (codec *abiCodec) DecodeNested(typeExpression string, buff []byte) (interface{}, error) {
+	t, err := ParseAbiType(typeExpression)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.decodeNestedFully(t, buff)
+}
+
+func (codec *abiCodec) encodeMulti(t *AbiType, value interface{}, args [][]byte) ([][]byte, error) {
+	value = dereference(value)
+	switch t.Name {
+	case typeOptional:
+		if value == nil {
+			return args, nil
+		}
+		return codec.encodeMulti(t.Generics[0], value, args)
+	case typeVariadic, typeCountedVarArgs:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		if t.Name == typeCountedVarArgs {
+			args = append(args, big.NewInt(int64(len(items))).Bytes())
+		}
+		for _, item := range items {
+			args, err = codec.encodeMulti(t.Generics[0], item, args)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return args, nil
+	case typeMulti:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(t.Generics) {
+			return nil, fmt.Errorf("%w for %s, provided: %d", ErrWrongNumberOfArguments, t, len(items))
+		}
+		for i, item := range items {
+			args, err = codec.encodeMulti(t.Generics[i], item, args)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return args, nil
+	default:
+		encoded, err := codec.encodeTopLevel(t, value)
+		if err != nil {
+			return nil, err
+		}
+		return append(args, encoded), nil
+	}
+}
+
+func (codec *abiCodec) decodeMulti(t *AbiType, returnData [][]byte, index *int) (interface{}, error) {
+	switch t.Name {
+	case typeOptional:
+		if *index >= len(returnData) {
+			return nil, nil
+		}
+		return codec.decodeMulti(t.Generics[0], returnData, index)
+	case typeVariadic:
+		items := make([]interface{}, 0)
+		for *index < len(returnData) {
+			item, err := codec.decodeMulti(t.Generics[0], returnData, index)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case typeCountedVarArgs:
+		if *index >= len(returnData) {
+			return nil, ErrUnexpectedEndOfData
+		}
+		count := big.NewInt(0).SetBytes(returnData[*index])
+		*index++
+		if !count.IsUint64() || count.Uint64() > uint64(len(returnData)-*index) {
+			return nil, fmt.Errorf("%w: invalid count for %s", ErrUnexpectedEndOfData, t)
+		}
+		items := make([]interface{}, 0, count.Uint64())
+		for i := uint64(0); i < count.Uint64(); i++ {
+			item, err := codec.decodeMulti(t.Generics[0], returnData, index)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case typeMulti:
+		items := make([]interface{}, 0, len(t.Generics))
+		for _, generic := range t.Generics {
+			item, err := codec.decodeMulti(generic, returnData, index)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		if *index >= len(returnData) {
+			return nil, fmt.Errorf("%w: missing value for %s", ErrUnexpectedEndOfData, t)
+		}
+		value, err := codec.decodeTopLevel(t, returnData[*index])
+		if err != nil {
+			return nil, err
+		}
+		*index++
+		return value, nil
+	}
+}
+
+func (codec *abiCodec) encodeTopLevel(t *AbiType, value interface{}) ([]byte, error) {
+	if t.IsMultiValue() {
+		return nil, fmt.Errorf("%w: %s can not be encoded as a single value", ErrWrongValueType, t)
+	}
+
+	value = dereference(value)
+	switch t.Name {
+	case typeU8, typeU16, typeU32, typeU64, typeUsize, typeBigUint:
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		err = checkUnsignedRange(t.Name, number)
+		if err != nil {
+			return nil, err
+		}
+		return number.Bytes(), nil
+	case typeI8, typeI16, typeI32, typeI64, typeIsize, typeBigInt:
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		err = checkSignedRange(t.Name, number)
+		if err != nil {
+			return nil, err
+		}
+		return signedToMinimalBytes(number), nil
+	case typeBool:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected bool, got %T", ErrWrongValueType, value)
+		}
+		if boolValue {
+			return []byte{1}, nil
+		}
+		return make([]byte, 0), nil
+	case typeBytes, typeBoxedBytes, typeManagedBuffer, typeUtf8String, typeTokenIdentifier, typeEgldOrEsdtTokenIdentifier:
+		return toBytes(value)
+	case typeOption:
+		if value == nil {
+			return make([]byte, 0), nil
+		}
+		buff := bytes.NewBuffer([]byte{1})
+		err := codec.encodeNested(t.Generics[0], value, buff)
+		if err != nil {
+			return nil, err
+		}
+		return buff.Bytes(), nil
+	case typeList:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		buff := &bytes.Buffer{}
+		for _, item := range items {
+			err = codec.encodeNested(t.Generics[0], item, buff)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return buff.Bytes(), nil
+	}
+
+	definition, isCustom := codec.abi.Types[t.Name]
+	if isCustom && definition.Type == AbiTypeEnum && isFieldlessEnum(definition) {
+		variant, _, err := codec.getEnumVariant(t.Name, definition, value)
+		if err != nil {
+			return nil, err
+		}
+		return big.NewInt(int64(variant.Discriminant)).Bytes(), nil
+	}
+
+	buff := &bytes.Buffer{}
+	err := codec.encodeNested(t, value, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (codec *abiCodec) encodeNested(t *AbiType, value interface{}, buff *bytes.Buffer) error {
+	value = dereference(value)
+	switch t.Name {
+	case typeU8, typeU16, typeU32, typeU64, typeUsize:
+		number, err := toBigInt(value)
+		if err != nil {
+			return err
+		}
+		err = checkUnsignedRange(t.Name, number)
+		if err != nil {
+			return err
+		}
+		buff.Write(toFixedWidth(number, fixedWidthOf(t.Name)))
+		return nil
+	case typeI8, typeI16, typeI32, typeI64, typeIsize:
+		number, err := toBigInt(value)
+		if err != nil {
+			return err
+		}
+		err = checkSignedRange(t.Name, number)
+		if err != nil {
+			return err
+		}
+		buff.Write(toFixedWidth(number, fixedWidthOf(t.Name)))
+		return nil
+	case typeBigUint, typeBigInt, typeBytes, typeBoxedBytes, typeManagedBuffer, typeUtf8String,
+		typeTokenIdentifier, typeEgldOrEsdtTokenIdentifier:
+		encoded, err := codec.encodeTopLevel(t, value)
+		if err != nil {
+			return err
+		}
+		writeLengthPrefixed(buff, encoded)
+		return nil
+	case typeBool:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%w: expected bool, got %T", ErrWrongValueType, value)
+		}
+		if boolValue {
+			buff.WriteByte(1)
+		} else {
+			buff.WriteByte(0)
+		}
+		return nil
+	case typeAddress:
+		address, err := toAddressBytes(value)
+		if err != nil {
+			return err
+		}
+		buff.Write(address)
+		return nil
+	case typeH256, typeCodeMetadata:
+		raw, err := toBytes(value)
+		if err != nil {
+			return err
+		}
+		if len(raw) != fixedWidthOf(t.Name) {
+			return fmt.Errorf("%w: %s should have %d bytes, provided %d", ErrValueOutOfRange, t.Name, fixedWidthOf(t.Name), len(raw))
+		}
+		buff.Write(raw)
+		return nil
+	case typeOption:
+		if value == nil {
+			buff.WriteByte(0)
+			return nil
+		}
+		buff.WriteByte(1)
+		return codec.encodeNested(t.Generics[0], value, buff)
+	case typeList:
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		writeUint32(buff, uint32(len(items)))
+		return codec.encodeNestedItems(t.Generics[0], items, buff)
+	case typeTuple:
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		if len(items) != len(t.Generics) {
+			return fmt.Errorf("%w for %s, provided: %d", ErrWrongNumberOfArguments, t, len(items))
+		}
+		for i, item := range items {
+			err = codec.encodeNested(t.Generics[i], item, buff)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if length, isArray := t.ArrayLength(); isArray {
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		if len(items) != length {
+			return fmt.Errorf("%w: %s should have %d items, provided %d", ErrValueOutOfRange, t, length, len(items))
+		}
+		return codec.encodeNestedItems(t.Generics[0], items, buff)
+	}
+
+	if t.IsMultiValue() {
+		return fmt.Errorf("%w: %s can not be nested", ErrWrongValueType, t)
+	}
+
+	return codec.encodeNestedCustom(t, value, buff)
+}
+
+func (codec *abiCodec) encodeNestedItems(t *AbiType, items []interface{}, buff *bytes.Buffer) error {
+	for _, item := range items {
+		err := codec.encodeNested(t, item, buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (codec *abiCodec) encodeNestedCustom(t *AbiType, value interface{}, buff *bytes.Buffer) error {
+	definition, found := codec.abi.Types[t.Name]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownType, t.Name)
+	}
+
+	switch definition.Type {
+	case AbiTypeStruct:
+		fields, err := toFieldsMap(t.Name, definition.Fields, value)
+		if err != nil {
+			return err
+		}
+		return codec.encodeFields(t.Name, definition.Fields, fields, buff)
+	case AbiTypeEnum:
+		variant, fields, err := codec.getEnumVariant(t.Name, definition, value)
+		if err != nil {
+			return err
+		}
+		buff.WriteByte(variant.Discriminant)
+		return codec.encodeFields(t.Name, variant.Fields, fields, buff)
+	default:
+		return fmt.Errorf("%w: %s has kind %s", ErrUnknownType, t.Name, definition.Type)
+	}
+}
+
+func (codec *abiCodec) encodeFields(typeName string, definitions []*AbiField, fields map[string]interface{}, buff *bytes.Buffer) error {
+	for _, field := range definitions {
+		value, found := fields[field.Name]
+		if !found {
+			return fmt.Errorf("%w: missing field %s of %s", ErrWrongValueType, field.Name, typeName)
+		}
+
+		fieldType, err := ParseAbiType(field.Type)
+		if err != nil {
+			return err
+		}
+
+		err = codec.encodeNested(fieldType, value, buff)
+		if err != nil {
+			return fmt.Errorf("%w for field %s of %s", err, field.Name, typeName)
+		}
+	}
+
+	return nil
+}
+
+func (codec *abiCodec) getEnumVariant(typeName string, definition *AbiTypeDefinition, value interface{}) (*AbiEnumVariant, map[string]interface{}, error) {
+	var enumValue EnumValue
+	switch v := value.(type) {
+	case EnumValue:
+		enumValue = v
+	case *EnumValue:
+		if v == nil {
+			return nil, nil, fmt.Errorf("%w: nil enum value for %s", ErrWrongValueType, typeName)
+		}
+		enumValue = *v
+	case string:
+		enumValue = EnumValue{Name: v}
+	default:
+		discriminant, err := toBigInt(value)
+		if err != nil || !discriminant.IsUint64() || discriminant.Uint64() > math.MaxUint8 {
+			return nil, nil, fmt.Errorf("%w: expected EnumValue for enum %s, got %T", ErrWrongValueType, typeName, value)
+		}
+		enumValue = EnumValue{Discriminant: uint8(discriminant.Uint64())}
+	}
+
+	for _, variant := range definition.Variants {
+		matchesName := len(enumValue.Name) > 0 && variant.Name == enumValue.Name
+		matchesDiscriminant := len(enumValue.Name) == 0 && variant.Discriminant == enumValue.Discriminant
+		if matchesName || matchesDiscriminant {
+			return variant, enumValue.Fields, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w for %s: name %q, discriminant %d", ErrInvalidDiscriminant, typeName, enumValue.Name, enumValue.Discriminant)
+}
+
+func (codec *abiCodec) decodeTopLevel(t *AbiType, buff []byte) (interface{}, error) {
+	if t.IsMultiValue() {
+		return nil, fmt.Errorf("%w: %s can not be decoded as a single value", ErrWrongValueType, t)
+	}
+
+	switch t.Name {
+	case typeU8, typeU16, typeU32, typeU64, typeUsize:
+		if len(buff) > fixedWidthOf(t.Name) {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrValueOutOfRange, len(buff), t.Name)
+		}
+		return castUnsigned(t.Name, big.NewInt(0).SetBytes(buff)), nil
+	case typeI8, typeI16, typeI32, typeI64, typeIsize:
+		if len(buff) > fixedWidthOf(t.Name) {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrValueOutOfRange, len(buff), t.Name)
+		}
+		return castSigned(t.Name, signedFromBytes(buff)), nil
+	case typeBigUint:
+		return big.NewInt(0).SetBytes(buff), nil
+	case typeBigInt:
+		return signedFromBytes(buff), nil
+	case typeBool:
+		if len(buff) == 0 {
+			return false, nil
+		}
+		return decodeBool(buff)
+	case typeBytes, typeBoxedBytes, typeManagedBuffer:
+		return copyBytes(buff), nil
+	case typeUtf8String, typeTokenIdentifier, typeEgldOrEsdtTokenIdentifier:
+		return string(buff), nil
+	case typeOption:
+		if len(buff) == 0 {
+			return nil, nil
+		}
+		if buff[0] != 1 {
+			return nil, fmt.Errorf("%w: invalid option marker %d", ErrWrongValueType, buff[0])
+		}
+		return codec.decodeNestedFully(t.Generics[0], buff[1:])
+	case typeList:
+		source := NewSourceBuffer(buff)
+		items := make([]interface{}, 0)
+		for source.Len() > 0 {
+			item, err := codec.decodeNested(t.Generics[0], source)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	definition, isCustom := codec.abi.Types[t.Name]
+	if isCustom && definition.Type == AbiTypeEnum && isFieldlessEnum(definition) {
+		if len(buff) > 1 {
+			return nil, fmt.Errorf("%w: %d bytes for enum %s", ErrValueOutOfRange, len(buff), t.Name)
+		}
+		discriminant := uint8(big.NewInt(0).SetBytes(buff).Uint64())
+		return codec.createEnumValue(t.Name, definition, discriminant)
+	}
+
+	return codec.decodeNestedFully(t, buff)
+}
+
+func (codec *abiCodec) decodeNestedFully(t *AbiType, buff []byte) (interface{}, error) {
+	source := NewSourceBuffer(buff)
+	value, err := codec.decodeNested(t, source)
+	if err != nil {
+		return nil, err
+	}
+	if source.Len() > 0 {
+		return nil, fmt.Errorf("%w: %d bytes for %s", ErrTrailingBytes, source.Len(), t)
+	}
+
+	return value, nil
+}
+
+func (codec *abiCodec) decodeNested(t *AbiType, source *SourceBuffer) (interface{}, error) {
+	switch t.Name {
+	case typeU8, typeU16, typeU32, typeU64, typeUsize:
+		raw, err := nextBytes(source, fixedWidthOf(t.Name))
+		if err != nil {
+			return nil, err
+		}
+		return castUnsigned(t.Name, big.NewInt(0).SetBytes(raw)), nil
+	case typeI8, typeI16, typeI32, typeI64, typeIsize:
+		raw, err := nextBytes(source, fixedWidthOf(t.Name))
+		if err != nil {
+			return nil, err
+		}
+		return castSigned(t.Name, signedFromBytes(raw)), nil
+	case typeBigUint, typeBigInt, typeBytes, typeBoxedBytes, typeManagedBuffer, typeUtf8String,
+		typeTokenIdentifier, typeEgldOrEsdtTokenIdentifier:
+		raw, eof := source.NextVarBytes()
+		if eof {
+			return nil, fmt.Errorf("%w for %s", ErrUnexpectedEndOfData, t.Name)
+		}
+		return codec.decodeTopLevel(t, raw)
+	case typeBool:
+		raw, err := nextBytes(source, 1)
+		if err != nil {
+			return nil, err
+		}
+		return decodeBool(raw)
+	case typeAddress:
+		raw, err := nextBytes(source, addressLength)
+		if err != nil {
+			return nil, err
+		}
+		return data.NewAddressFromBytes(raw), nil
+	case typeH256, typeCodeMetadata:
+		raw, err := nextBytes(source, fixedWidthOf(t.Name))
+		if err != nil {
+			return nil, err
+		}
+		return copyBytes(raw), nil
+	case typeOption:
+		marker, eof := source.NextByte()
+		if eof {
+			return nil, fmt.Errorf("%w for %s", ErrUnexpectedEndOfData, t)
+		}
+		switch marker {
+		case 0:
+			return nil, nil
+		case 1:
+			return codec.decodeNested(t.Generics[0], source)
+		default:
+			return nil, fmt.Errorf("%w: invalid option marker %d", ErrWrongValueType, marker)
+		}
+	case typeList:
+		length, eof := source.NextUint32()
+		if eof {
+			return nil, fmt.Errorf("%w for %s", ErrUnexpectedEndOfData, t)
+		}
+		return codec.decodeNestedItems(t.Generics[0], int(length), source)
+	case typeTuple:
+		items := make([]interface{}, 0, len(t.Generics))
+		for _, generic := range t.Generics {
+			item, err := codec.decodeNested(generic, source)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	if length, isArray := t.ArrayLength(); isArray {
+		return codec.decodeNestedItems(t.Generics[0], length, source)
+	}
+
+	if t.IsMultiValue() {
+		return nil, fmt.Errorf("%w: %s can not be nested", ErrWrongValueType, t)
+	}
+
+	return codec.decodeNestedCustom(t, source)
+}
+
+func (codec *abiCodec) decodeNestedItems(t *AbiType, length int, source *SourceBuffer) ([]interface{}, error) {
+	// the capacity is bounded by the remaining bytes as a corrupted length prefix should not trigger huge allocations
+	capacity := uint64(length)
+	if capacity > source.Len() {
+		capacity = source.Len()
+	}
+
+	items := make([]interface{}, 0, capacity)
+	for i := 0; i < length; i++ {
+		item, err := codec.decodeNested(t, source)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (codec *abiCodec) decodeNestedCustom(t *AbiType, source *SourceBuffer) (interface{}, error) {
+	definition, found := codec.abi.Types[t.Name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, t.Name)
+	}
+
+	switch definition.Type {
+	case AbiTypeStruct:
+		return codec.decodeFields(definition.Fields, source)
+	case AbiTypeEnum:
+		discriminant, eof := source.NextByte()
+		if eof {
+			return nil, fmt.Errorf("%w for enum %s", ErrUnexpectedEndOfData, t.Name)
+		}
+		enumValue, err := codec.createEnumValue(t.Name, definition, discriminant)
+		if err != nil {
+			return nil, err
+		}
+		variant := findVariant(definition, discriminant)
+		if len(variant.Fields) == 0 {
+			return enumValue, nil
+		}
+		enumValue.Fields, err = codec.decodeFields(variant.Fields, source)
+		if err != nil {
+			return nil, err
+		}
+		return enumValue, nil
+	default:
+		return nil, fmt.Errorf("%w: %s has kind %s", ErrUnknownType, t.Name, definition.Type)
+	}
+}
+
+func (codec *abiCodec) decodeFields(definitions []*AbiField, source *SourceBuffer) (map[string]interface{}, error) {
+	fields := make(map[string]interface{}, len(definitions))
+	for _, field := range definitions {
+		fieldType, err := ParseAbiType(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		fields[field.Name], err = codec.decodeNested(fieldType, source)
+		if err != nil {
+			return nil, fmt.Errorf("%w for field %s", err, field.Name)
+		}
+	}
+
+	return fields, nil
+}
+
+func (codec *abiCodec) createEnumValue(typeName string, definition *AbiTypeDefinition, discriminant uint8) (EnumValue, error) {
+	variant := findVariant(definition, discriminant)
+	if variant == nil {
+		return EnumValue{}, fmt.Errorf("%w for %s: %d", ErrInvalidDiscriminant, typeName, discriminant)
+	}
+
+	return EnumValue{
+		Name:         variant.Name,
+		Discriminant: variant.Discriminant,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (codec *abiCodec) IsInterfaceNil() bool {
+	return codec == nil
+}
+
+func findVariant(definition *AbiTypeDefinition, discriminant uint8) *AbiEnumVariant {
+	for _, variant := range definition.Variants {
+		if variant.Discriminant == discriminant {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+func isFieldlessEnum(definition *AbiTypeDefinition) bool {
+	for _, variant := range definition.Variants {
+		if len(variant.Fields) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fixedWidthOf(typeName string) int {
+	switch typeName {
+	case typeU8, typeI8:
+		return 1
+	case typeU16, typeI16:
+		return uint16Size
+	case typeU32, typeI32, typeUsize, typeIsize:
+		return uint32Size
+	case typeU64, typeI64:
+		return uint64Size
+	case typeAddress, typeH256:
+		return addressLength
+	case typeCodeMetadata:
+		return codeMetadataLength
+	default:
+		return 0
+	}
+}
+
+func checkUnsignedRange(typeName string, number *big.Int) error {
+	if number.Sign() < 0 {
+		return fmt.Errorf("%w: negative value %s for %s", ErrValueOutOfRange, number.String(), typeName)
+	}
+
+	width := fixedWidthOf(typeName)
+	if width > 0 && number.BitLen() > width*8 {
+		return fmt.Errorf("%w: %s for %s", ErrValueOutOfRange, number.String(), typeName)
+	}
+
+	return nil
+}
+
+func checkSignedRange(typeName string, number *big.Int) error {
+	width := fixedWidthOf(typeName)
+	if width == 0 {
+		return nil
+	}
+
+	limit := big.NewInt(0).Lsh(big.NewInt(1), uint(width*8-1))
+	minimum := big.NewInt(0).Neg(limit)
+	if number.Cmp(minimum) < 0 || number.Cmp(limit) >= 0 {
+		return fmt.Errorf("%w: %s for %s", ErrValueOutOfRange, number.String(), typeName)
+	}
+
+	return nil
+}
+
+// signedToMinimalBytes returns the minimal two's complement representation of the provided number
+func signedToMinimalBytes(number *big.Int) []byte {
+	switch number.Sign() {
+	case 0:
+		return make([]byte, 0)
+	case 1:
+		raw := number.Bytes()
+		if raw[0]&0x80 != 0 {
+			raw = append([]byte{0}, raw...)
+		}
+		return raw
+	default:
+		// two's complement of a negative number is the bitwise not of (|number| - 1)
+		raw := big.NewInt(0).Sub(big.NewInt(0).Neg(number), big.NewInt(1)).Bytes()
+		for i := range raw {
+			raw[i] = ^raw[i]
+		}
+		if len(raw) == 0 || raw[0]&0x80 == 0 {
+			raw = append([]byte{0xFF}, raw...)
+		}
+		return raw
+	}
+}
+
+// signedFromBytes interprets the provided bytes as a big endian two's complement number
+func signedFromBytes(raw []byte) *big.Int {
+	number := big.NewInt(0).SetBytes(raw)
+	if len(raw) > 0 && raw[0]&0x80 != 0 {
+		number.Sub(number, big.NewInt(0).Lsh(big.NewInt(1), uint(len(raw)*8)))
+	}
+
+	return number
+}
+
+// toFixedWidth returns the big endian two's complement representation of the number on the provided number of bytes
+func toFixedWidth(number *big.Int, width int) []byte {
+	value := big.NewInt(0).Set(number)
+	if value.Sign() < 0 {
+		value.Add(value, big.NewInt(0).Lsh(big.NewInt(1), uint(width*8)))
+	}
+
+	result := make([]byte, width)
+	value.FillBytes(result)
+
+	return result
+}
+
+func castUnsigned(typeName string, number *big.Int) interface{} {
+	switch typeName {
+	case typeU8:
+		return uint8(number.Uint64())
+	case typeU16:
+		return uint16(number.Uint64())
+	case typeU32, typeUsize:
+		return uint32(number.Uint64())
+	default:
+		return number.Uint64()
+	}
+}
+
+func castSigned(typeName string, number *big.Int) interface{} {
+	switch typeName {
+	case typeI8:
+		return int8(number.Int64())
+	case typeI16:
+		return int16(number.Int64())
+	case typeI32, typeIsize:
+		return int32(number.Int64())
+	default:
+		return number.Int64()
+	}
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil, fmt.Errorf("%w: nil *big.Int", ErrWrongValueType)
+		}
+		return big.NewInt(0).Set(v), nil
+	case big.Int:
+		return big.NewInt(0).Set(&v), nil
+	case string:
+		number, ok := big.NewInt(0).SetString(v, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not a decimal number", ErrWrongValueType, v)
+		}
+		return number, nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	switch reflectedValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(reflectedValue.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return big.NewInt(0).SetUint64(reflectedValue.Uint()), nil
+	default:
+		return nil, fmt.Errorf("%w: expected a number, got %T", ErrWrongValueType, value)
+	}
+}
+
+func toBytes(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	default:
+		return nil, fmt.Errorf("%w: expected []byte or string, got %T", ErrWrongValueType, value)
+	}
+}
+
+func toAddressBytes(value interface{}) ([]byte, error) {
+	var raw []byte
+	switch v := value.(type) {
+	case core.AddressHandler:
+		if check.IfNil(v) {
+			return nil, fmt.Errorf("%w: nil address", ErrWrongValueType)
+		}
+		raw = v.AddressBytes()
+	case []byte:
+		raw = v
+	default:
+		return nil, fmt.Errorf("%w: expected core.AddressHandler or []byte, got %T", ErrWrongValueType, value)
+	}
+
+	if len(raw) != addressLength {
+		return nil, fmt.Errorf("%w: address should have %d bytes, provided %d", ErrValueOutOfRange, addressLength, len(raw))
+	}
+
+	return raw, nil
+}
+
+func toSlice(value interface{}) ([]interface{}, error) {
+	if items, ok := value.([]interface{}); ok {
+		return items, nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Kind() == reflect.Struct {
+		return structFieldsByPosition(reflectedValue)
+	}
+	if reflectedValue.Kind() != reflect.Slice && reflectedValue.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%w: expected a slice, got %T", ErrWrongValueType, value)
+	}
+
+	items := make([]interface{}, 0, reflectedValue.Len())
+	for i := 0; i < reflectedValue.Len(); i++ {
+		items = append(items, reflectedValue.Index(i).Interface())
+	}
+
+	return items, nil
+}
+
+// dereference follows the pointers of typed Go values. *big.Int values, addresses and enum values are kept as they are
+// while nil pointers are converted to an untyped nil, the representation of a missing Option or optional value
+func dereference(value interface{}) interface{} {
+	for {
+		reflectedValue := reflect.ValueOf(value)
+		if reflectedValue.Kind() != reflect.Ptr {
+			return value
+		}
+		if reflectedValue.IsNil() {
+			return nil
+		}
+
+		switch value.(type) {
+		case *big.Int, core.AddressHandler, *EnumValue:
+			return value
+		}
+		value = reflectedValue.Elem().Interface()
+	}
+}
+
+func toFieldsMap(typeName string, definitions []*AbiField, value interface{}) (map[string]interface{}, error) {
+	if fields, ok := value.(map[string]interface{}); ok {
+		return fields, nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: expected map[string]interface{} or a struct for struct %s, got %T", ErrWrongValueType, typeName, value)
+	}
+
+	fields := make(map[string]interface{}, len(definitions))
+	for _, field := range definitions {
+		index, found := findStructField(reflectedValue.Type(), field.Name)
+		if !found {
+			return nil, fmt.Errorf("%w: missing field %s of %s in %T", ErrWrongValueType, field.Name, typeName, value)
+		}
+		fields[field.Name] = reflectedValue.Field(index).Interface()
+	}
+
+	return fields, nil
+}
+
+func structFieldsByPosition(reflectedValue reflect.Value) ([]interface{}, error) {
+	items := make([]interface{}, 0, reflectedValue.NumField())
+	for i := 0; i < reflectedValue.NumField(); i++ {
+		if !reflectedValue.Type().Field(i).IsExported() {
+			return nil, fmt.Errorf("%w: unexported field %s of %s", ErrWrongValueType, reflectedValue.Type().Field(i).Name, reflectedValue.Type())
+		}
+		items = append(items, reflectedValue.Field(i).Interface())
+	}
+
+	return items, nil
+}
+
+func decodeBool(raw []byte) (bool, error) {
+	if len(raw) != 1 || raw[0] > 1 {
+		return false, fmt.Errorf("%w: %x", ErrInvalidBoolValue, raw)
+	}
+
+	return raw[0] == 1, nil
+}
+
+func nextBytes(source *SourceBuffer, n int) ([]byte, error) {
+	if source.Len() < uint64(n) {
+		return nil, fmt.Errorf("%w: needed %d bytes, available %d", ErrUnexpectedEndOfData, n, source.Len())
+	}
+
+	raw, _ := source.NextBytes(uint32(n))
+
+	return raw, nil
+}
+
+func copyBytes(raw []byte) []byte {
+	result := make([]byte, len(raw))
+	copy(result, raw)
+
+	return result
+}
+
+func writeUint32(buff *bytes.Buffer, value uint32) {
+	length := make([]byte, uint32Size)
+	binary.BigEndian.PutUint32(length, value)
+	buff.Write(length)
+}
+
+func writeLengthPrefixed(buff *bytes.Buffer, raw []byte) {
+	writeUint32(buff, uint32(len(raw)))
+	buff.Write(raw)
+}
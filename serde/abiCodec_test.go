@@ -0,0 +1,392 @@
+package serde
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const srcTestContractAbi = "./testingMocks/testContract.abi.json"
+
+func createTestAbiCodec(t *testing.T) *abiCodec {
+	abi, err := LoadAbiFromFile(srcTestContractAbi)
+	require.Nil(t, err)
+
+	codec, err := NewAbiCodec(abi)
+	require.Nil(t, err)
+
+	return codec
+}
+
+func TestNewAbiCodec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil abi should error", func(t *testing.T) {
+		t.Parallel()
+
+		codec, err := NewAbiCodec(nil)
+		assert.True(t, check.IfNil(codec))
+		assert.Equal(t, ErrNilAbi, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		codec := createTestAbiCodec(t)
+		assert.False(t, check.IfNil(codec))
+	})
+}
+
+func TestParseAbiType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := ParseAbiType("variadic<multi<Option<List<u8>>, tuple<utf-8 string,array32<u8>>>>")
+		require.Nil(t, err)
+		assert.Equal(t, "variadic<multi<Option<List<u8>>,tuple<utf-8 string,array32<u8>>>>", parsed.String())
+		assert.True(t, parsed.IsMultiValue())
+	})
+	t.Run("invalid expressions should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidExpressions := []string{"", "List<u8", "List<>", "List<u8>>", "Option", "tuple<u8>x", "arrayX<u8>"}
+		for _, expression := range invalidExpressions {
+			_, err := ParseAbiType(expression)
+			assert.True(t, errors.Is(err, ErrInvalidTypeExpression), expression)
+		}
+	})
+}
+
+func TestAbiCodec_Primitives(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+	address := data.NewAddressFromBytes(bytes.Repeat([]byte{1}, 32))
+
+	testCases := []struct {
+		typeExpression string
+		value          interface{}
+		decoded        interface{}
+		topLevel       string
+		nested         string
+	}{
+		{typeU8, 0, uint8(0), "", "00"},
+		{typeU8, 255, uint8(255), "ff", "ff"},
+		{typeU16, uint16(258), uint16(258), "0102", "0102"},
+		{typeU32, 1, uint32(1), "01", "00000001"},
+		{typeUsize, 1, uint32(1), "01", "00000001"},
+		{typeU64, uint64(1) << 63, uint64(1) << 63, "8000000000000000", "8000000000000000"},
+		{typeI8, -1, int8(-1), "ff", "ff"},
+		{typeI16, 127, int16(127), "7f", "007f"},
+		{typeI16, 128, int16(128), "0080", "0080"},
+		{typeI32, -129, int32(-129), "ff7f", "ffffff7f"},
+		{typeI64, 0, int64(0), "", "0000000000000000"},
+		{typeBigUint, big.NewInt(0), big.NewInt(0), "", "00000000"},
+		{typeBigUint, "1000", big.NewInt(1000), "03e8", "0000000203e8"},
+		{typeBigInt, big.NewInt(-1), big.NewInt(-1), "ff", "00000001ff"},
+		{typeBigInt, big.NewInt(255), big.NewInt(255), "00ff", "0000000200ff"},
+		{typeBool, true, true, "01", "01"},
+		{typeBool, false, false, "", "00"},
+		{typeManagedBuffer, []byte("abc"), []byte("abc"), "616263", "00000003616263"},
+		{typeBytes, []byte{}, []byte{}, "", "00000000"},
+		{typeTokenIdentifier, "WEGLD-bd4d79", "WEGLD-bd4d79", hex.EncodeToString([]byte("WEGLD-bd4d79")), "0000000c" + hex.EncodeToString([]byte("WEGLD-bd4d79"))},
+		{typeEgldOrEsdtTokenIdentifier, EgldTokenIdentifier, EgldTokenIdentifier, "45474c44", "0000000445474c44"},
+		{typeUtf8String, "hi", "hi", "6869", "000000026869"},
+		{typeAddress, address, address, hex.EncodeToString(address.AddressBytes()), hex.EncodeToString(address.AddressBytes())},
+		{typeCodeMetadata, []byte{5, 0}, []byte{5, 0}, "0500", "0500"},
+	}
+
+	for _, tc := range testCases {
+		topLevel, err := codec.EncodeTopLevel(tc.typeExpression, tc.value)
+		require.Nil(t, err, tc.typeExpression)
+		assert.Equal(t, tc.topLevel, hex.EncodeToString(topLevel), tc.typeExpression)
+
+		nested, err := codec.EncodeNested(tc.typeExpression, tc.value)
+		require.Nil(t, err, tc.typeExpression)
+		assert.Equal(t, tc.nested, hex.EncodeToString(nested), tc.typeExpression)
+
+		decoded, err := codec.DecodeTopLevel(tc.typeExpression, topLevel)
+		require.Nil(t, err, tc.typeExpression)
+		assert.Equal(t, tc.decoded, decoded, tc.typeExpression)
+
+		decoded, err = codec.DecodeNested(tc.typeExpression, nested)
+		require.Nil(t, err, tc.typeExpression)
+		assert.Equal(t, tc.decoded, decoded, tc.typeExpression)
+	}
+}
+
+func TestAbiCodec_EncodeErrors(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+
+	_, err := codec.EncodeTopLevel(typeU8, 256)
+	assert.True(t, errors.Is(err, ErrValueOutOfRange))
+
+	_, err = codec.EncodeTopLevel(typeI8, -129)
+	assert.True(t, errors.Is(err, ErrValueOutOfRange))
+
+	_, err = codec.EncodeTopLevel(typeBigUint, big.NewInt(-1))
+	assert.True(t, errors.Is(err, ErrValueOutOfRange))
+
+	_, err = codec.EncodeTopLevel(typeBool, 1)
+	assert.True(t, errors.Is(err, ErrWrongValueType))
+
+	_, err = codec.EncodeTopLevel(typeAddress, []byte("short"))
+	assert.True(t, errors.Is(err, ErrValueOutOfRange))
+
+	_, err = codec.EncodeTopLevel("Unknown", 1)
+	assert.True(t, errors.Is(err, ErrUnknownType))
+
+	_, err = codec.EncodeNested("variadic<u8>", []interface{}{1})
+	assert.True(t, errors.Is(err, ErrWrongValueType))
+
+	_, err = codec.EncodeTopLevel("Status", "Paused")
+	assert.True(t, errors.Is(err, ErrInvalidDiscriminant))
+}
+
+func TestAbiCodec_DecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+
+	_, err := codec.DecodeNested(typeU32, []byte{1, 2})
+	assert.True(t, errors.Is(err, ErrUnexpectedEndOfData))
+
+	_, err = codec.DecodeNested(typeU8, []byte{1, 2})
+	assert.True(t, errors.Is(err, ErrTrailingBytes))
+
+	_, err = codec.DecodeTopLevel(typeU16, []byte{1, 2, 3})
+	assert.True(t, errors.Is(err, ErrValueOutOfRange))
+
+	_, err = codec.DecodeTopLevel(typeBool, []byte{2})
+	assert.True(t, errors.Is(err, ErrInvalidBoolValue))
+
+	_, err = codec.DecodeNested("List<u32>", []byte{0xff, 0xff, 0xff, 0xff, 0})
+	assert.True(t, errors.Is(err, ErrUnexpectedEndOfData))
+
+	_, err = codec.DecodeTopLevel("Action", []byte{7})
+	assert.True(t, errors.Is(err, ErrInvalidDiscriminant))
+}
+
+func TestAbiCodec_Generics(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+
+	t.Run("option", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := codec.EncodeTopLevel("Option<u32>", nil)
+		require.Nil(t, err)
+		assert.Empty(t, encoded)
+
+		encoded, err = codec.EncodeTopLevel("Option<u32>", 5)
+		require.Nil(t, err)
+		assert.Equal(t, "0100000005", hex.EncodeToString(encoded))
+
+		encoded, err = codec.EncodeNested("Option<u32>", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "00", hex.EncodeToString(encoded))
+
+		decoded, err := codec.DecodeTopLevel("Option<u32>", []byte{})
+		require.Nil(t, err)
+		assert.Nil(t, decoded)
+
+		decoded, err = codec.DecodeNested("Option<u32>", []byte{1, 0, 0, 0, 5})
+		require.Nil(t, err)
+		assert.Equal(t, uint32(5), decoded)
+	})
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := codec.EncodeTopLevel("List<u16>", []uint16{1, 2})
+		require.Nil(t, err)
+		assert.Equal(t, "00010002", hex.EncodeToString(encoded))
+
+		encoded, err = codec.EncodeNested("List<u16>", []uint16{1, 2})
+		require.Nil(t, err)
+		assert.Equal(t, "0000000200010002", hex.EncodeToString(encoded))
+
+		decoded, err := codec.DecodeTopLevel("List<u16>", encoded[4:])
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{uint16(1), uint16(2)}, decoded)
+	})
+	t.Run("tuple and array", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := codec.EncodeTopLevel("tuple<u8,BigUint>", []interface{}{1, big.NewInt(2)})
+		require.Nil(t, err)
+		assert.Equal(t, "010000000102", hex.EncodeToString(encoded))
+
+		decoded, err := codec.DecodeTopLevel("tuple<u8,BigUint>", encoded)
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{uint8(1), big.NewInt(2)}, decoded)
+
+		encoded, err = codec.EncodeNested("array2<u8>", []byte{3, 4})
+		require.Nil(t, err)
+		assert.Equal(t, "0304", hex.EncodeToString(encoded))
+
+		_, err = codec.EncodeNested("array2<u8>", []byte{3})
+		assert.True(t, errors.Is(err, ErrValueOutOfRange))
+	})
+}
+
+func TestAbiCodec_CustomTypes(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+	owner := data.NewAddressFromBytes(bytes.Repeat([]byte{2}, 32))
+
+	t.Run("struct", func(t *testing.T) {
+		t.Parallel()
+
+		config := map[string]interface{}{
+			"owner":       owner,
+			"token":       "TKN-123456",
+			"fee_percent": 250,
+			"limits":      []*big.Int{big.NewInt(10)},
+			"pair":        []interface{}{-2, true},
+		}
+		encoded, err := codec.EncodeTopLevel("Config", config)
+		require.Nil(t, err)
+
+		expected := hex.EncodeToString(owner.AddressBytes()) +
+			"0000000a" + hex.EncodeToString([]byte("TKN-123456")) +
+			"000000fa" +
+			"00000001" + "000000010a" +
+			"fffe" + "01"
+		assert.Equal(t, expected, hex.EncodeToString(encoded))
+
+		decoded, err := codec.DecodeTopLevel("Config", encoded)
+		require.Nil(t, err)
+		decodedConfig := decoded.(map[string]interface{})
+		assert.Equal(t, owner.AddressBytes(), decodedConfig["owner"].(core.AddressHandler).AddressBytes())
+		assert.Equal(t, "TKN-123456", decodedConfig["token"])
+		assert.Equal(t, uint32(250), decodedConfig["fee_percent"])
+		assert.Equal(t, []interface{}{big.NewInt(10)}, decodedConfig["limits"])
+		assert.Equal(t, []interface{}{int16(-2), true}, decodedConfig["pair"])
+
+		delete(config, "token")
+		_, err = codec.EncodeTopLevel("Config", config)
+		assert.True(t, errors.Is(err, ErrWrongValueType))
+	})
+	t.Run("fieldless enum", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := codec.EncodeTopLevel("Status", EnumValue{Name: "Inactive"})
+		require.Nil(t, err)
+		assert.Empty(t, encoded)
+
+		encoded, err = codec.EncodeNested("Status", EnumValue{Discriminant: 1})
+		require.Nil(t, err)
+		assert.Equal(t, []byte{1}, encoded)
+
+		decoded, err := codec.DecodeTopLevel("Status", []byte{})
+		require.Nil(t, err)
+		assert.Equal(t, EnumValue{Name: "Inactive", Discriminant: 0}, decoded)
+	})
+	t.Run("enum with fields", func(t *testing.T) {
+		t.Parallel()
+
+		transfer := EnumValue{
+			Name: "Transfer",
+			Fields: map[string]interface{}{
+				"to":     owner,
+				"amount": big.NewInt(-5),
+			},
+		}
+		encoded, err := codec.EncodeTopLevel("Action", transfer)
+		require.Nil(t, err)
+		assert.Equal(t, "01"+hex.EncodeToString(owner.AddressBytes())+"00000001fb", hex.EncodeToString(encoded))
+
+		decoded, err := codec.DecodeTopLevel("Action", encoded)
+		require.Nil(t, err)
+		decodedEnum := decoded.(EnumValue)
+		assert.Equal(t, "Transfer", decodedEnum.Name)
+		assert.Equal(t, big.NewInt(-5), decodedEnum.Fields["amount"])
+
+		encoded, err = codec.EncodeTopLevel("Action", EnumValue{Name: "Nothing"})
+		require.Nil(t, err)
+		assert.Equal(t, []byte{0}, encoded)
+	})
+}
+
+func TestAbiCodec_EndpointArgumentsAndOutputs(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+	destination := data.NewAddressFromBytes(bytes.Repeat([]byte{3}, 32))
+
+	t.Run("wrong number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := codec.EncodeEndpointArguments("deposit", []interface{}{destination})
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+	t.Run("unknown endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := codec.EncodeEndpointArguments("missing", nil)
+		assert.True(t, errors.Is(err, ErrEndpointNotFound))
+	})
+	t.Run("multi-value arguments", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := codec.EncodeEndpointArguments("deposit", []interface{}{
+			destination,
+			nil,
+			[]interface{}{
+				[]interface{}{EgldTokenIdentifier, 0, big.NewInt(1)},
+				[]interface{}{"NFT-abcdef", 7, 1},
+			},
+		})
+		require.Nil(t, err)
+		expected := [][]byte{
+			destination.AddressBytes(),
+			{},
+			[]byte(EgldTokenIdentifier), {}, {1},
+			[]byte("NFT-abcdef"), {7}, {1},
+		}
+		assert.Equal(t, expected, args)
+
+		args, err = codec.EncodeEndpointArguments("getConfig", []interface{}{nil})
+		require.Nil(t, err)
+		assert.Empty(t, args)
+
+		args, err = codec.EncodeConstructorArguments([]interface{}{big.NewInt(10)})
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{{10}}, args)
+	})
+	t.Run("outputs", func(t *testing.T) {
+		t.Parallel()
+
+		config, _ := hex.DecodeString(hex.EncodeToString(destination.AddressBytes()) + "00000003544b4e" + "00000001" + "00000000" + "0000" + "00")
+		returnData := [][]byte{config, {1}, {0}, append([]byte{2}, []byte{0, 0, 0, 1, 0xaa}...)}
+
+		results, err := codec.DecodeEndpointOutputs("getConfig", returnData)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(results))
+		assert.Equal(t, "TKN", results[0].(map[string]interface{})["token"])
+		assert.Equal(t, EnumValue{Name: "Active", Discriminant: 1}, results[1])
+		actions := results[2].([]interface{})
+		require.Equal(t, 2, len(actions))
+		assert.Equal(t, EnumValue{Name: "Nothing"}, actions[0])
+		assert.Equal(t, []byte{0xaa}, actions[1].(EnumValue).Fields["0"])
+
+		_, err = codec.DecodeEndpointOutputs("getSum", [][]byte{{1}, {2}})
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+
+		_, err = codec.DecodeEndpointOutputs("getSum", nil)
+		assert.True(t, errors.Is(err, ErrUnexpectedEndOfData))
+	})
+}
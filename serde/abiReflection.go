@@ -0,0 +1,229 @@
+package serde
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+const abiTagName = "abi"
+
+var (
+	bigIntPointerType = reflect.TypeOf(&big.Int{})
+	enumValueType     = reflect.TypeOf(EnumValue{})
+)
+
+// DecodeEndpointOutputsInto decodes the return data of the named endpoint and stores the results in the provided
+// targets, one pointer for each declared output
+func (codec *abiCodec) DecodeEndpointOutputsInto(endpointName string, returnData [][]byte, targets ...interface{}) error {
+	results, err := codec.DecodeEndpointOutputs(endpointName, returnData)
+	if err != nil {
+		return err
+	}
+	if len(results) != len(targets) {
+		return fmt.Errorf("%w, expected: %d targets, provided: %d", ErrWrongNumberOfArguments, len(results), len(targets))
+	}
+
+	for i, result := range results {
+		err = AssignAbiValue(result, targets[i])
+		if err != nil {
+			return fmt.Errorf("%w for output %d of %s", err, i, endpointName)
+		}
+	}
+
+	return nil
+}
+
+// AssignAbiValue stores a value produced by the ABI codec in the provided target pointer, converting it to the
+// target's Go type. Structs are filled from the decoded ABI structs (fields matched by the `abi` tag or by name) and
+// from the decoded tuples and multi-values (fields matched by position), integer types accept enum values, pointers
+// are left nil for missing Option and optional values
+func AssignAbiValue(value interface{}, target interface{}) error {
+	reflectedTarget := reflect.ValueOf(target)
+	if reflectedTarget.Kind() != reflect.Ptr || reflectedTarget.IsNil() {
+		return fmt.Errorf("%w: expected a non-nil pointer as target, got %T", ErrWrongValueType, target)
+	}
+
+	return assignValue(value, reflectedTarget.Elem())
+}
+
+func assignValue(value interface{}, target reflect.Value) error {
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Type().AssignableTo(target.Type()) {
+		target.Set(reflectedValue)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.Type() == bigIntPointerType {
+			number, err := toBigInt(value)
+			if err != nil {
+				return err
+			}
+			target.Set(reflect.ValueOf(number))
+			return nil
+		}
+		element := reflect.New(target.Type().Elem())
+		err := assignValue(value, element.Elem())
+		if err != nil {
+			return err
+		}
+		target.Set(element)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return assignInteger(value, target)
+	case reflect.String:
+		raw, err := toBytes(value)
+		if err != nil {
+			return err
+		}
+		target.SetString(string(raw))
+		return nil
+	case reflect.Slice:
+		if target.Type().Elem().Kind() == reflect.Uint8 {
+			if str, isString := value.(string); isString {
+				target.SetBytes([]byte(str))
+				return nil
+			}
+		}
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		slice := reflect.MakeSlice(target.Type(), len(items), len(items))
+		err = assignItems(items, slice)
+		if err != nil {
+			return err
+		}
+		target.Set(slice)
+		return nil
+	case reflect.Array:
+		items, err := toSlice(value)
+		if err != nil {
+			return err
+		}
+		if len(items) != target.Len() {
+			return fmt.Errorf("%w: %d items for %s", ErrValueOutOfRange, len(items), target.Type())
+		}
+		return assignItems(items, target)
+	case reflect.Struct:
+		return assignStruct(value, target)
+	}
+
+	return fmt.Errorf("%w: can not assign %T to %s", ErrWrongValueType, value, target.Type())
+}
+
+func assignInteger(value interface{}, target reflect.Value) error {
+	if enumValue, isEnum := value.(EnumValue); isEnum {
+		value = enumValue.Discriminant
+	}
+
+	number, err := toBigInt(value)
+	if err != nil {
+		return err
+	}
+
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !number.IsInt64() || target.OverflowInt(number.Int64()) {
+			return fmt.Errorf("%w: %s for %s", ErrValueOutOfRange, number.String(), target.Type())
+		}
+		target.SetInt(number.Int64())
+	default:
+		if !number.IsUint64() || target.OverflowUint(number.Uint64()) {
+			return fmt.Errorf("%w: %s for %s", ErrValueOutOfRange, number.String(), target.Type())
+		}
+		target.SetUint(number.Uint64())
+	}
+
+	return nil
+}
+
+func assignItems(items []interface{}, target reflect.Value) error {
+	for i, item := range items {
+		err := assignValue(item, target.Index(i))
+		if err != nil {
+			return fmt.Errorf("%w for item %d", err, i)
+		}
+	}
+
+	return nil
+}
+
+func assignStruct(value interface{}, target reflect.Value) error {
+	if target.Type() == enumValueType {
+		return fmt.Errorf("%w: can not assign %T to %s", ErrWrongValueType, value, target.Type())
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			index, found := findStructField(target.Type(), name)
+			if !found {
+				return fmt.Errorf("%w: %s has no field for %s", ErrWrongValueType, target.Type(), name)
+			}
+			err := assignValue(fieldValue, target.Field(index))
+			if err != nil {
+				return fmt.Errorf("%w for field %s", err, name)
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(v) != target.NumField() {
+			return fmt.Errorf("%w for %s, provided: %d", ErrWrongNumberOfArguments, target.Type(), len(v))
+		}
+		return assignFieldsByPosition(v, target)
+	default:
+		return fmt.Errorf("%w: can not assign %T to %s", ErrWrongValueType, value, target.Type())
+	}
+}
+
+func assignFieldsByPosition(items []interface{}, target reflect.Value) error {
+	for i, item := range items {
+		if !target.Type().Field(i).IsExported() {
+			return fmt.Errorf("%w: unexported field %s of %s", ErrWrongValueType, target.Type().Field(i).Name, target.Type())
+		}
+		err := assignValue(item, target.Field(i))
+		if err != nil {
+			return fmt.Errorf("%w for field %s", err, target.Type().Field(i).Name)
+		}
+	}
+
+	return nil
+}
+
+// findStructField returns the index of the exported struct field tagged with `abi:"<name>"`. Untagged fields
+// match if their name is equal to the ABI name, ignoring the case and the underscores
+func findStructField(structType reflect.Type, abiName string) (int, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, hasTag := field.Tag.Lookup(abiTagName)
+		if hasTag {
+			if tag == abiName {
+				return i, true
+			}
+			continue
+		}
+		if normalizeFieldName(field.Name) == normalizeFieldName(abiName) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
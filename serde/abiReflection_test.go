@@ -0,0 +1,192 @@
+package serde
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStatus uint8
+
+type testPair struct {
+	Item0 int16
+	Item1 bool
+}
+
+type testConfig struct {
+	Owner      core.AddressHandler `abi:"owner"`
+	Token      string              `abi:"token"`
+	FeePercent uint32              `abi:"fee_percent"`
+	Limits     []*big.Int          `abi:"limits"`
+	Pair       testPair            `abi:"pair"`
+}
+
+type testPayment struct {
+	Token  string
+	Nonce  uint64
+	Amount *big.Int
+}
+
+func TestAbiCodec_TypedValuesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+	owner := data.NewAddressFromBytes(bytes.Repeat([]byte{1}, 32))
+
+	t.Run("struct", func(t *testing.T) {
+		t.Parallel()
+
+		config := testConfig{
+			Owner:      owner,
+			Token:      "TKN-123456",
+			FeePercent: 250,
+			Limits:     []*big.Int{big.NewInt(5), big.NewInt(0)},
+			Pair:       testPair{Item0: -2, Item1: true},
+		}
+		encoded, err := codec.EncodeTopLevel("Config", &config)
+		require.Nil(t, err)
+
+		fromMap, err := codec.EncodeTopLevel("Config", map[string]interface{}{
+			"owner":       owner,
+			"token":       "TKN-123456",
+			"fee_percent": 250,
+			"limits":      []interface{}{5, 0},
+			"pair":        []interface{}{-2, true},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, fromMap, encoded)
+
+		decoded, err := codec.DecodeTopLevel("Config", encoded)
+		require.Nil(t, err)
+
+		result := testConfig{}
+		err = AssignAbiValue(decoded, &result)
+		require.Nil(t, err)
+		assert.Equal(t, owner.AddressBytes(), result.Owner.AddressBytes())
+		assert.Equal(t, config.Token, result.Token)
+		assert.Equal(t, config.FeePercent, result.FeePercent)
+		assert.Equal(t, config.Limits, result.Limits)
+		assert.Equal(t, config.Pair, result.Pair)
+	})
+	t.Run("option and optional pointers", func(t *testing.T) {
+		t.Parallel()
+
+		var missing *[]byte
+		encoded, err := codec.EncodeTopLevel("Option<bytes>", missing)
+		require.Nil(t, err)
+		assert.Empty(t, encoded)
+
+		memo := []byte("memo")
+		encoded, err = codec.EncodeTopLevel("Option<bytes>", &memo)
+		require.Nil(t, err)
+
+		decoded, err := codec.DecodeTopLevel("Option<bytes>", encoded)
+		require.Nil(t, err)
+		var result *[]byte
+		err = AssignAbiValue(decoded, &result)
+		require.Nil(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, memo, *result)
+
+		err = AssignAbiValue(nil, &result)
+		require.Nil(t, err)
+		assert.Nil(t, result)
+
+		var key *string
+		args, err := codec.EncodeEndpointArguments("getConfig", []interface{}{key})
+		require.Nil(t, err)
+		assert.Empty(t, args)
+	})
+	t.Run("multi-values from structs", func(t *testing.T) {
+		t.Parallel()
+
+		payments := []testPayment{
+			{Token: EgldTokenIdentifier, Amount: big.NewInt(1)},
+			{Token: "NFT-abcdef", Nonce: 7, Amount: big.NewInt(1)},
+		}
+		typedArgs, err := codec.EncodeEndpointArguments("deposit", []interface{}{owner, (*[]byte)(nil), payments})
+		require.Nil(t, err)
+
+		genericArgs, err := codec.EncodeEndpointArguments("deposit", []interface{}{
+			owner,
+			nil,
+			[]interface{}{
+				[]interface{}{EgldTokenIdentifier, 0, 1},
+				[]interface{}{"NFT-abcdef", 7, 1},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, genericArgs, typedArgs)
+	})
+	t.Run("enums", func(t *testing.T) {
+		t.Parallel()
+
+		encoded, err := codec.EncodeTopLevel("Status", testStatus(1))
+		require.Nil(t, err)
+		assert.Equal(t, []byte{1}, encoded)
+
+		_, err = codec.EncodeTopLevel("Status", 300)
+		assert.True(t, errors.Is(err, ErrWrongValueType))
+
+		decoded, err := codec.DecodeTopLevel("Status", encoded)
+		require.Nil(t, err)
+		var status testStatus
+		err = AssignAbiValue(decoded, &status)
+		require.Nil(t, err)
+		assert.Equal(t, testStatus(1), status)
+	})
+}
+
+func TestAbiCodec_DecodeEndpointOutputsInto(t *testing.T) {
+	t.Parallel()
+
+	codec := createTestAbiCodec(t)
+
+	t.Run("wrong number of targets should error", func(t *testing.T) {
+		t.Parallel()
+
+		var first, second *big.Int
+		err := codec.DecodeEndpointOutputsInto("getSum", [][]byte{{1}}, &first, &second)
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+	t.Run("non-pointer target should error", func(t *testing.T) {
+		t.Parallel()
+
+		var sum *big.Int
+		err := codec.DecodeEndpointOutputsInto("getSum", [][]byte{{1}}, sum)
+		assert.True(t, errors.Is(err, ErrWrongValueType))
+	})
+	t.Run("out of range value should error", func(t *testing.T) {
+		t.Parallel()
+
+		var small uint8
+		err := AssignAbiValue(big.NewInt(256), &small)
+		assert.True(t, errors.Is(err, ErrValueOutOfRange))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var sum *big.Int
+		err := codec.DecodeEndpointOutputsInto("getSum", [][]byte{{1, 0}}, &sum)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(256), sum)
+
+		config := append(bytes.Repeat([]byte{2}, 32), []byte{0, 0, 0, 3, 'T', 'K', 'N', 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}...)
+		var resultConfig testConfig
+		var status testStatus
+		var actions []EnumValue
+		err = codec.DecodeEndpointOutputsInto("getConfig", [][]byte{config, {1}, {0}, {0}}, &resultConfig, &status, &actions)
+		require.Nil(t, err)
+		assert.Equal(t, "TKN", resultConfig.Token)
+		assert.Equal(t, uint32(1), resultConfig.FeePercent)
+		assert.Equal(t, testStatus(1), status)
+		require.Equal(t, 2, len(actions))
+		assert.Equal(t, "Nothing", actions[0].Name)
+	})
+}
@@ -0,0 +1,188 @@
+package serde
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	typeU8                        = "u8"
+	typeU16                       = "u16"
+	typeU32                       = "u32"
+	typeU64                       = "u64"
+	typeUsize                     = "usize"
+	typeI8                        = "i8"
+	typeI16                       = "i16"
+	typeI32                       = "i32"
+	typeI64                       = "i64"
+	typeIsize                     = "isize"
+	typeBigUint                   = "BigUint"
+	typeBigInt                    = "BigInt"
+	typeBool                      = "bool"
+	typeAddress                   = "Address"
+	typeH256                      = "H256"
+	typeBytes                     = "bytes"
+	typeBoxedBytes                = "BoxedBytes"
+	typeManagedBuffer             = "ManagedBuffer"
+	typeUtf8String                = "utf-8 string"
+	typeTokenIdentifier           = "TokenIdentifier"
+	typeEgldOrEsdtTokenIdentifier = "EgldOrEsdtTokenIdentifier"
+	typeCodeMetadata              = "CodeMetadata"
+
+	typeOption         = "Option"
+	typeList           = "List"
+	typeTuple          = "tuple"
+	typeArrayPrefix    = "array"
+	typeVariadic       = "variadic"
+	typeCountedVarArgs = "counted-variadic"
+	typeMulti          = "multi"
+	typeOptional       = "optional"
+
+	// EgldTokenIdentifier is the EgldOrEsdtTokenIdentifier representation of the native token
+	EgldTokenIdentifier = "EGLD"
+)
+
+// AbiType is the parsed form of an ABI type expression such as `Option<List<tuple<u8,BigUint>>>`
+type AbiType struct {
+	Name     string
+	Generics []*AbiType
+}
+
+// String returns the ABI type expression
+func (t *AbiType) String() string {
+	if len(t.Generics) == 0 {
+		return t.Name
+	}
+
+	generics := make([]string, 0, len(t.Generics))
+	for _, generic := range t.Generics {
+		generics = append(generics, generic.String())
+	}
+
+	return fmt.Sprintf("%s<%s>", t.Name, strings.Join(generics, ","))
+}
+
+// IsMultiValue returns true if the type is encoded as several top-level arguments
+func (t *AbiType) IsMultiValue() bool {
+	switch t.Name {
+	case typeVariadic, typeCountedVarArgs, typeMulti, typeOptional:
+		return true
+	default:
+		return false
+	}
+}
+
+// ArrayLength returns the fixed length of an `arrayN<T>` type
+func (t *AbiType) ArrayLength() (int, bool) {
+	if !strings.HasPrefix(t.Name, typeArrayPrefix) || len(t.Generics) != 1 {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(strings.TrimPrefix(t.Name, typeArrayPrefix))
+	if err != nil || length < 0 {
+		return 0, false
+	}
+
+	return length, true
+}
+
+// ParseAbiType parses the provided ABI type expression
+func ParseAbiType(expression string) (*AbiType, error) {
+	parser := &typeExpressionParser{input: expression}
+	result, err := parser.parseType()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos != len(parser.input) {
+		return nil, fmt.Errorf("%w: unexpected character at position %d in %s", ErrInvalidTypeExpression, parser.pos, expression)
+	}
+
+	err = result.checkGenerics()
+	if err != nil {
+		return nil, fmt.Errorf("%w in %s", err, expression)
+	}
+
+	return result, nil
+}
+
+// checkGenerics verifies that the generic types have the expected number of type parameters
+func (t *AbiType) checkGenerics() error {
+	switch t.Name {
+	case typeOption, typeList, typeVariadic, typeCountedVarArgs, typeOptional:
+		if len(t.Generics) != 1 {
+			return fmt.Errorf("%w: %s expects one type parameter", ErrInvalidTypeExpression, t.Name)
+		}
+	case typeTuple, typeMulti:
+		if len(t.Generics) == 0 {
+			return fmt.Errorf("%w: %s expects at least one type parameter", ErrInvalidTypeExpression, t.Name)
+		}
+	default:
+		if strings.HasPrefix(t.Name, typeArrayPrefix) {
+			if _, isArray := t.ArrayLength(); !isArray {
+				return fmt.Errorf("%w: %s", ErrInvalidTypeExpression, t.Name)
+			}
+		}
+	}
+
+	for _, generic := range t.Generics {
+		err := generic.checkGenerics()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type typeExpressionParser struct {
+	input string
+	pos   int
+}
+
+func (parser *typeExpressionParser) parseType() (*AbiType, error) {
+	start := parser.pos
+	for parser.pos < len(parser.input) && !isTypeDelimiter(parser.input[parser.pos]) {
+		parser.pos++
+	}
+
+	name := strings.TrimSpace(parser.input[start:parser.pos])
+	if len(name) == 0 {
+		return nil, fmt.Errorf("%w: empty type name in %s", ErrInvalidTypeExpression, parser.input)
+	}
+
+	result := &AbiType{
+		Name: name,
+	}
+	if parser.pos == len(parser.input) || parser.input[parser.pos] != '<' {
+		return result, nil
+	}
+
+	parser.pos++
+	for {
+		generic, err := parser.parseType()
+		if err != nil {
+			return nil, err
+		}
+		result.Generics = append(result.Generics, generic)
+
+		if parser.pos == len(parser.input) {
+			return nil, fmt.Errorf("%w: unterminated generic in %s", ErrInvalidTypeExpression, parser.input)
+		}
+
+		delimiter := parser.input[parser.pos]
+		parser.pos++
+		switch delimiter {
+		case ',':
+			continue
+		case '>':
+			return result, nil
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q in %s", ErrInvalidTypeExpression, delimiter, parser.input)
+		}
+	}
+}
+
+func isTypeDelimiter(c byte) bool {
+	return c == '<' || c == '>' || c == ','
+}
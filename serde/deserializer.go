@@ -7,6 +7,8 @@ import (
 	"reflect"
 )
 
+const untilEndOfBuffer = -1
+
 type deserializer struct{}
 
 //NewDeserializer will create a new instance of the deserializer.
@@ -21,6 +23,14 @@ func (des *deserializer) CreatePrimitiveDataType(obj interface{}, buff []byte) e
 		return err
 	}
 
+	if reflectedValue.Kind() == reflect.Slice {
+		items, eof := des.getSliceItemsFromBuffer(NewSourceBuffer(buff), reflectedValue.Type(), untilEndOfBuffer)
+		if eof {
+			return errors.New("empty buffer")
+		}
+		return des.setValue(reflectedValue, items)
+	}
+
 	if reflectedValue.Kind() == reflect.String || reflectedValue.Type() == reflect.ValueOf(big.Int{}).Type() {
 		length := make([]byte, 4)
 		binary.BigEndian.PutUint32(length[:], uint32(len(buff)))
@@ -155,12 +165,60 @@ func (des *deserializer) getNextValueFromBuffer(buffer *SourceBuffer, v reflect.
 		}
 		return buffer.OffBytes(), false
 	case reflect.Ptr:
+		if v.Type() == reflect.TypeOf(&big.Int{}) {
+			buff, eof := buffer.NextVarBytes()
+			return big.NewInt(0).SetBytes(buff), eof
+		}
 		return buffer.OffBytes(), false
+	case reflect.Slice:
+		count, eof := buffer.NextUint32()
+		if eof {
+			return nil, eof
+		}
+		return des.getSliceItemsFromBuffer(buffer, v.Type(), int(count))
 	default:
 		return nil, true
 	}
 }
 
+// getSliceItemsFromBuffer reads count items of the slice element type. If count is untilEndOfBuffer, the items are
+// read until the buffer is exhausted
+func (des *deserializer) getSliceItemsFromBuffer(buffer *SourceBuffer, sliceType reflect.Type, count int) (interface{}, bool) {
+	items := reflect.MakeSlice(sliceType, 0, 0)
+	for index := 0; index != count; index++ {
+		if count == untilEndOfBuffer && buffer.Len() == 0 {
+			break
+		}
+
+		item := reflect.New(sliceType.Elem()).Elem()
+		err := des.setItem(item, buffer)
+		if err != nil {
+			return nil, true
+		}
+		items = reflect.Append(items, item)
+	}
+
+	return items.Interface(), false
+}
+
+func (des *deserializer) setItem(item reflect.Value, buffer *SourceBuffer) error {
+	if item.Kind() == reflect.Struct && item.Type() != reflect.TypeOf(big.Int{}) {
+		usedBytes, err := des.CreateStruct(item, buffer.OffBytes())
+		if err != nil {
+			return err
+		}
+		buffer.Skip(usedBytes)
+		return nil
+	}
+
+	valueFromBuffer, eof := des.getNextValueFromBuffer(buffer, item)
+	if eof {
+		return errors.New("empty buffer")
+	}
+
+	return des.setValue(item, valueFromBuffer)
+}
+
 func (des *deserializer) setValue(obj reflect.Value, value interface{}) error {
 	if !obj.IsValid() {
 		return errors.New("invalid object")
@@ -0,0 +1,39 @@
+package serde
+
+import "errors"
+
+// ErrNilAbi signals that a nil ABI definition was provided
+var ErrNilAbi = errors.New("nil ABI definition")
+
+// ErrInvalidTypeExpression signals that an ABI type expression could not be parsed
+var ErrInvalidTypeExpression = errors.New("invalid ABI type expression")
+
+// ErrUnknownType signals that an ABI type is neither a known primitive nor a custom type of the contract
+var ErrUnknownType = errors.New("unknown ABI type")
+
+// ErrEndpointNotFound signals that the requested endpoint is not defined in the ABI
+var ErrEndpointNotFound = errors.New("endpoint not found in ABI")
+
+// ErrWrongNumberOfArguments signals that the number of provided values does not match the ABI definition
+var ErrWrongNumberOfArguments = errors.New("wrong number of arguments")
+
+// ErrWrongValueType signals that the provided Go value can not be encoded as the requested ABI type
+var ErrWrongValueType = errors.New("wrong value type")
+
+// ErrValueOutOfRange signals that the provided value does not fit the requested ABI type
+var ErrValueOutOfRange = errors.New("value out of range")
+
+// ErrUnexpectedEndOfData signals that there were not enough bytes to decode the requested ABI type
+var ErrUnexpectedEndOfData = errors.New("unexpected end of data")
+
+// ErrTrailingBytes signals that some bytes remained unused after decoding a top-level value
+var ErrTrailingBytes = errors.New("trailing bytes after decoding")
+
+// ErrInvalidDiscriminant signals that an enum discriminant does not match any of the ABI variants
+var ErrInvalidDiscriminant = errors.New("invalid enum discriminant")
+
+// ErrInvalidBoolValue signals that a bool value was encoded with a byte other than 0 or 1
+var ErrInvalidBoolValue = errors.New("invalid bool value")
+
+// ErrMultiValueNotLast signals that a variadic value was not the last one in an arguments list
+var ErrMultiValueNotLast = errors.New("variadic value should be the last one")
@@ -5,3 +5,22 @@ type Deserializer interface {
 	CreateStruct(obj interface{}, buff []byte) (uint64, error)
 	CreatePrimitiveDataType(obj interface{}, buff []byte) error
 }
+
+// AbiCodec defines the methods used to encode arguments and decode results of a smart contract based on its ABI
+type AbiCodec interface {
+	EncodeEndpointArguments(endpointName string, values []interface{}) ([][]byte, error)
+	EncodeConstructorArguments(values []interface{}) ([][]byte, error)
+	DecodeEndpointOutputs(endpointName string, returnData [][]byte) ([]interface{}, error)
+	DecodeEndpointOutputsInto(endpointName string, returnData [][]byte, targets ...interface{}) error
+	EncodeTopLevel(typeExpression string, value interface{}) ([]byte, error)
+	EncodeNested(typeExpression string, value interface{}) ([]byte, error)
+	DecodeTopLevel(typeExpression string, buff []byte) (interface{}, error)
+	DecodeNested(typeExpression string, buff []byte) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// Serializer defines the methods used to encode objects into byte arrays that can be read back by a Deserializer
+type Serializer interface {
+	SerializeStruct(obj interface{}) ([]byte, error)
+	SerializePrimitiveDataType(obj interface{}) ([]byte, error)
+}
@@ -0,0 +1,195 @@
+package serde
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+type serializer struct{}
+
+// NewSerializer will create a new instance of the serializer.
+func NewSerializer() *serializer {
+	return &serializer{}
+}
+
+// SerializePrimitiveDataType encodes the received object using the top-level encoding. Strings and big integers are
+// written without their length prefix, slices are written as the concatenation of their nested encoded items while the
+// fixed size numbers and bools are written on their full width, as expected by the Deserializer
+func (ser *serializer) SerializePrimitiveDataType(obj interface{}) ([]byte, error) {
+	reflectedValue, err := ser.getReflectedValue(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case reflectedValue.Kind() == reflect.String:
+		return []byte(reflectedValue.String()), nil
+	case reflectedValue.Type() == bigIntType:
+		return ser.bigIntBytes(reflectedValue)
+	case reflectedValue.Kind() == reflect.Slice:
+		buff := &bytes.Buffer{}
+		err = ser.writeItems(reflectedValue, buff)
+		if err != nil {
+			return nil, err
+		}
+		return buff.Bytes(), nil
+	case reflectedValue.Kind() == reflect.Struct:
+		return nil, errors.New("invalid type")
+	}
+
+	buff := &bytes.Buffer{}
+	err = ser.writeValue(reflectedValue, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// SerializeStruct encodes all the fields of the received struct using the nested encoding
+func (ser *serializer) SerializeStruct(obj interface{}) ([]byte, error) {
+	reflectedValue, err := ser.getReflectedValue(obj)
+	if err != nil {
+		return nil, err
+	}
+	if reflectedValue.Kind() != reflect.Struct || reflectedValue.Type() == bigIntType {
+		return nil, errors.New("invalid type")
+	}
+
+	buff := &bytes.Buffer{}
+	err = ser.writeFields(reflectedValue, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (ser *serializer) getReflectedValue(obj interface{}) (reflect.Value, error) {
+	value, ok := obj.(reflect.Value)
+	if !ok {
+		value = reflect.ValueOf(obj)
+	}
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}, errors.New("nil object")
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return reflect.Value{}, errors.New("invalid object")
+	}
+
+	return value, nil
+}
+
+func (ser *serializer) writeFields(reflectedValue reflect.Value, buff *bytes.Buffer) error {
+	for fieldIndex := 0; fieldIndex < reflectedValue.NumField(); fieldIndex++ {
+		field := reflectedValue.Type().Field(fieldIndex)
+		if !field.IsExported() {
+			return fmt.Errorf("can't serialize unexported field %s", field.Name)
+		}
+
+		err := ser.writeValue(reflectedValue.Field(fieldIndex), buff)
+		if err != nil {
+			return fmt.Errorf("%w for field %s", err, field.Name)
+		}
+	}
+
+	return nil
+}
+
+func (ser *serializer) writeValue(value reflect.Value, buff *bytes.Buffer) error {
+	switch value.Kind() {
+	case reflect.Int8:
+		buff.WriteByte(uint8(value.Int()))
+	case reflect.Int16:
+		ser.writeUint(uint64(value.Int()), uint16Size, buff)
+	case reflect.Int32:
+		ser.writeUint(uint64(value.Int()), uint32Size, buff)
+	case reflect.Int64:
+		ser.writeUint(uint64(value.Int()), uint64Size, buff)
+	case reflect.Uint8:
+		buff.WriteByte(uint8(value.Uint()))
+	case reflect.Uint16:
+		ser.writeUint(value.Uint(), uint16Size, buff)
+	case reflect.Uint32:
+		ser.writeUint(value.Uint(), uint32Size, buff)
+	case reflect.Uint64:
+		ser.writeUint(value.Uint(), uint64Size, buff)
+	case reflect.Bool:
+		if value.Bool() {
+			buff.WriteByte(1)
+		} else {
+			buff.WriteByte(0)
+		}
+	case reflect.String:
+		ser.writeVarBytes([]byte(value.String()), buff)
+	case reflect.Slice:
+		ser.writeUint(uint64(value.Len()), uint32Size, buff)
+		return ser.writeItems(value, buff)
+	case reflect.Struct:
+		if value.Type() == bigIntType {
+			raw, err := ser.bigIntBytes(value)
+			if err != nil {
+				return err
+			}
+			ser.writeVarBytes(raw, buff)
+			return nil
+		}
+		return ser.writeFields(value, buff)
+	case reflect.Ptr:
+		if value.IsNil() {
+			return errors.New("nil pointer")
+		}
+		return ser.writeValue(value.Elem(), buff)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+
+	return nil
+}
+
+func (ser *serializer) writeItems(value reflect.Value, buff *bytes.Buffer) error {
+	for i := 0; i < value.Len(); i++ {
+		err := ser.writeValue(value.Index(i), buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ser *serializer) bigIntBytes(value reflect.Value) ([]byte, error) {
+	number := big.NewInt(0)
+	if value.CanAddr() {
+		number = value.Addr().Interface().(*big.Int)
+	} else {
+		copied := value.Interface().(big.Int)
+		number.Set(&copied)
+	}
+
+	if number.Sign() < 0 {
+		return nil, fmt.Errorf("%w: negative big integer %s", ErrValueOutOfRange, number.String())
+	}
+
+	return number.Bytes(), nil
+}
+
+func (ser *serializer) writeUint(value uint64, size int, buff *bytes.Buffer) {
+	raw := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(raw, value)
+	buff.Write(raw[uint64Size-size:])
+}
+
+func (ser *serializer) writeVarBytes(raw []byte, buff *bytes.Buffer) {
+	ser.writeUint(uint64(len(raw)), uint32Size, buff)
+	buff.Write(raw)
+}
@@ -0,0 +1,176 @@
+package serde
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/serde/testingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializer_SerializeStruct_BasicTypes(t *testing.T) {
+	t.Parallel()
+
+	expectedData, err := ioutil.ReadFile(srcBasicTypes)
+	require.Nil(t, err)
+
+	bigInt := big.Int{}
+	bigInt.SetBytes([]byte{23, 0, 0, 0})
+	bt := &testingMocks.DataBasics{
+		U8:              8,
+		U16:             16,
+		U32:             32,
+		U64:             64,
+		I8:              8,
+		I16:             16,
+		I32:             32,
+		I64:             64,
+		Bool:            true,
+		BoxedBytes:      "BoxedBytes",
+		TokenIdentifier: "ALC-6258d2",
+		BigInt:          bigInt,
+		BigUint:         bigInt,
+	}
+
+	ser := NewSerializer()
+	serialized, err := ser.SerializeStruct(bt)
+	require.Nil(t, err)
+	assert.Equal(t, expectedData, serialized)
+}
+
+func TestSerializer_SerializeStruct_NestedStructures(t *testing.T) {
+	t.Parallel()
+
+	expectedData, err := ioutil.ReadFile(srcNestedStructures)
+	require.Nil(t, err)
+
+	bigInt := big.Int{}
+	bigInt.SetBytes([]byte{23, 0, 0, 0})
+	nesting := testingMocks.NestingStructure{
+		String: "BoxedBytes",
+		Ticker: "ALC-6258d2",
+		Bool:   false,
+		Int64:  385875968,
+		BigInt: bigInt,
+		OtherStruct: testingMocks.OtherStruct{
+			String: "BoxedBytes",
+			Bool:   true,
+		},
+		AnotherBigInt: bigInt,
+	}
+
+	ser := NewSerializer()
+	serialized, err := ser.SerializeStruct(nesting)
+	require.Nil(t, err)
+	assert.Equal(t, expectedData, serialized)
+}
+
+func TestSerializer_SerializeStruct_SlicesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := &testingMocks.SliceStructure{
+		Bytes:   []byte("abc"),
+		Numbers: []uint32{1, 2},
+		Amounts: []*big.Int{big.NewInt(0), big.NewInt(1000)},
+		Structs: []testingMocks.OtherStruct{
+			{String: "first", Bool: true},
+			{String: "second", Bool: false},
+		},
+		Amount: big.NewInt(255),
+	}
+
+	ser := NewSerializer()
+	serialized, err := ser.SerializeStruct(original)
+	require.Nil(t, err)
+
+	expected := "00000003616263" +
+		"00000002" + "00000001" + "00000002" +
+		"00000002" + "00000000" + "0000000203e8" +
+		"00000002" + "000000056669727374" + "01" + "000000067365636f6e64" + "00" +
+		"00000001ff"
+	assert.Equal(t, expected, hex.EncodeToString(serialized))
+
+	decoded := &testingMocks.SliceStructure{}
+	usedBytes, err := NewDeserializer().CreateStruct(decoded, serialized)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(len(serialized)), usedBytes)
+	assert.Equal(t, original, decoded)
+}
+
+func TestSerializer_SerializePrimitiveDataType(t *testing.T) {
+	t.Parallel()
+
+	ser := NewSerializer()
+	des := NewDeserializer()
+
+	t.Run("big int", func(t *testing.T) {
+		t.Parallel()
+
+		expectedData, err := ioutil.ReadFile(srcPrimitive)
+		require.Nil(t, err)
+
+		value := big.NewInt(0).SetBytes(expectedData)
+		serialized, err := ser.SerializePrimitiveDataType(value)
+		require.Nil(t, err)
+		assert.Equal(t, expectedData, serialized)
+
+		_, err = ser.SerializePrimitiveDataType(big.NewInt(-1))
+		assert.ErrorIs(t, err, ErrValueOutOfRange)
+	})
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		serialized, err := ser.SerializePrimitiveDataType("ALC-6258d2")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("ALC-6258d2"), serialized)
+
+		decoded := ""
+		err = des.CreatePrimitiveDataType(&decoded, serialized)
+		require.Nil(t, err)
+		assert.Equal(t, "ALC-6258d2", decoded)
+	})
+	t.Run("fixed size numbers", func(t *testing.T) {
+		t.Parallel()
+
+		serialized, err := ser.SerializePrimitiveDataType(int16(-2))
+		require.Nil(t, err)
+		assert.Equal(t, []byte{0xff, 0xfe}, serialized)
+
+		decoded := int16(0)
+		err = des.CreatePrimitiveDataType(&decoded, serialized)
+		require.Nil(t, err)
+		assert.Equal(t, int16(-2), decoded)
+	})
+	t.Run("slice", func(t *testing.T) {
+		t.Parallel()
+
+		values := []string{"a", "bc"}
+		serialized, err := ser.SerializePrimitiveDataType(values)
+		require.Nil(t, err)
+		assert.Equal(t, "0000000161000000026263", hex.EncodeToString(serialized))
+
+		var decoded []string
+		err = des.CreatePrimitiveDataType(&decoded, serialized)
+		require.Nil(t, err)
+		assert.Equal(t, values, decoded)
+	})
+	t.Run("unsupported types should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ser.SerializePrimitiveDataType(1)
+		assert.NotNil(t, err)
+
+		_, err = ser.SerializePrimitiveDataType(testingMocks.OtherStruct{})
+		assert.NotNil(t, err)
+
+		_, err = ser.SerializeStruct(big.NewInt(1))
+		assert.NotNil(t, err)
+
+		var nilPointer *testingMocks.OtherStruct
+		_, err = ser.SerializeStruct(nilPointer)
+		assert.NotNil(t, err)
+	})
+}
@@ -38,3 +38,12 @@ type NestingStructure struct {
 	OtherStruct   OtherStruct
 	AnotherBigInt big.Int
 }
+
+// SliceStructure contains slices of primitives, of big integers and of nested structures
+type SliceStructure struct {
+	Bytes   []byte
+	Numbers []uint32
+	Amounts []*big.Int
+	Structs []OtherStruct
+	Amount  *big.Int
+}
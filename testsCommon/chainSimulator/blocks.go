@@ -0,0 +1,406 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const miniblockTypeTxBlock = "TxBlock"
+
+var (
+	blocksMarshaller = &marshal.GogoProtoMarshalizer{}
+	blocksHasher     = blake2b.NewBlake2b()
+)
+
+type shardPair struct {
+	sender   uint32
+	receiver uint32
+}
+
+type blocksStorage struct {
+	hyperBlocks          []*data.HyperBlock
+	epochStarts          map[uint64]uint64
+	epochStartMetaBlocks map[uint64][]byte
+	rawBlocksByHash      map[uint32]map[string][]byte
+	rawBlocksByNonce     map[uint32]map[uint64][]byte
+	rawMiniBlocks        map[string][]byte
+	lastHeaderHashes     map[uint32][]byte
+}
+
+func newBlocksStorage() *blocksStorage {
+	return &blocksStorage{
+		hyperBlocks:          make([]*data.HyperBlock, 0),
+		epochStarts:          make(map[uint64]uint64),
+		epochStartMetaBlocks: make(map[uint64][]byte),
+		rawBlocksByHash:      make(map[uint32]map[string][]byte),
+		rawBlocksByNonce:     make(map[uint32]map[uint64][]byte),
+		rawMiniBlocks:        make(map[string][]byte),
+		lastHeaderHashes:     make(map[uint32][]byte),
+	}
+}
+
+func (storage *blocksStorage) latest() *data.HyperBlock {
+	return storage.hyperBlocks[len(storage.hyperBlocks)-1]
+}
+
+func (storage *blocksStorage) putBlock(shardID uint32, nonce uint64, hash []byte, buff []byte) {
+	_, found := storage.rawBlocksByHash[shardID]
+	if !found {
+		storage.rawBlocksByHash[shardID] = make(map[string][]byte)
+		storage.rawBlocksByNonce[shardID] = make(map[uint64][]byte)
+	}
+
+	storage.rawBlocksByHash[shardID][hex.EncodeToString(hash)] = buff
+	storage.rawBlocksByNonce[shardID][nonce] = buff
+	storage.lastHeaderHashes[shardID] = hash
+}
+
+// produceBlock executes the pending transactions and produces a shard block in each shard together with the
+// metachain block notarizing them, exposed as a hyper block. Each round produces a block, so the nonce and the round
+// are always equal
+func (sim *chainSimulator) produceBlock() error {
+	sim.mut.Lock()
+	defer sim.mut.Unlock()
+
+	nonce := uint64(len(sim.blocks.hyperBlocks))
+	epoch := uint64(0)
+	if sim.networkConfig.RoundsPerEpoch > 0 {
+		epoch = nonce / uint64(sim.networkConfig.RoundsPerEpoch)
+	}
+	isEpochStart := nonce == 0 || (sim.networkConfig.RoundsPerEpoch > 0 && nonce%uint64(sim.networkConfig.RoundsPerEpoch) == 0)
+	timestamp := uint64(sim.networkConfig.StartTime) + nonce*uint64(sim.networkConfig.RoundDuration)/1000
+
+	executed := sim.selectExecutableTransactions()
+	statuses := make([]string, 0, len(executed))
+	for _, pending := range executed {
+		statuses = append(statuses, string(sim.executeTransaction(pending)))
+	}
+
+	miniBlocks, miniBlocksHashes, err := sim.createMiniBlocks(executed)
+	if err != nil {
+		return err
+	}
+
+	shardInfo := make([]block.ShardData, 0, sim.networkConfig.NumShardsWithoutMeta)
+	shardHashes := make(map[uint32][]byte)
+	for shardID := uint32(0); shardID < sim.networkConfig.NumShardsWithoutMeta; shardID++ {
+		header := &block.Header{
+			Nonce:            nonce,
+			PrevHash:         sim.blocks.lastHeaderHashes[shardID],
+			ShardID:          shardID,
+			TimeStamp:        timestamp,
+			Round:            nonce,
+			Epoch:            uint32(epoch),
+			BlockBodyType:    block.TxBlock,
+			MiniBlockHeaders: shardMiniBlockHeaders(shardID, miniBlocks, miniBlocksHashes),
+			ChainID:          []byte(sim.networkConfig.ChainID),
+			SoftwareVersion:  []byte(sim.networkConfig.LatestTagSoftwareVersion),
+			AccumulatedFees:  big.NewInt(0),
+			DeveloperFees:    big.NewInt(0),
+		}
+		header.TxCount = countTransactions(header.MiniBlockHeaders)
+
+		headerHash, errStore := sim.storeBlock(shardID, nonce, header)
+		if errStore != nil {
+			return errStore
+		}
+		shardHashes[shardID] = headerHash
+
+		shardInfo = append(shardInfo, block.ShardData{
+			HeaderHash:            headerHash,
+			ShardMiniBlockHeaders: header.MiniBlockHeaders,
+			Round:                 nonce,
+			PrevHash:              header.PrevHash,
+			Nonce:                 nonce,
+			AccumulatedFees:       big.NewInt(0),
+			DeveloperFees:         big.NewInt(0),
+			ShardID:               shardID,
+			TxCount:               header.TxCount,
+		})
+	}
+
+	metaBlock := &block.MetaBlock{
+		Nonce:                  nonce,
+		Epoch:                  uint32(epoch),
+		Round:                  nonce,
+		TimeStamp:              timestamp,
+		ShardInfo:              shardInfo,
+		PrevHash:               sim.blocks.lastHeaderHashes[core.MetachainShardId],
+		ChainID:                []byte(sim.networkConfig.ChainID),
+		SoftwareVersion:        []byte(sim.networkConfig.LatestTagSoftwareVersion),
+		AccumulatedFees:        big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+		TxCount:                uint32(len(executed)),
+		EpochStart:             createEpochStart(isEpochStart, shardInfo),
+	}
+	metaHash, err := sim.storeBlock(core.MetachainShardId, nonce, metaBlock)
+	if err != nil {
+		return err
+	}
+	if isEpochStart {
+		sim.blocks.epochStarts[epoch] = nonce
+		sim.blocks.epochStartMetaBlocks[epoch] = sim.blocks.rawBlocksByNonce[core.MetachainShardId][nonce]
+	}
+
+	hyperBlock := &data.HyperBlock{
+		Nonce:         nonce,
+		Round:         nonce,
+		Hash:          hex.EncodeToString(metaHash),
+		PrevBlockHash: hex.EncodeToString(metaBlock.PrevHash),
+		Epoch:         epoch,
+		NumTxs:        uint64(len(executed)),
+		Timestamp:     timestamp,
+		Transactions:  make([]data.TransactionOnNetwork, 0, len(executed)),
+	}
+	hyperBlock.ShardBlocks = make([]struct {
+		Hash  string `json:"hash"`
+		Nonce uint64 `json:"nonce"`
+		Shard uint32 `json:"shard"`
+	}, len(shardInfo))
+	for idx, shardData := range shardInfo {
+		hyperBlock.ShardBlocks[idx].Hash = hex.EncodeToString(shardData.HeaderHash)
+		hyperBlock.ShardBlocks[idx].Nonce = shardData.Nonce
+		hyperBlock.ShardBlocks[idx].Shard = shardData.ShardID
+	}
+
+	for idx, pending := range executed {
+		tx := sim.transactions[pending.hash]
+		tx.Status = statuses[idx]
+		tx.BlockNonce = nonce
+		tx.BlockHash = hex.EncodeToString(shardHashes[pending.sourceShard])
+		tx.MiniblockType = miniblockTypeTxBlock
+		tx.MiniblockHash = hex.EncodeToString(miniBlocksHashes[shardPair{sender: pending.sourceShard, receiver: pending.destinationShard}])
+		tx.Timestamp = timestamp
+		tx.HyperBlockNonce = nonce
+		tx.HyperBlockHash = hyperBlock.Hash
+		tx.NotarizedAtSourceInMetaNonce = nonce
+		tx.NotarizedAtSourceInMetaHash = hyperBlock.Hash
+		tx.NotarizedAtDestinationInMetaNonce = nonce
+		tx.NotarizedAtDestinationInMetaHash = hyperBlock.Hash
+
+		hyperBlock.Transactions = append(hyperBlock.Transactions, *tx)
+	}
+
+	sim.blocks.hyperBlocks = append(sim.blocks.hyperBlocks, hyperBlock)
+	log.Debug("chainSimulator: produced block", "nonce", nonce, "epoch", epoch, "num txs", len(executed))
+
+	return nil
+}
+
+// createMiniBlocks groups the executed transactions in a miniblock for each sender and receiver shards pair
+func (sim *chainSimulator) createMiniBlocks(executed []*pendingTransaction) ([]*block.MiniBlock, map[shardPair][]byte, error) {
+	miniBlocksByPair := make(map[shardPair]*block.MiniBlock)
+	pairs := make([]shardPair, 0)
+	for _, pending := range executed {
+		pair := shardPair{sender: pending.sourceShard, receiver: pending.destinationShard}
+		miniBlock, found := miniBlocksByPair[pair]
+		if !found {
+			miniBlock = &block.MiniBlock{
+				SenderShardID:   pair.sender,
+				ReceiverShardID: pair.receiver,
+				Type:            block.TxBlock,
+			}
+			miniBlocksByPair[pair] = miniBlock
+			pairs = append(pairs, pair)
+		}
+
+		txHash, err := hex.DecodeString(pending.hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		miniBlock.TxHashes = append(miniBlock.TxHashes, txHash)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].sender != pairs[j].sender {
+			return pairs[i].sender < pairs[j].sender
+		}
+		return pairs[i].receiver < pairs[j].receiver
+	})
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(pairs))
+	hashes := make(map[shardPair][]byte, len(pairs))
+	for _, pair := range pairs {
+		miniBlock := miniBlocksByPair[pair]
+		buff, err := blocksMarshaller.Marshal(miniBlock)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		hash := blocksHasher.Compute(string(buff))
+		sim.blocks.rawMiniBlocks[hex.EncodeToString(hash)] = buff
+		miniBlocks = append(miniBlocks, miniBlock)
+		hashes[pair] = hash
+	}
+
+	return miniBlocks, hashes, nil
+}
+
+func (sim *chainSimulator) storeBlock(shardID uint32, nonce uint64, header interface{}) ([]byte, error) {
+	buff, err := blocksMarshaller.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := blocksHasher.Compute(string(buff))
+	sim.blocks.putBlock(shardID, nonce, hash, buff)
+
+	return hash, nil
+}
+
+// shardMiniBlockHeaders returns the headers of the miniblocks sent or received by the shard, the cross shard
+// miniblocks being included in both the sender and the receiver shard blocks
+func shardMiniBlockHeaders(shardID uint32, miniBlocks []*block.MiniBlock, hashes map[shardPair][]byte) []block.MiniBlockHeader {
+	headers := make([]block.MiniBlockHeader, 0)
+	for _, miniBlock := range miniBlocks {
+		if miniBlock.SenderShardID != shardID && miniBlock.ReceiverShardID != shardID {
+			continue
+		}
+
+		headers = append(headers, block.MiniBlockHeader{
+			Hash:            hashes[shardPair{sender: miniBlock.SenderShardID, receiver: miniBlock.ReceiverShardID}],
+			SenderShardID:   miniBlock.SenderShardID,
+			ReceiverShardID: miniBlock.ReceiverShardID,
+			TxCount:         uint32(len(miniBlock.TxHashes)),
+			Type:            miniBlock.Type,
+		})
+	}
+
+	return headers
+}
+
+func countTransactions(headers []block.MiniBlockHeader) uint32 {
+	count := uint32(0)
+	for _, header := range headers {
+		count += header.TxCount
+	}
+
+	return count
+}
+
+func createEpochStart(isEpochStart bool, shardInfo []block.ShardData) block.EpochStart {
+	epochStart := block.EpochStart{
+		Economics: block.Economics{
+			TotalSupply:                      big.NewInt(0),
+			TotalToDistribute:                big.NewInt(0),
+			TotalNewlyMinted:                 big.NewInt(0),
+			RewardsPerBlock:                  big.NewInt(0),
+			RewardsForProtocolSustainability: big.NewInt(0),
+			NodePrice:                        big.NewInt(0),
+		},
+	}
+	if !isEpochStart {
+		return epochStart
+	}
+
+	for _, shardData := range shardInfo {
+		epochStart.LastFinalizedHeaders = append(epochStart.LastFinalizedHeaders, block.EpochStartShardData{
+			ShardID:    shardData.ShardID,
+			Round:      shardData.Round,
+			Nonce:      shardData.Nonce,
+			HeaderHash: shardData.HeaderHash,
+		})
+	}
+
+	return epochStart
+}
+
+func (sim *chainSimulator) checkShardID(shardID uint32) error {
+	if shardID == core.MetachainShardId || shardID < sim.networkConfig.NumShardsWithoutMeta {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d", ErrInvalidShardID, shardID)
+}
+
+// GetNonceAtEpochStart returns the nonce of the first block in the current epoch
+func (sim *chainSimulator) GetNonceAtEpochStart(_ context.Context, shardID uint32) (uint64, error) {
+	err := sim.checkShardID(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	return sim.blocks.epochStarts[sim.blocks.latest().Epoch], nil
+}
+
+// GetRawBlockByHash returns the marshalled shard or metachain block with the provided hash
+func (sim *chainSimulator) GetRawBlockByHash(_ context.Context, shardID uint32, hash string) ([]byte, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	buff, found := sim.blocks.rawBlocksByHash[shardID][hash]
+	if !found {
+		return nil, fmt.Errorf("%w for shard %d and hash %s", ErrBlockNotFound, shardID, hash)
+	}
+
+	return copyBytes(buff), nil
+}
+
+// GetRawBlockByNonce returns the marshalled shard or metachain block with the provided nonce
+func (sim *chainSimulator) GetRawBlockByNonce(_ context.Context, shardID uint32, nonce uint64) ([]byte, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	buff, found := sim.blocks.rawBlocksByNonce[shardID][nonce]
+	if !found {
+		return nil, fmt.Errorf("%w for shard %d and nonce %d", ErrBlockNotFound, shardID, nonce)
+	}
+
+	return copyBytes(buff), nil
+}
+
+// GetRawMiniBlockByHash returns the marshalled miniblock with the provided hash
+func (sim *chainSimulator) GetRawMiniBlockByHash(_ context.Context, shardID uint32, hash string, _ uint32) ([]byte, error) {
+	err := sim.checkShardID(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	buff, found := sim.blocks.rawMiniBlocks[hash]
+	if !found {
+		return nil, fmt.Errorf("%w for miniblock hash %s", ErrBlockNotFound, hash)
+	}
+
+	return copyBytes(buff), nil
+}
+
+// GetRawStartOfEpochMetaBlock returns the marshalled metachain block starting the provided epoch
+func (sim *chainSimulator) GetRawStartOfEpochMetaBlock(_ context.Context, epoch uint32) ([]byte, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	buff, found := sim.blocks.epochStartMetaBlocks[uint64(epoch)]
+	if !found {
+		return nil, fmt.Errorf("%w for start of epoch %d", ErrBlockNotFound, epoch)
+	}
+
+	return copyBytes(buff), nil
+}
+
+func copyHyperBlock(hyperBlock *data.HyperBlock) *data.HyperBlock {
+	hyperBlockCopy := *hyperBlock
+	hyperBlockCopy.ShardBlocks = append(hyperBlock.ShardBlocks[:0:0], hyperBlock.ShardBlocks...)
+	hyperBlockCopy.Transactions = append(make([]data.TransactionOnNetwork, 0, len(hyperBlock.Transactions)), hyperBlock.Transactions...)
+
+	return &hyperBlockCopy
+}
+
+func copyBytes(buff []byte) []byte {
+	return append(make([]byte, 0, len(buff)), buff...)
+}
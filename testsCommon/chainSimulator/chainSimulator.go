@@ -0,0 +1,556 @@
+package chainSimulator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/testsCommon/chainSimulator")
+
+const vmUserError = "user error"
+
+// VMQueryHandler answers the VM queries done on a simulated smart contract
+type VMQueryHandler func(request *data.VmValueRequest) (*vm.VMOutputApi, error)
+
+// ArgsChainSimulator is the DTO used in the chain simulator constructor
+type ArgsChainSimulator struct {
+	NetworkConfig      *data.NetworkConfig
+	RatingsConfig      *data.RatingsConfig
+	EnableEpochsConfig *data.EnableEpochsConfig
+	// BlockInterval is the cadence of the blocks production, 0 meaning that the blocks are only produced on
+	// GenerateBlocks calls
+	BlockInterval time.Duration
+	// VerifySignatures enables the verification of the sender and guardian signatures of the sent transactions
+	VerifySignatures bool
+}
+
+type txHashComputer interface {
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+}
+
+type addressShardCoordinator interface {
+	ComputeShardId(address sdkCore.AddressHandler) (uint32, error)
+}
+
+type tokenKey struct {
+	identifier string
+	nonce      uint64
+}
+
+type accountState struct {
+	nonce   uint64
+	balance *big.Int
+	tokens  map[tokenKey]*big.Int
+}
+
+type pendingTransaction struct {
+	hash             string
+	tx               *transaction.FrontendTransaction
+	value            *big.Int
+	sourceShard      uint32
+	destinationShard uint32
+}
+
+type chainSimulator struct {
+	networkConfig      data.NetworkConfig
+	ratingsConfig      *data.RatingsConfig
+	enableEpochsConfig *data.EnableEpochsConfig
+	verifySignatures   bool
+	shardCoordinator   addressShardCoordinator
+	txHashComputer     txHashComputer
+
+	mut             sync.RWMutex
+	accounts        map[string]*accountState
+	pool            map[string]*pendingTransaction
+	transactions    map[string]*data.TransactionOnNetwork
+	blocks          *blocksStorage
+	vmQueryHandlers map[string]VMQueryHandler
+
+	cancel func()
+}
+
+// DefaultNetworkConfig returns the network configuration of a local testnet with 3 shards
+func DefaultNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:                  "localnet",
+		Denomination:             18,
+		GasPerDataByte:           1500,
+		LatestTagSoftwareVersion: "simulator",
+		MetaConsensusGroup:       1,
+		MinGasLimit:              50000,
+		MinGasPrice:              1000000000,
+		MinTransactionVersion:    1,
+		NumMetachainNodes:        1,
+		NumNodesInShard:          1,
+		NumShardsWithoutMeta:     3,
+		RoundDuration:            6000,
+		ShardConsensusGroupSize:  1,
+		StartTime:                time.Now().Unix(),
+		RoundsPerEpoch:           100,
+		ExtraGasLimitGuardedTx:   50000,
+	}
+}
+
+// NewChainSimulator creates an in-memory simulated network implementing the proxy interfaces used across the SDK.
+// It keeps the accounts with their nonces, EGLD and ESDT balances, validates the sent transactions as a node would
+// and executes the move balance and ESDT transfer transactions when producing blocks. The genesis block is produced
+// on construction. Close should be called when the blocks are produced at a cadence
+func NewChainSimulator(args ArgsChainSimulator) (*chainSimulator, error) {
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfigs
+	}
+	if args.BlockInterval < 0 {
+		return nil, fmt.Errorf("%w for BlockInterval", ErrInvalidValue)
+	}
+
+	shardCoordinator, err := blockchain.NewShardCoordinator(args.NetworkConfig.NumShardsWithoutMeta, 0)
+	if err != nil {
+		return nil, err
+	}
+	hashComputer, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	if err != nil {
+		return nil, err
+	}
+
+	sim := &chainSimulator{
+		networkConfig:      *args.NetworkConfig,
+		ratingsConfig:      args.RatingsConfig,
+		enableEpochsConfig: args.EnableEpochsConfig,
+		verifySignatures:   args.VerifySignatures,
+		shardCoordinator:   shardCoordinator,
+		txHashComputer:     hashComputer,
+		accounts:           make(map[string]*accountState),
+		pool:               make(map[string]*pendingTransaction),
+		transactions:       make(map[string]*data.TransactionOnNetwork),
+		blocks:             newBlocksStorage(),
+		vmQueryHandlers:    make(map[string]VMQueryHandler),
+		cancel:             func() {},
+	}
+	if sim.ratingsConfig == nil {
+		sim.ratingsConfig = &data.RatingsConfig{}
+	}
+	if sim.enableEpochsConfig == nil {
+		sim.enableEpochsConfig = &data.EnableEpochsConfig{}
+	}
+
+	err = sim.produceBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if args.BlockInterval > 0 {
+		var ctx context.Context
+		ctx, sim.cancel = context.WithCancel(context.Background())
+		go sim.produceBlocksLoop(ctx, args.BlockInterval)
+	}
+
+	return sim, nil
+}
+
+func (sim *chainSimulator) produceBlocksLoop(ctx context.Context, interval time.Duration) {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("finishing chainSimulator.produceBlocksLoop...")
+			return
+		case <-timer.C:
+			err := sim.GenerateBlocks(1)
+			log.LogIfError(err)
+			timer.Reset(interval)
+		}
+	}
+}
+
+// GenerateBlocks produces the provided number of blocks, executing the pending transactions in the first one
+func (sim *chainSimulator) GenerateBlocks(numBlocks int) error {
+	for i := 0; i < numBlocks; i++ {
+		err := sim.produceBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetBalance sets the EGLD balance of an address
+func (sim *chainSimulator) SetBalance(address sdkCore.AddressHandler, balance *big.Int) error {
+	if check.IfNil(address) {
+		return ErrNilAddress
+	}
+	if balance == nil || balance.Sign() < 0 {
+		return fmt.Errorf("%w for balance", ErrInvalidValue)
+	}
+
+	sim.mut.Lock()
+	sim.getOrCreateAccount(address.AddressAsBech32String()).balance = big.NewInt(0).Set(balance)
+	sim.mut.Unlock()
+
+	return nil
+}
+
+// SetESDTBalance sets the balance an address holds of a token, the nonce being 0 for the fungible tokens
+func (sim *chainSimulator) SetESDTBalance(address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, balance *big.Int) error {
+	if check.IfNil(address) {
+		return ErrNilAddress
+	}
+	if len(tokenIdentifier) == 0 {
+		return fmt.Errorf("%w for tokenIdentifier", ErrInvalidValue)
+	}
+	if balance == nil || balance.Sign() < 0 {
+		return fmt.Errorf("%w for balance", ErrInvalidValue)
+	}
+
+	sim.mut.Lock()
+	account := sim.getOrCreateAccount(address.AddressAsBech32String())
+	account.tokens[tokenKey{identifier: tokenIdentifier, nonce: nonce}] = big.NewInt(0).Set(balance)
+	sim.mut.Unlock()
+
+	return nil
+}
+
+// SetVMQueryHandler registers the handler answering the VM queries done on the provided smart contract address
+func (sim *chainSimulator) SetVMQueryHandler(scAddress sdkCore.AddressHandler, handler VMQueryHandler) error {
+	if check.IfNil(scAddress) {
+		return ErrNilAddress
+	}
+	if handler == nil {
+		return fmt.Errorf("%w for handler", ErrInvalidValue)
+	}
+
+	sim.mut.Lock()
+	sim.vmQueryHandlers[scAddress.AddressAsBech32String()] = handler
+	sim.mut.Unlock()
+
+	return nil
+}
+
+func (sim *chainSimulator) getOrCreateAccount(bech32Address string) *accountState {
+	account, found := sim.accounts[bech32Address]
+	if !found {
+		account = &accountState{
+			balance: big.NewInt(0),
+			tokens:  make(map[tokenKey]*big.Int),
+		}
+		sim.accounts[bech32Address] = account
+	}
+
+	return account
+}
+
+// GetNetworkConfig returns the network configuration of the simulated network
+func (sim *chainSimulator) GetNetworkConfig(_ context.Context) (*data.NetworkConfig, error) {
+	networkConfig := sim.networkConfig
+
+	return &networkConfig, nil
+}
+
+// GetNetworkStatus returns the status of a simulated shard
+func (sim *chainSimulator) GetNetworkStatus(_ context.Context, shardID uint32) (*data.NetworkStatus, error) {
+	err := sim.checkShardID(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	latest := sim.blocks.latest()
+	epochStart := sim.blocks.epochStarts[latest.Epoch]
+
+	return &data.NetworkStatus{
+		CurrentRound:               latest.Round,
+		EpochNumber:                latest.Epoch,
+		Nonce:                      latest.Nonce,
+		NonceAtEpochStart:          epochStart,
+		NoncesPassedInCurrentEpoch: latest.Nonce - epochStart,
+		RoundAtEpochStart:          epochStart,
+		RoundsPassedInCurrentEpoch: latest.Round - epochStart,
+		RoundsPerEpoch:             uint64(sim.networkConfig.RoundsPerEpoch),
+		CrossCheckBlockHeight:      fmt.Sprintf("meta %d", latest.Nonce),
+		HighestNonce:               latest.Nonce,
+		ProbableHighestNonce:       latest.Nonce,
+		ShardID:                    shardID,
+	}, nil
+}
+
+// GetShardOfAddress returns the shard of the provided address
+func (sim *chainSimulator) GetShardOfAddress(_ context.Context, bech32Address string) (uint32, error) {
+	address, err := data.NewAddressFromBech32String(bech32Address)
+	if err != nil {
+		return 0, err
+	}
+
+	return sim.shardCoordinator.ComputeShardId(address)
+}
+
+// GetRestAPIEntityType returns the simulated REST API entity type, a proxy
+func (sim *chainSimulator) GetRestAPIEntityType() sdkCore.RestAPIEntityType {
+	return sdkCore.Proxy
+}
+
+// GetAccount returns the nonce and the balance of an address. Unknown addresses are returned with 0 nonce and balance
+func (sim *chainSimulator) GetAccount(_ context.Context, address sdkCore.AddressHandler) (*data.Account, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+	if !address.IsValid() {
+		return nil, ErrInvalidAddress
+	}
+
+	bech32Address := address.AddressAsBech32String()
+
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	account := &data.Account{
+		Address: bech32Address,
+		Balance: "0",
+	}
+	accState, found := sim.accounts[bech32Address]
+	if found {
+		account.Nonce = accState.nonce
+		account.Balance = accState.balance.String()
+	}
+
+	return account, nil
+}
+
+// GetDefaultTransactionArguments returns the transaction arguments of a move balance sent by the address
+func (sim *chainSimulator) GetDefaultTransactionArguments(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	networkConfigs *data.NetworkConfig,
+) (transaction.FrontendTransaction, string, error) {
+	if networkConfigs == nil {
+		return transaction.FrontendTransaction{}, "", ErrNilNetworkConfigs
+	}
+	if check.IfNil(address) {
+		return transaction.FrontendTransaction{}, "", ErrNilAddress
+	}
+
+	account, err := sim.GetAccount(ctx, address)
+	if err != nil {
+		return transaction.FrontendTransaction{}, "", err
+	}
+
+	return transaction.FrontendTransaction{
+		Nonce:    account.Nonce,
+		Sender:   address.AddressAsBech32String(),
+		GasPrice: networkConfigs.MinGasPrice,
+		GasLimit: networkConfigs.MinGasLimit,
+		ChainID:  networkConfigs.ChainID,
+		Version:  networkConfigs.MinTransactionVersion,
+	}, account.Balance, nil
+}
+
+// GetESDTTokenData returns the balance of a fungible token held by the address
+func (sim *chainSimulator) GetESDTTokenData(_ context.Context, address sdkCore.AddressHandler, tokenIdentifier string, _ api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error) {
+	balance, err := sim.getTokenBalance(address, tokenKey{identifier: tokenIdentifier})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ESDTFungibleTokenData{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         balance.String(),
+	}, nil
+}
+
+// GetNFTTokenData returns the balance of a non-fungible, semi-fungible or meta ESDT token held by the address
+func (sim *chainSimulator) GetNFTTokenData(_ context.Context, address sdkCore.AddressHandler, tokenIdentifier string, nonce uint64, _ api.AccountQueryOptions) (*data.ESDTNFTTokenData, error) {
+	balance, err := sim.getTokenBalance(address, tokenKey{identifier: tokenIdentifier, nonce: nonce})
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ESDTNFTTokenData{
+		TokenIdentifier: fmt.Sprintf("%s-%s", tokenIdentifier, nonceToHex(nonce)),
+		Balance:         balance.String(),
+		Nonce:           nonce,
+	}, nil
+}
+
+func (sim *chainSimulator) getTokenBalance(address sdkCore.AddressHandler, key tokenKey) (*big.Int, error) {
+	if check.IfNil(address) {
+		return nil, ErrNilAddress
+	}
+
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	account, found := sim.accounts[address.AddressAsBech32String()]
+	if !found {
+		return big.NewInt(0), nil
+	}
+	balance, found := account.tokens[key]
+	if !found {
+		return big.NewInt(0), nil
+	}
+
+	return big.NewInt(0).Set(balance), nil
+}
+
+// ExecuteVMQuery answers the VM query with the handler registered for the smart contract address
+func (sim *chainSimulator) ExecuteVMQuery(_ context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+	if vmRequest == nil {
+		return nil, fmt.Errorf("%w for vmRequest", ErrInvalidValue)
+	}
+
+	sim.mut.RLock()
+	handler, found := sim.vmQueryHandlers[vmRequest.Address]
+	sim.mut.RUnlock()
+	if !found {
+		return &data.VmValuesResponseData{
+			Data: &vm.VMOutputApi{
+				ReturnCode:    vmUserError,
+				ReturnMessage: "invalid contract code (not found)",
+			},
+		}, nil
+	}
+
+	output, err := handler(vmRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.VmValuesResponseData{Data: output}, nil
+}
+
+// GetTransactionStatus returns the status of a sent transaction
+func (sim *chainSimulator) GetTransactionStatus(_ context.Context, hash string) (string, error) {
+	tx, err := sim.getTransaction(hash)
+	if err != nil {
+		return "", err
+	}
+
+	return tx.Status, nil
+}
+
+// ProcessTransactionStatus returns the processed status of a sent transaction
+func (sim *chainSimulator) ProcessTransactionStatus(_ context.Context, hexTxHash string) (transaction.TxStatus, error) {
+	tx, err := sim.getTransaction(hexTxHash)
+	if err != nil {
+		return transaction.TxStatusFail, err
+	}
+
+	return transaction.TxStatus(tx.Status), nil
+}
+
+// GetTransactionInfo returns the details of a sent transaction
+func (sim *chainSimulator) GetTransactionInfo(_ context.Context, hash string) (*data.TransactionInfo, error) {
+	tx, err := sim.getTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &data.TransactionInfo{}
+	info.Data.Transaction = *tx
+
+	return info, nil
+}
+
+// GetTransactionInfoWithResults returns the details of a sent transaction
+func (sim *chainSimulator) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	return sim.GetTransactionInfo(ctx, hash)
+}
+
+func (sim *chainSimulator) getTransaction(hash string) (*data.TransactionOnNetwork, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	tx, found := sim.transactions[hash]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionNotFound, hash)
+	}
+	txCopy := *tx
+
+	return &txCopy, nil
+}
+
+// GetLatestHyperBlockNonce returns the nonce of the latest produced hyper block
+func (sim *chainSimulator) GetLatestHyperBlockNonce(_ context.Context) (uint64, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	return sim.blocks.latest().Nonce, nil
+}
+
+// GetHyperBlockByNonce returns the hyper block with the provided nonce
+func (sim *chainSimulator) GetHyperBlockByNonce(_ context.Context, nonce uint64) (*data.HyperBlock, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	if nonce >= uint64(len(sim.blocks.hyperBlocks)) {
+		return nil, fmt.Errorf("%w for nonce %d", ErrBlockNotFound, nonce)
+	}
+
+	return copyHyperBlock(sim.blocks.hyperBlocks[nonce]), nil
+}
+
+// GetHyperBlockByHash returns the hyper block with the provided hash
+func (sim *chainSimulator) GetHyperBlockByHash(_ context.Context, hash string) (*data.HyperBlock, error) {
+	sim.mut.RLock()
+	defer sim.mut.RUnlock()
+
+	for _, hyperBlock := range sim.blocks.hyperBlocks {
+		if hyperBlock.Hash == hash {
+			return copyHyperBlock(hyperBlock), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for hash %s", ErrBlockNotFound, hash)
+}
+
+// GetRatingsConfig returns the ratings configuration provided on construction
+func (sim *chainSimulator) GetRatingsConfig(_ context.Context) (*data.RatingsConfig, error) {
+	return sim.ratingsConfig, nil
+}
+
+// GetEnableEpochsConfig returns the enable epochs configuration provided on construction
+func (sim *chainSimulator) GetEnableEpochsConfig(_ context.Context) (*data.EnableEpochsConfig, error) {
+	return sim.enableEpochsConfig, nil
+}
+
+// GetGenesisNodesPubKeys returns an empty nodes setup, the simulated network having no validators
+func (sim *chainSimulator) GetGenesisNodesPubKeys(_ context.Context) (*data.GenesisNodes, error) {
+	return &data.GenesisNodes{
+		Eligible: make(map[uint32][]string),
+		Waiting:  make(map[uint32][]string),
+	}, nil
+}
+
+// GetValidatorsInfoByEpoch returns an empty validators list, the simulated network having no validators
+func (sim *chainSimulator) GetValidatorsInfoByEpoch(_ context.Context, _ uint32) ([]*state.ShardValidatorInfo, error) {
+	return make([]*state.ShardValidatorInfo, 0), nil
+}
+
+// Close stops the blocks production
+func (sim *chainSimulator) Close() error {
+	sim.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sim *chainSimulator) IsInterfaceNil() bool {
+	return sim == nil
+}
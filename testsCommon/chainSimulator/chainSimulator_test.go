@@ -0,0 +1,494 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/multiversx/mx-sdk-go/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ blockchain.Proxy         = (*chainSimulator)(nil)
+	_ interactors.Proxy        = (*chainSimulator)(nil)
+	_ interactors.AwaiterProxy = (*chainSimulator)(nil)
+	_ workflows.ProxyHandler   = (*chainSimulator)(nil)
+)
+
+var oneEGLD = big.NewInt(1000000000000000000)
+
+func createSimulator(t *testing.T) *chainSimulator {
+	args := ArgsChainSimulator{
+		NetworkConfig:    DefaultNetworkConfig(),
+		VerifySignatures: true,
+	}
+	args.NetworkConfig.RoundsPerEpoch = 5
+	sim, err := NewChainSimulator(args)
+	require.Nil(t, err)
+
+	return sim
+}
+
+func createAccount(t *testing.T) sdkCore.CryptoComponentsHolder {
+	sk, _ := keyGenerator.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGenerator, skBytes)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func createSignedTx(t *testing.T, sim *chainSimulator, sender sdkCore.CryptoComponentsHolder, receiver string, value *big.Int, txData []byte) *transaction.FrontendTransaction {
+	networkConfig, _ := sim.GetNetworkConfig(context.Background())
+	tx, _, err := sim.GetDefaultTransactionArguments(context.Background(), sender.GetAddressHandler(), networkConfig)
+	require.Nil(t, err)
+
+	tx.Receiver = receiver
+	tx.Value = value.String()
+	tx.Data = txData
+	tx.GasLimit = networkConfig.MinGasLimit + networkConfig.GasPerDataByte*uint64(len(txData))
+	signTx(t, sender, &tx)
+
+	return &tx
+}
+
+func signTx(t *testing.T, sender sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) {
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	err := txBuilder.ApplySignature(sender, tx)
+	require.Nil(t, err)
+}
+
+func TestNewChainSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := NewChainSimulator(ArgsChainSimulator{})
+		assert.True(t, check.IfNil(sim))
+		assert.Equal(t, ErrNilNetworkConfigs, err)
+	})
+	t.Run("negative block interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := NewChainSimulator(ArgsChainSimulator{
+			NetworkConfig: DefaultNetworkConfig(),
+			BlockInterval: -time.Second,
+		})
+		assert.True(t, check.IfNil(sim))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work and produce the genesis block", func(t *testing.T) {
+		t.Parallel()
+
+		sim, err := NewChainSimulator(ArgsChainSimulator{NetworkConfig: DefaultNetworkConfig()})
+		assert.False(t, check.IfNil(sim))
+		assert.Nil(t, err)
+
+		nonce, err := sim.GetLatestHyperBlockNonce(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(0), nonce)
+	})
+	t.Run("should produce blocks at the configured cadence", func(t *testing.T) {
+		t.Parallel()
+
+		sim, _ := NewChainSimulator(ArgsChainSimulator{
+			NetworkConfig: DefaultNetworkConfig(),
+			BlockInterval: time.Millisecond * 10,
+		})
+		defer func() {
+			_ = sim.Close()
+		}()
+
+		require.Eventually(t, func() bool {
+			nonce, _ := sim.GetLatestHyperBlockNonce(context.Background())
+			return nonce >= 3
+		}, time.Second, time.Millisecond*5)
+	})
+}
+
+func TestChainSimulator_SendTransactionValidation(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	sender := createAccount(t)
+	receiver := createAccount(t).GetBech32()
+	require.Nil(t, sim.SetBalance(sender.GetAddressHandler(), oneEGLD))
+
+	testCases := []struct {
+		name        string
+		alter       func(tx *transaction.FrontendTransaction)
+		expectedErr error
+	}{
+		{
+			name:        "invalid receiver",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.Receiver = "erd1invalid" },
+			expectedErr: ErrInvalidAddress,
+		},
+		{
+			name:        "invalid chain ID",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.ChainID = "T" },
+			expectedErr: ErrInvalidChainID,
+		},
+		{
+			name:        "invalid version",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.Version = 0 },
+			expectedErr: ErrInvalidTransactionVersion,
+		},
+		{
+			name:        "insufficient gas price",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.GasPrice = 1 },
+			expectedErr: ErrInsufficientGasPrice,
+		},
+		{
+			name:        "insufficient gas limit",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.Data = []byte("note") },
+			expectedErr: ErrInsufficientGasLimit,
+		},
+		{
+			name:        "invalid value",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.Value = "-1" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "insufficient funds",
+			alter:       func(tx *transaction.FrontendTransaction) { tx.Value = oneEGLD.String() },
+			expectedErr: ErrInsufficientFunds,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tx := createSignedTx(t, sim, sender, receiver, big.NewInt(1), nil)
+		testCase.alter(tx)
+		signTx(t, sender, tx)
+
+		hash, err := sim.SendTransaction(context.Background(), tx)
+		assert.Empty(t, hash, testCase.name)
+		assert.True(t, errors.Is(err, testCase.expectedErr), fmt.Sprintf("%s: %v", testCase.name, err))
+	}
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		hash, err := sim.SendTransaction(context.Background(), nil)
+		assert.Empty(t, hash)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("missing signature should error", func(t *testing.T) {
+		tx := createSignedTx(t, sim, sender, receiver, big.NewInt(1), nil)
+		tx.Signature = ""
+
+		_, err := sim.SendTransaction(context.Background(), tx)
+		assert.Equal(t, ErrMissingSignature, err)
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		tx := createSignedTx(t, sim, sender, receiver, big.NewInt(1), nil)
+		tx.Value = "2"
+
+		_, err := sim.SendTransaction(context.Background(), tx)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("lower nonce should error", func(t *testing.T) {
+		tx := createSignedTx(t, sim, sender, receiver, big.NewInt(1), nil)
+		_, err := sim.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Nil(t, sim.GenerateBlocks(1))
+
+		_, err = sim.SendTransaction(context.Background(), tx)
+		assert.True(t, errors.Is(err, ErrLowerNonceInTransaction))
+	})
+}
+
+func TestChainSimulator_MoveBalance(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	sender := createAccount(t)
+	receiver := createAccount(t)
+	require.Nil(t, sim.SetBalance(sender.GetAddressHandler(), oneEGLD))
+
+	value := big.NewInt(1000)
+	tx := createSignedTx(t, sim, sender, receiver.GetBech32(), value, []byte("note"))
+	hash, err := sim.SendTransaction(context.Background(), tx)
+	require.Nil(t, err)
+
+	sameHash, err := sim.SendTransaction(context.Background(), tx)
+	require.Nil(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	status, err := sim.ProcessTransactionStatus(context.Background(), hash)
+	require.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusPending, status)
+
+	require.Nil(t, sim.GenerateBlocks(1))
+
+	status, err = sim.ProcessTransactionStatus(context.Background(), hash)
+	require.Nil(t, err)
+	assert.Equal(t, transaction.TxStatusSuccess, status)
+
+	senderAccount, _ := sim.GetAccount(context.Background(), sender.GetAddressHandler())
+	fee := big.NewInt(0).SetUint64((tx.GasLimit) * tx.GasPrice)
+	expectedBalance := big.NewInt(0).Sub(oneEGLD, fee)
+	expectedBalance.Sub(expectedBalance, value)
+	assert.Equal(t, uint64(1), senderAccount.Nonce)
+	assert.Equal(t, expectedBalance.String(), senderAccount.Balance)
+
+	receiverAccount, _ := sim.GetAccount(context.Background(), receiver.GetAddressHandler())
+	assert.Equal(t, value.String(), receiverAccount.Balance)
+
+	info, err := sim.GetTransactionInfoWithResults(context.Background(), hash)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), info.Data.Transaction.HyperBlockNonce)
+	assert.Equal(t, miniblockTypeTxBlock, info.Data.Transaction.MiniblockType)
+	assert.NotEmpty(t, info.Data.Transaction.MiniblockHash)
+
+	hyperBlock, err := sim.GetHyperBlockByNonce(context.Background(), 1)
+	require.Nil(t, err)
+	require.Len(t, hyperBlock.Transactions, 1)
+	assert.Equal(t, hash, hyperBlock.Transactions[0].Hash)
+	assert.Len(t, hyperBlock.ShardBlocks, 3)
+
+	hyperBlockByHash, err := sim.GetHyperBlockByHash(context.Background(), hyperBlock.Hash)
+	require.Nil(t, err)
+	assert.Equal(t, hyperBlock, hyperBlockByHash)
+
+	_, err = sim.GetHyperBlockByNonce(context.Background(), 2)
+	assert.True(t, errors.Is(err, ErrBlockNotFound))
+}
+
+func TestChainSimulator_NonceOrdering(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	sender := createAccount(t)
+	receiver := createAccount(t).GetBech32()
+	require.Nil(t, sim.SetBalance(sender.GetAddressHandler(), oneEGLD))
+
+	first := createSignedTx(t, sim, sender, receiver, big.NewInt(1), nil)
+	second := createSignedTx(t, sim, sender, receiver, big.NewInt(2), nil)
+	second.Nonce = 1
+	signTx(t, sender, second)
+	gapped := createSignedTx(t, sim, sender, receiver, big.NewInt(3), nil)
+	gapped.Nonce = 3
+	signTx(t, sender, gapped)
+
+	hashes, err := sim.SendTransactions(context.Background(), []*transaction.FrontendTransaction{gapped, second, first, nil})
+	require.Nil(t, err)
+	require.Len(t, hashes, 3)
+
+	require.Nil(t, sim.GenerateBlocks(1))
+
+	account, _ := sim.GetAccount(context.Background(), sender.GetAddressHandler())
+	assert.Equal(t, uint64(2), account.Nonce)
+
+	status, _ := sim.GetTransactionStatus(context.Background(), hashes[0])
+	assert.Equal(t, string(transaction.TxStatusPending), status, "the transaction with a nonce gap should wait")
+
+	t.Run("no transaction accepted should error", func(t *testing.T) {
+		hashes, err = sim.SendTransactions(context.Background(), []*transaction.FrontendTransaction{first})
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrLowerNonceInTransaction))
+	})
+}
+
+func TestChainSimulator_ESDTTransfers(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	sender := createAccount(t)
+	receiver := createAccount(t)
+	require.Nil(t, sim.SetBalance(sender.GetAddressHandler(), oneEGLD))
+	require.Nil(t, sim.SetESDTBalance(sender.GetAddressHandler(), "TKN-abcdef", 0, big.NewInt(100)))
+	require.Nil(t, sim.SetESDTBalance(sender.GetAddressHandler(), "NFT-abcdef", 7, big.NewInt(1)))
+
+	t.Run("ESDTTransfer should work", func(t *testing.T) {
+		txData := []byte(fmt.Sprintf("%s@%s@%s", core.BuiltInFunctionESDTTransfer, hex.EncodeToString([]byte("TKN-abcdef")), "28"))
+		tx := createSignedTx(t, sim, sender, receiver.GetBech32(), big.NewInt(0), txData)
+		tx.GasLimit = 500000
+		signTx(t, sender, tx)
+
+		hash, err := sim.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Nil(t, sim.GenerateBlocks(1))
+
+		status, _ := sim.ProcessTransactionStatus(context.Background(), hash)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+
+		tokenData, _ := sim.GetESDTTokenData(context.Background(), receiver.GetAddressHandler(), "TKN-abcdef", api.AccountQueryOptions{})
+		assert.Equal(t, "40", tokenData.Balance)
+		tokenData, _ = sim.GetESDTTokenData(context.Background(), sender.GetAddressHandler(), "TKN-abcdef", api.AccountQueryOptions{})
+		assert.Equal(t, "60", tokenData.Balance)
+
+		account, _ := sim.GetAccount(context.Background(), sender.GetAddressHandler())
+		expectedBalance := big.NewInt(0).Sub(oneEGLD, big.NewInt(0).SetUint64(tx.GasLimit*tx.GasPrice))
+		assert.Equal(t, expectedBalance.String(), account.Balance, "built in function calls consume the whole gas limit")
+	})
+	t.Run("ESDTNFTTransfer should work", func(t *testing.T) {
+		txData := []byte(fmt.Sprintf("%s@%s@07@01@%s", core.BuiltInFunctionESDTNFTTransfer,
+			hex.EncodeToString([]byte("NFT-abcdef")), hex.EncodeToString(receiver.GetAddressHandler().AddressBytes())))
+		tx := createSignedTx(t, sim, sender, sender.GetBech32(), big.NewInt(0), txData)
+		tx.GasLimit = 1000000
+		signTx(t, sender, tx)
+
+		hash, err := sim.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Nil(t, sim.GenerateBlocks(1))
+
+		status, _ := sim.ProcessTransactionStatus(context.Background(), hash)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+
+		tokenData, _ := sim.GetNFTTokenData(context.Background(), receiver.GetAddressHandler(), "NFT-abcdef", 7, api.AccountQueryOptions{})
+		assert.Equal(t, "1", tokenData.Balance)
+		assert.Equal(t, "NFT-abcdef-07", tokenData.TokenIdentifier)
+	})
+	t.Run("MultiESDTNFTTransfer should work", func(t *testing.T) {
+		txData := []byte(fmt.Sprintf("%s@%s@01@%s@@0a", core.BuiltInFunctionMultiESDTNFTTransfer,
+			hex.EncodeToString(receiver.GetAddressHandler().AddressBytes()), hex.EncodeToString([]byte("TKN-abcdef"))))
+		tx := createSignedTx(t, sim, sender, sender.GetBech32(), big.NewInt(0), txData)
+		tx.GasLimit = 1000000
+		signTx(t, sender, tx)
+
+		hash, err := sim.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Nil(t, sim.GenerateBlocks(1))
+
+		status, _ := sim.ProcessTransactionStatus(context.Background(), hash)
+		assert.Equal(t, transaction.TxStatusSuccess, status)
+
+		tokenData, _ := sim.GetESDTTokenData(context.Background(), receiver.GetAddressHandler(), "TKN-abcdef", api.AccountQueryOptions{})
+		assert.Equal(t, "50", tokenData.Balance)
+	})
+	t.Run("MultiESDTNFTTransfer with an overflowing number of transfers should fail", func(t *testing.T) {
+		numTransfers := []string{
+			"5555555555555556",
+			"ffffffffffffffff",
+			"010000000000000000",
+		}
+		for _, hexNumTransfers := range numTransfers {
+			txData := []byte(fmt.Sprintf("%s@%s@%s@%s@@0a", core.BuiltInFunctionMultiESDTNFTTransfer,
+				hex.EncodeToString(receiver.GetAddressHandler().AddressBytes()), hexNumTransfers, hex.EncodeToString([]byte("TKN-abcdef"))))
+			tx := createSignedTx(t, sim, sender, sender.GetBech32(), big.NewInt(0), txData)
+			tx.GasLimit = 1000000
+			signTx(t, sender, tx)
+
+			hash, err := sim.SendTransaction(context.Background(), tx)
+			require.Nil(t, err)
+			require.Nil(t, sim.GenerateBlocks(1))
+
+			status, _ := sim.ProcessTransactionStatus(context.Background(), hash)
+			assert.Equal(t, transaction.TxStatusFail, status, hexNumTransfers)
+		}
+
+		tokenData, _ := sim.GetESDTTokenData(context.Background(), receiver.GetAddressHandler(), "TKN-abcdef", api.AccountQueryOptions{})
+		assert.Equal(t, "50", tokenData.Balance)
+	})
+	t.Run("not enough tokens should fail", func(t *testing.T) {
+		txData := []byte(fmt.Sprintf("%s@%s@%s", core.BuiltInFunctionESDTTransfer, hex.EncodeToString([]byte("TKN-abcdef")), "ff"))
+		tx := createSignedTx(t, sim, sender, receiver.GetBech32(), big.NewInt(0), txData)
+		tx.GasLimit = 500000
+		signTx(t, sender, tx)
+
+		hash, err := sim.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		require.Nil(t, sim.GenerateBlocks(1))
+
+		status, _ := sim.ProcessTransactionStatus(context.Background(), hash)
+		assert.Equal(t, transaction.TxStatusFail, status)
+
+		account, _ := sim.GetAccount(context.Background(), sender.GetAddressHandler())
+		assert.Equal(t, tx.Nonce+1, account.Nonce, "a failed transaction should consume the nonce")
+	})
+}
+
+func TestChainSimulator_ExecuteVMQuery(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	contract := createAccount(t).GetAddressHandler()
+
+	response, err := sim.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: contract.AddressAsBech32String()})
+	require.Nil(t, err)
+	assert.Equal(t, vmUserError, response.Data.ReturnCode)
+
+	err = sim.SetVMQueryHandler(contract, func(request *data.VmValueRequest) (*vm.VMOutputApi, error) {
+		return &vm.VMOutputApi{
+			ReturnCode: "ok",
+			ReturnData: [][]byte{[]byte(request.FuncName)},
+		}, nil
+	})
+	require.Nil(t, err)
+
+	response, err = sim.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: contract.AddressAsBech32String(), FuncName: "getSum"})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("getSum")}, response.Data.ReturnData)
+}
+
+func TestChainSimulator_NetworkStatusAndRawBlocks(t *testing.T) {
+	t.Parallel()
+
+	sim := createSimulator(t)
+	require.Nil(t, sim.GenerateBlocks(7))
+
+	status, err := sim.GetNetworkStatus(context.Background(), core.MetachainShardId)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), status.Nonce)
+	assert.Equal(t, uint64(1), status.EpochNumber)
+	assert.Equal(t, uint64(5), status.NonceAtEpochStart)
+
+	_, err = sim.GetNetworkStatus(context.Background(), 3)
+	assert.True(t, errors.Is(err, ErrInvalidShardID))
+
+	nonceAtEpochStart, err := sim.GetNonceAtEpochStart(context.Background(), 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), nonceAtEpochStart)
+
+	hyperBlock, _ := sim.GetHyperBlockByNonce(context.Background(), 6)
+	rawMetaBlock, err := sim.GetRawBlockByHash(context.Background(), core.MetachainShardId, hyperBlock.Hash)
+	require.Nil(t, err)
+	metaBlock := &block.MetaBlock{}
+	require.Nil(t, blocksMarshaller.Unmarshal(metaBlock, rawMetaBlock))
+	assert.Equal(t, uint64(6), metaBlock.GetNonce())
+	assert.Equal(t, uint32(1), metaBlock.GetEpoch())
+	assert.Len(t, metaBlock.ShardInfo, 3)
+
+	rawShardBlock, err := sim.GetRawBlockByHash(context.Background(), 1, hyperBlock.ShardBlocks[1].Hash)
+	require.Nil(t, err)
+	shardBlock := &block.Header{}
+	require.Nil(t, blocksMarshaller.Unmarshal(shardBlock, rawShardBlock))
+	assert.Equal(t, uint32(1), shardBlock.GetShardID())
+	assert.Equal(t, metaBlock.ShardInfo[1].HeaderHash, mustDecodeHex(t, hyperBlock.ShardBlocks[1].Hash))
+
+	rawEpochStart, err := sim.GetRawStartOfEpochMetaBlock(context.Background(), 1)
+	require.Nil(t, err)
+	epochStartMetaBlock := &block.MetaBlock{}
+	require.Nil(t, blocksMarshaller.Unmarshal(epochStartMetaBlock, rawEpochStart))
+	assert.Equal(t, uint64(5), epochStartMetaBlock.GetNonce())
+	assert.True(t, epochStartMetaBlock.IsStartOfEpochBlock())
+
+	_, err = sim.GetRawStartOfEpochMetaBlock(context.Background(), 2)
+	assert.True(t, errors.Is(err, ErrBlockNotFound))
+}
+
+func mustDecodeHex(t *testing.T, hexString string) []byte {
+	buff, err := hex.DecodeString(hexString)
+	require.Nil(t, err)
+
+	return buff
+}
@@ -0,0 +1,51 @@
+package chainSimulator
+
+import "errors"
+
+// ErrNilAddress signals that a nil address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilNetworkConfigs signals that nil network configs were provided
+var ErrNilNetworkConfigs = errors.New("nil network configs")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidAddress signals that an invalid address was provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrInvalidChainID signals that the transaction has a chain ID different from the simulated network's one
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that the transaction version is lower than the minimum one
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInsufficientGasPrice signals that the transaction gas price is lower than the minimum one
+var ErrInsufficientGasPrice = errors.New("insufficient gas price in tx")
+
+// ErrInsufficientGasLimit signals that the transaction gas limit does not cover the data
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit in tx")
+
+// ErrLowerNonceInTransaction signals that the transaction nonce was already consumed
+var ErrLowerNonceInTransaction = errors.New("lowerNonceInTransaction")
+
+// ErrInsufficientFunds signals that the sender can not pay the value and the maximum fee of the transaction
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrMissingSignature signals that the transaction is not signed
+var ErrMissingSignature = errors.New("missing signature")
+
+// ErrInvalidSignature signals that the transaction signature could not be verified
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrTransactionNotFound signals that the requested transaction is unknown
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrBlockNotFound signals that the requested block is unknown
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrInvalidShardID signals that the provided shard ID does not exist in the simulated network
+var ErrInvalidShardID = errors.New("invalid shard ID")
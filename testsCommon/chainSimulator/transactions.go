@@ -0,0 +1,381 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	processingTypeMoveBalance     = "MoveBalance"
+	processingTypeBuiltInFunction = "BuiltInFunctionCall"
+	transactionTypeNormal         = "normal"
+
+	signedWithHashOption    = uint32(1)
+	guardedOption           = uint32(1 << 1)
+	minVersionForTxOptions  = uint32(2)
+	errMessageNotEnoughESDT = "insufficient funds"
+)
+
+var (
+	keyGenerator  = signing.NewKeyGenerator(ed25519.NewEd25519())
+	singleSigner  = &singlesig.Ed25519Signer{}
+	keccakHasher  = keccak.NewKeccak()
+	errBuiltInTxs = fmt.Errorf("%w: built in function called with tx value is not allowed", ErrInvalidValue)
+)
+
+// SendTransaction validates the transaction as a node would and adds it to the pool, returning its hash. The
+// transaction is executed when producing the first block in which its nonce is the sender's next nonce
+func (sim *chainSimulator) SendTransaction(_ context.Context, tx *transaction.FrontendTransaction) (string, error) {
+	sim.mut.Lock()
+	defer sim.mut.Unlock()
+
+	return sim.addToPool(tx)
+}
+
+// SendTransactions sends the transactions one by one, returning the hashes of the accepted ones. An error is
+// returned only if no transaction was accepted
+func (sim *chainSimulator) SendTransactions(_ context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+	sim.mut.Lock()
+	defer sim.mut.Unlock()
+
+	var lastErr error
+	hashes := make([]string, 0, len(txs))
+	for idx, tx := range txs {
+		hash, err := sim.addToPool(tx)
+		if err != nil {
+			log.Debug("chainSimulator.SendTransactions: transaction rejected", "index", idx, "error", err)
+			lastErr = err
+			continue
+		}
+
+		hashes = append(hashes, hash)
+	}
+	if len(hashes) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+
+	return hashes, nil
+}
+
+func (sim *chainSimulator) addToPool(tx *transaction.FrontendTransaction) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransaction
+	}
+
+	pending, err := sim.validateTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	txHash, err := sim.txHashComputer.ComputeTxHash(tx)
+	if err != nil {
+		return "", err
+	}
+	pending.hash = hex.EncodeToString(txHash)
+
+	_, alreadySent := sim.transactions[pending.hash]
+	if alreadySent {
+		return pending.hash, nil
+	}
+
+	sim.pool[pending.hash] = pending
+	sim.transactions[pending.hash] = &data.TransactionOnNetwork{
+		Type:                        transactionTypeNormal,
+		ProcessingTypeOnSource:      processingType(tx),
+		ProcessingTypeOnDestination: processingType(tx),
+		Hash:                        pending.hash,
+		Nonce:                       tx.Nonce,
+		Value:                       pending.value.String(),
+		Receiver:                    tx.Receiver,
+		Sender:                      tx.Sender,
+		GasPrice:                    tx.GasPrice,
+		GasLimit:                    tx.GasLimit,
+		Data:                        tx.Data,
+		Signature:                   tx.Signature,
+		SourceShard:                 pending.sourceShard,
+		DestinationShard:            pending.destinationShard,
+		Status:                      string(transaction.TxStatusPending),
+	}
+
+	return pending.hash, nil
+}
+
+func (sim *chainSimulator) validateTransaction(tx *transaction.FrontendTransaction) (*pendingTransaction, error) {
+	sender, err := data.NewAddressFromBech32String(tx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender: %s", ErrInvalidAddress, err.Error())
+	}
+	receiver, err := data.NewAddressFromBech32String(tx.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver: %s", ErrInvalidAddress, err.Error())
+	}
+	if tx.ChainID != sim.networkConfig.ChainID {
+		return nil, fmt.Errorf("%w, provided %s", ErrInvalidChainID, tx.ChainID)
+	}
+	if tx.Version < sim.networkConfig.MinTransactionVersion {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidTransactionVersion, tx.Version)
+	}
+	if tx.GasPrice < sim.networkConfig.MinGasPrice {
+		return nil, fmt.Errorf("%w, provided %d, minimum %d", ErrInsufficientGasPrice, tx.GasPrice, sim.networkConfig.MinGasPrice)
+	}
+	minGasLimit := sim.computeMoveBalanceGas(tx)
+	if tx.GasLimit < minGasLimit {
+		return nil, fmt.Errorf("%w, provided %d, minimum %d", ErrInsufficientGasLimit, tx.GasLimit, minGasLimit)
+	}
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w for value: %s", ErrInvalidValue, tx.Value)
+	}
+	if len(tx.Signature) == 0 {
+		return nil, ErrMissingSignature
+	}
+	if sim.verifySignatures {
+		err = verifySignatures(tx, sender.AddressBytes())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	account := sim.getOrCreateAccount(tx.Sender)
+	if tx.Nonce < account.nonce {
+		return nil, fmt.Errorf("%w, account nonce %d, provided %d", ErrLowerNonceInTransaction, account.nonce, tx.Nonce)
+	}
+	maxCost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit), big.NewInt(0).SetUint64(tx.GasPrice))
+	maxCost.Add(maxCost, value)
+	if account.balance.Cmp(maxCost) < 0 {
+		return nil, fmt.Errorf("%w, balance %s, required %s", ErrInsufficientFunds, account.balance.String(), maxCost.String())
+	}
+
+	sourceShard, err := sim.shardCoordinator.ComputeShardId(sender)
+	if err != nil {
+		return nil, err
+	}
+	destinationShard, err := sim.shardCoordinator.ComputeShardId(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pendingTransaction{
+		tx:               tx,
+		value:            value,
+		sourceShard:      sourceShard,
+		destinationShard: destinationShard,
+	}, nil
+}
+
+func (sim *chainSimulator) computeMoveBalanceGas(tx *transaction.FrontendTransaction) uint64 {
+	gas := sim.networkConfig.MinGasLimit + sim.networkConfig.GasPerDataByte*uint64(len(tx.Data))
+	if tx.Options&guardedOption > 0 {
+		gas += sim.networkConfig.ExtraGasLimitGuardedTx
+	}
+
+	return gas
+}
+
+func verifySignatures(tx *transaction.FrontendTransaction, senderPublicKey []byte) error {
+	unsignedTx := *tx
+	unsignedTx.Signature = ""
+	unsignedTx.GuardianSignature = ""
+	message, err := json.Marshal(&unsignedTx)
+	if err != nil {
+		return err
+	}
+	if tx.Version >= minVersionForTxOptions && tx.Options&signedWithHashOption > 0 {
+		message = keccakHasher.Compute(string(message))
+	}
+
+	err = verifySignature(senderPublicKey, message, tx.Signature)
+	if err != nil {
+		return err
+	}
+	if tx.Options&guardedOption == 0 {
+		return nil
+	}
+
+	guardian, err := data.NewAddressFromBech32String(tx.GuardianAddr)
+	if err != nil {
+		return fmt.Errorf("%w for guardian: %s", ErrInvalidAddress, err.Error())
+	}
+
+	return verifySignature(guardian.AddressBytes(), message, tx.GuardianSignature)
+}
+
+func verifySignature(publicKeyBytes []byte, message []byte, hexSignature string) error {
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+	publicKey, err := keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	err = singleSigner.Verify(publicKey, message, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
+
+// selectExecutableTransactions removes from the pool the transactions having consecutive nonces starting from each
+// sender's account nonce. When several transactions have the same nonce, the one with the highest gas price wins and
+// the other ones are dropped
+func (sim *chainSimulator) selectExecutableTransactions() []*pendingTransaction {
+	bySender := make(map[string][]*pendingTransaction)
+	for _, pending := range sim.pool {
+		bySender[pending.tx.Sender] = append(bySender[pending.tx.Sender], pending)
+	}
+
+	senders := make([]string, 0, len(bySender))
+	for sender := range bySender {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	selected := make([]*pendingTransaction, 0, len(sim.pool))
+	for _, sender := range senders {
+		txs := bySender[sender]
+		sort.Slice(txs, func(i, j int) bool {
+			if txs[i].tx.Nonce != txs[j].tx.Nonce {
+				return txs[i].tx.Nonce < txs[j].tx.Nonce
+			}
+			if txs[i].tx.GasPrice != txs[j].tx.GasPrice {
+				return txs[i].tx.GasPrice > txs[j].tx.GasPrice
+			}
+			return txs[i].hash < txs[j].hash
+		})
+
+		nextNonce := sim.getOrCreateAccount(sender).nonce
+		for _, pending := range txs {
+			switch {
+			case pending.tx.Nonce < nextNonce:
+				delete(sim.pool, pending.hash)
+				delete(sim.transactions, pending.hash)
+			case pending.tx.Nonce == nextNonce:
+				delete(sim.pool, pending.hash)
+				selected = append(selected, pending)
+				nextNonce++
+			}
+		}
+	}
+
+	return selected
+}
+
+// executeTransaction applies the transaction on the accounts and returns its status. Move balance transactions are
+// charged the gas used by their data, built in function calls are charged their whole gas limit
+func (sim *chainSimulator) executeTransaction(pending *pendingTransaction) transaction.TxStatus {
+	tx := pending.tx
+	sender := sim.getOrCreateAccount(tx.Sender)
+	sender.nonce++
+
+	transfer, errParse := builders.ParseTokenTransfer(tx.Sender, tx.Receiver, tx.Data)
+	isBuiltInFunction := transfer != nil || errParse != nil
+	gasUsed := sim.computeMoveBalanceGas(tx)
+	if isBuiltInFunction {
+		gasUsed = tx.GasLimit
+	}
+	fee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(gasUsed), big.NewInt(0).SetUint64(tx.GasPrice))
+
+	required := big.NewInt(0).Add(fee, pending.value)
+	if sender.balance.Cmp(required) < 0 {
+		sender.balance.Sub(sender.balance, minBigInt(fee, sender.balance))
+		return transaction.TxStatusInvalid
+	}
+	sender.balance.Sub(sender.balance, fee)
+
+	if !isBuiltInFunction {
+		sender.balance.Sub(sender.balance, pending.value)
+		receiver := sim.getOrCreateAccount(tx.Receiver)
+		receiver.balance.Add(receiver.balance, pending.value)
+
+		return transaction.TxStatusSuccess
+	}
+
+	if pending.value.Sign() > 0 {
+		log.Debug("chainSimulator: transaction failed", "hash", pending.hash, "error", errBuiltInTxs)
+		return transaction.TxStatusFail
+	}
+
+	err := errParse
+	if err == nil {
+		err = sim.executeESDTTransfer(tx, transfer)
+	}
+	if err != nil {
+		log.Debug("chainSimulator: transaction failed", "hash", pending.hash, "error", err)
+		return transaction.TxStatusFail
+	}
+
+	return transaction.TxStatusSuccess
+}
+
+// executeESDTTransfer moves the tokens of the transfer. The NFT transfers issued by users are sent to themselves, with
+// the destination as argument
+func (sim *chainSimulator) executeESDTTransfer(tx *transaction.FrontendTransaction, transfer *data.TokenTransfer) error {
+	if transfer.Function != core.BuiltInFunctionESDTTransfer && tx.Sender != tx.Receiver {
+		return fmt.Errorf("%w: invalid receiver for %s", ErrInvalidValue, transfer.Function)
+	}
+
+	sender := sim.getOrCreateAccount(tx.Sender)
+	for _, payment := range transfer.Payments {
+		key := tokenKey{identifier: payment.TokenIdentifier, nonce: payment.Nonce}
+		balance, found := sender.tokens[key]
+		if !found || balance.Cmp(payment.Amount) < 0 {
+			return fmt.Errorf("%w: %s for token %s", ErrInvalidValue, errMessageNotEnoughESDT, payment.TokenIdentifier)
+		}
+	}
+
+	receiver := sim.getOrCreateAccount(transfer.Destination)
+	for _, payment := range transfer.Payments {
+		key := tokenKey{identifier: payment.TokenIdentifier, nonce: payment.Nonce}
+		sender.tokens[key].Sub(sender.tokens[key], payment.Amount)
+		receiverBalance, found := receiver.tokens[key]
+		if !found {
+			receiverBalance = big.NewInt(0)
+			receiver.tokens[key] = receiverBalance
+		}
+		receiverBalance.Add(receiverBalance, payment.Amount)
+	}
+
+	return nil
+}
+
+func processingType(tx *transaction.FrontendTransaction) string {
+	transfer, err := builders.ParseTokenTransfer(tx.Sender, tx.Receiver, tx.Data)
+	if transfer != nil || err != nil {
+		return processingTypeBuiltInFunction
+	}
+
+	return processingTypeMoveBalance
+}
+
+func minBigInt(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return big.NewInt(0).Set(a)
+	}
+
+	return big.NewInt(0).Set(b)
+}
+
+func nonceToHex(nonce uint64) string {
+	encoded := fmt.Sprintf("%x", nonce)
+	if len(encoded)%2 != 0 {
+		encoded = "0" + encoded
+	}
+
+	return encoded
+}
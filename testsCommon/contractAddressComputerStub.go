@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-sdk-go/core"
+
+// ContractAddressComputerStub -
+type ContractAddressComputerStub struct {
+	ComputeArwenScAddressCalled func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+}
+
+// ComputeArwenScAddress -
+func (stub *ContractAddressComputerStub) ComputeArwenScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+	if stub.ComputeArwenScAddressCalled != nil {
+		return stub.ComputeArwenScAddressCalled(address, nonce)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ContractAddressComputerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package testsCommon
+
+import "github.com/multiversx/mx-sdk-go/data"
+
+// EventsHandlerStub -
+type EventsHandlerStub struct {
+	HandleEventsCalled         func(events []data.NotifierEvent) error
+	HandleRevertedBlockCalled  func(block *data.NotifierRevertedBlock) error
+	HandleFinalizedBlockCalled func(block *data.NotifierFinalizedBlock) error
+}
+
+// HandleEvents -
+func (stub *EventsHandlerStub) HandleEvents(events []data.NotifierEvent) error {
+	if stub.HandleEventsCalled != nil {
+		return stub.HandleEventsCalled(events)
+	}
+
+	return nil
+}
+
+// HandleRevertedBlock -
+func (stub *EventsHandlerStub) HandleRevertedBlock(block *data.NotifierRevertedBlock) error {
+	if stub.HandleRevertedBlockCalled != nil {
+		return stub.HandleRevertedBlockCalled(block)
+	}
+
+	return nil
+}
+
+// HandleFinalizedBlock -
+func (stub *EventsHandlerStub) HandleFinalizedBlock(block *data.NotifierFinalizedBlock) error {
+	if stub.HandleFinalizedBlockCalled != nil {
+		return stub.HandleFinalizedBlockCalled(block)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *EventsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// HyperBlockHandlerStub -
+type HyperBlockHandlerStub struct {
+	ProcessHyperBlockCalled  func(ctx context.Context, block *data.HyperBlock) error
+	ProcessTransactionCalled func(ctx context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error
+}
+
+// ProcessHyperBlock -
+func (stub *HyperBlockHandlerStub) ProcessHyperBlock(ctx context.Context, block *data.HyperBlock) error {
+	if stub.ProcessHyperBlockCalled != nil {
+		return stub.ProcessHyperBlockCalled(ctx, block)
+	}
+
+	return nil
+}
+
+// ProcessTransaction -
+func (stub *HyperBlockHandlerStub) ProcessTransaction(ctx context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error {
+	if stub.ProcessTransactionCalled != nil {
+		return stub.ProcessTransactionCalled(ctx, block, tx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *HyperBlockHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
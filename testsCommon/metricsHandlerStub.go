@@ -0,0 +1,36 @@
+package testsCommon
+
+import "time"
+
+// MetricsHandlerStub -
+type MetricsHandlerStub struct {
+	ObserveRequestCalled        func(method string, endpoint string, statusCode int, duration time.Duration, err error)
+	IncrementCacheAccessCalled  func(cacheName string, hit bool)
+	AddResentTransactionsCalled func(address string, numTransactions int)
+}
+
+// ObserveRequest -
+func (stub *MetricsHandlerStub) ObserveRequest(method string, endpoint string, statusCode int, duration time.Duration, err error) {
+	if stub.ObserveRequestCalled != nil {
+		stub.ObserveRequestCalled(method, endpoint, statusCode, duration, err)
+	}
+}
+
+// IncrementCacheAccess -
+func (stub *MetricsHandlerStub) IncrementCacheAccess(cacheName string, hit bool) {
+	if stub.IncrementCacheAccessCalled != nil {
+		stub.IncrementCacheAccessCalled(cacheName, hit)
+	}
+}
+
+// AddResentTransactions -
+func (stub *MetricsHandlerStub) AddResentTransactions(address string, numTransactions int) {
+	if stub.AddResentTransactionsCalled != nil {
+		stub.AddResentTransactionsCalled(address, numTransactions)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *MetricsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
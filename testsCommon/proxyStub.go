@@ -32,6 +32,10 @@ type ProxyStub struct {
 	GetHyperBlockByNonceCalled           func(ctx context.Context, nonce uint64) (*data.HyperBlock, error)
 	GetDefaultTransactionArgumentsCalled func(ctx context.Context, address sdkCore.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error)
 	GetValidatorsInfoByEpochCalled       func(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
+	ProcessTransactionStatusCalled       func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResultsCalled  func(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	RequestTransactionCostCalled         func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	SimulateTransactionCalled            func(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error)
 }
 
 // ExecuteVMQuery -
@@ -209,6 +213,42 @@ func (stub *ProxyStub) GetValidatorsInfoByEpoch(ctx context.Context, epoch uint3
 	return make([]*state.ShardValidatorInfo, 0), nil
 }
 
+// ProcessTransactionStatus -
+func (stub *ProxyStub) ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+	if stub.ProcessTransactionStatusCalled != nil {
+		return stub.ProcessTransactionStatusCalled(ctx, hexTxHash)
+	}
+
+	return transaction.TxStatusSuccess, nil
+}
+
+// GetTransactionInfoWithResults -
+func (stub *ProxyStub) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	if stub.GetTransactionInfoWithResultsCalled != nil {
+		return stub.GetTransactionInfoWithResultsCalled(ctx, hash)
+	}
+
+	return &data.TransactionInfo{}, nil
+}
+
+// RequestTransactionCost -
+func (stub *ProxyStub) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if stub.RequestTransactionCostCalled != nil {
+		return stub.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return &data.TxCostResponseData{}, nil
+}
+
+// SimulateTransaction -
+func (stub *ProxyStub) SimulateTransaction(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulationResults, error) {
+	if stub.SimulateTransactionCalled != nil {
+		return stub.SimulateTransactionCalled(ctx, tx, checkSignature)
+	}
+
+	return &data.TransactionSimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (stub *ProxyStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,9 @@
+package server
+
+import "errors"
+
+// ErrUnknownRoute signals that the fixture route is not one of the gateway routes
+var ErrUnknownRoute = errors.New("unknown route")
+
+// ErrInvalidFixture signals that an invalid fixture was provided
+var ErrInvalidFixture = errors.New("invalid fixture")
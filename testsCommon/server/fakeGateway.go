@@ -0,0 +1,382 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/testsCommon/server")
+
+const (
+	defaultListenAddress = "127.0.0.1:0"
+	codeSuccessful       = "successful"
+	codeNotFound         = "not_found"
+)
+
+// GatewayRoutes are the routes served by the fake gateway, mirroring the ones of the endpoint providers. A segment
+// between braces matches any value
+var GatewayRoutes = []string{
+	"network/config",
+	"network/economics",
+	"network/ratings",
+	"network/enable-epochs",
+	"network/genesis-nodes",
+	"network/status/{shard}",
+	"node/status",
+	"address/{address}",
+	"address/{address}/esdt",
+	"address/{address}/esdt/{token}",
+	"address/{address}/nft/{token}/nonce/{nonce}",
+	"address/{address}/key/{key}",
+	"address/{address}/keys",
+	"address/iterate-keys",
+	"address/{address}/esdts/roles",
+	"address/{address}/esdts-with-role/{role}",
+	"address/{address}/registered-nfts",
+	"transaction/cost",
+	"transaction/send",
+	"transaction/send-multiple",
+	"transaction/{hash}",
+	"transaction/{hash}/status",
+	"transaction/{hash}/process-status",
+	"hyperblock/by-nonce/{nonce}",
+	"hyperblock/by-hash/{hash}",
+	"vm-values/query",
+	"internal/raw/startofepoch/metablock/by-epoch/{epoch}",
+	"internal/json/startofepoch/validators/by-epoch/{epoch}",
+	"internal/{shard}/raw/block/by-hash/{hash}",
+	"internal/{shard}/raw/block/by-nonce/{nonce}",
+	"internal/{shard}/raw/miniblock/by-hash/{hash}/epoch/{epoch}",
+	"internal/raw/block/by-hash/{hash}",
+	"internal/raw/block/by-nonce/{nonce}",
+	"internal/raw/miniblock/by-hash/{hash}/epoch/{epoch}",
+}
+
+// Fixture defines the response served for the requests matching a method, a route and, optionally, a request body.
+// The same format is used for the fixture files and for the recorded sessions
+type Fixture struct {
+	Method string `json:"method"`
+	// Route is either a concrete path, like address/erd1..., or a gateway route, like address/{address}
+	Route string `json:"route"`
+	// RequestBody, when set, restricts the fixture to the requests having the same JSON body
+	RequestBody json.RawMessage `json:"requestBody,omitempty"`
+	StatusCode  int             `json:"statusCode"`
+	// Data is served wrapped in the gateway response envelope, {"data":...,"error":"","code":"successful"}
+	Data json.RawMessage `json:"data,omitempty"`
+	// Response is served as it is and takes precedence over Data
+	Response json.RawMessage `json:"response,omitempty"`
+	// Times is the number of times the fixture is served before falling through to the next matching one, 0 meaning
+	// that the fixture is always served
+	Times int `json:"times,omitempty"`
+}
+
+// RecordedRequest is a request received by the fake gateway
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+type fixtureState struct {
+	fixture     Fixture
+	segments    []string
+	requestBody []byte
+	numServed   int
+}
+
+// ArgsFakeGateway is the DTO used in the fake gateway constructor
+type ArgsFakeGateway struct {
+	// ListenAddress defaults to a random port on the loopback interface
+	ListenAddress string
+	Fixtures      []Fixture
+}
+
+type fakeGateway struct {
+	server   *http.Server
+	listener net.Listener
+
+	mut      sync.Mutex
+	fixtures []*fixtureState
+	requests []RecordedRequest
+}
+
+// NewFakeGateway creates and starts a local HTTP server answering the gateway routes with the scripted fixtures.
+// The requests without a matching fixture are answered with 404 and a gateway-like error
+func NewFakeGateway(args ArgsFakeGateway) (*fakeGateway, error) {
+	gateway := &fakeGateway{}
+	err := gateway.AddFixtures(args.Fixtures...)
+	if err != nil {
+		return nil, err
+	}
+
+	listenAddress := args.ListenAddress
+	if len(listenAddress) == 0 {
+		listenAddress = defaultListenAddress
+	}
+	gateway.listener, err = net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway.server = &http.Server{Handler: gateway}
+	go func() {
+		errServe := gateway.server.Serve(gateway.listener)
+		if errServe != nil && errServe != http.ErrServerClosed {
+			log.Error("fake gateway stopped", "error", errServe)
+		}
+	}()
+
+	return gateway, nil
+}
+
+// LoadFixturesFromFile reads a JSON array of fixtures, as written by hand or from a recorded session
+func LoadFixturesFromFile(filename string) ([]Fixture, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := make([]Fixture, 0)
+	err = json.Unmarshal(buff, &fixtures)
+	if err != nil {
+		return nil, fmt.Errorf("%w in file %s: %s", ErrInvalidFixture, filename, err.Error())
+	}
+
+	return fixtures, nil
+}
+
+// URL returns the address the fake gateway can be reached at
+func (gateway *fakeGateway) URL() string {
+	return "http://" + gateway.listener.Addr().String()
+}
+
+// AddFixtures appends the fixtures, which are tried in the order they were added
+func (gateway *fakeGateway) AddFixtures(fixtures ...Fixture) error {
+	states := make([]*fixtureState, 0, len(fixtures))
+	for idx, fixture := range fixtures {
+		state, err := newFixtureState(fixture)
+		if err != nil {
+			return fmt.Errorf("%w for fixture at index %d", err, idx)
+		}
+		states = append(states, state)
+	}
+
+	gateway.mut.Lock()
+	gateway.fixtures = append(gateway.fixtures, states...)
+	gateway.mut.Unlock()
+
+	return nil
+}
+
+// SetData adds a fixture always answering the method and route with the provided data wrapped in the gateway
+// response envelope
+func (gateway *fakeGateway) SetData(method string, route string, data interface{}) error {
+	buff, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return gateway.AddFixtures(Fixture{
+		Method: method,
+		Route:  route,
+		Data:   buff,
+	})
+}
+
+// Requests returns the requests received so far
+func (gateway *fakeGateway) Requests() []RecordedRequest {
+	gateway.mut.Lock()
+	defer gateway.mut.Unlock()
+
+	return append(make([]RecordedRequest, 0, len(gateway.requests)), gateway.requests...)
+}
+
+// Reset removes all fixtures and recorded requests
+func (gateway *fakeGateway) Reset() {
+	gateway.mut.Lock()
+	gateway.fixtures = nil
+	gateway.requests = nil
+	gateway.mut.Unlock()
+}
+
+// ServeHTTP answers the request with the first matching fixture
+func (gateway *fakeGateway) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		writeResponse(writer, http.StatusBadRequest, errorResponse(err.Error(), "bad_request"))
+		return
+	}
+
+	path := strings.Trim(request.URL.Path, "/")
+	state := gateway.findFixture(request.Method, path, body)
+	if state == nil {
+		log.Debug("fake gateway: no fixture", "method", request.Method, "path", path)
+		writeResponse(writer, http.StatusNotFound, errorResponse(fmt.Sprintf("no fixture for %s %s", request.Method, path), codeNotFound))
+		return
+	}
+
+	statusCode := state.fixture.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	if len(state.fixture.Response) > 0 {
+		writeResponse(writer, statusCode, state.fixture.Response)
+		return
+	}
+
+	writeResponse(writer, statusCode, dataResponse(state.fixture.Data))
+}
+
+func (gateway *fakeGateway) findFixture(method string, path string, body []byte) *fixtureState {
+	gateway.mut.Lock()
+	defer gateway.mut.Unlock()
+
+	gateway.requests = append(gateway.requests, RecordedRequest{
+		Method: method,
+		Path:   path,
+		Body:   body,
+	})
+
+	segments := strings.Split(path, "/")
+	compactedBody := compactJSON(body)
+	for _, state := range gateway.fixtures {
+		if state.fixture.Method != method {
+			continue
+		}
+		if state.fixture.Times > 0 && state.numServed >= state.fixture.Times {
+			continue
+		}
+		if !segmentsMatch(state.segments, segments) {
+			continue
+		}
+		if len(state.requestBody) > 0 && !bytes.Equal(state.requestBody, compactedBody) {
+			continue
+		}
+
+		state.numServed++
+		return state
+	}
+
+	return nil
+}
+
+// Close stops the fake gateway
+func (gateway *fakeGateway) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	return gateway.server.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gateway *fakeGateway) IsInterfaceNil() bool {
+	return gateway == nil
+}
+
+func newFixtureState(fixture Fixture) (*fixtureState, error) {
+	if len(fixture.Method) == 0 {
+		return nil, fmt.Errorf("%w: empty method", ErrInvalidFixture)
+	}
+	if fixture.Times < 0 {
+		return nil, fmt.Errorf("%w: negative times", ErrInvalidFixture)
+	}
+
+	route := strings.Trim(strings.SplitN(fixture.Route, "?", 2)[0], "/")
+	segments := strings.Split(route, "/")
+	if !isGatewayRoute(segments) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRoute, fixture.Route)
+	}
+
+	return &fixtureState{
+		fixture:     fixture,
+		segments:    segments,
+		requestBody: compactJSON(fixture.RequestBody),
+	}, nil
+}
+
+func isGatewayRoute(segments []string) bool {
+	for _, route := range GatewayRoutes {
+		if segmentsMatch(strings.Split(route, "/"), segments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// segmentsMatch returns true if the route segments match the path segments, a placeholder segment on any side
+// matching any value
+func segmentsMatch(routeSegments []string, pathSegments []string) bool {
+	if len(routeSegments) != len(pathSegments) {
+		return false
+	}
+
+	for idx := range routeSegments {
+		if isPlaceholder(routeSegments[idx]) || isPlaceholder(pathSegments[idx]) {
+			continue
+		}
+		if routeSegments[idx] != pathSegments[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPlaceholder(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func compactJSON(buff []byte) []byte {
+	if len(buff) == 0 {
+		return nil
+	}
+
+	compacted := bytes.NewBuffer(nil)
+	err := json.Compact(compacted, buff)
+	if err != nil {
+		return buff
+	}
+
+	return compacted.Bytes()
+}
+
+func dataResponse(data json.RawMessage) []byte {
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+
+	buff, _ := json.Marshal(map[string]interface{}{
+		"data":  data,
+		"error": "",
+		"code":  codeSuccessful,
+	})
+
+	return buff
+}
+
+func errorResponse(message string, code string) []byte {
+	buff, _ := json.Marshal(map[string]interface{}{
+		"data":  nil,
+		"error": message,
+		"code":  code,
+	})
+
+	return buff
+}
+
+func writeResponse(writer http.ResponseWriter, statusCode int, body []byte) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_, _ = writer.Write(body)
+}
@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createFakeGatewayAndProxy(t *testing.T, fixtures ...Fixture) (*fakeGateway, blockchain.Proxy) {
+	gateway, err := NewFakeGateway(ArgsFakeGateway{Fixtures: fixtures})
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = gateway.Close()
+	})
+
+	proxy, err := blockchain.NewProxy(blockchain.ArgsProxy{
+		ProxyURL:            gateway.URL(),
+		CacheExpirationTime: time.Minute,
+		EntityType:          sdkCore.Proxy,
+	})
+	require.Nil(t, err)
+
+	return gateway, proxy
+}
+
+func TestNewFakeGateway(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fixture without method should error", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, err := NewFakeGateway(ArgsFakeGateway{Fixtures: []Fixture{{Route: "network/config"}}})
+		assert.True(t, check.IfNil(gateway))
+		assert.True(t, errors.Is(err, ErrInvalidFixture))
+	})
+	t.Run("fixture with unknown route should error", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, err := NewFakeGateway(ArgsFakeGateway{Fixtures: []Fixture{{Method: http.MethodGet, Route: "network/configs"}}})
+		assert.True(t, check.IfNil(gateway))
+		assert.True(t, errors.Is(err, ErrUnknownRoute))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, err := NewFakeGateway(ArgsFakeGateway{})
+		assert.False(t, check.IfNil(gateway))
+		assert.Nil(t, err)
+		assert.Nil(t, gateway.Close())
+	})
+}
+
+func TestFakeGateway_FixturesFromFile(t *testing.T) {
+	t.Parallel()
+
+	fixtures, err := LoadFixturesFromFile("testdata/session.json")
+	require.Nil(t, err)
+	_, proxy := createFakeGatewayAndProxy(t, fixtures...)
+
+	networkConfig, err := proxy.GetNetworkConfig(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "T", networkConfig.ChainID)
+	assert.Equal(t, uint32(3), networkConfig.NumShardsWithoutMeta)
+
+	address, _ := data.NewAddressFromBech32String(testAddress)
+	account, err := proxy.GetAccount(context.Background(), address)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(37), account.Nonce)
+	assert.Equal(t, "100000000000000000000", account.Balance)
+
+	hyperBlockProxy := proxy.(interface {
+		GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error)
+	})
+	hyperBlock, err := hyperBlockProxy.GetHyperBlockByNonce(context.Background(), 128)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(129), hyperBlock.Round)
+
+	t.Run("missing file should error", func(t *testing.T) {
+		_, err = LoadFixturesFromFile("testdata/missing.json")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFakeGateway_AccountStorageRoutes(t *testing.T) {
+	t.Parallel()
+
+	_, proxy := createFakeGatewayAndProxy(t,
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/key/{key}",
+			Data:   json.RawMessage(`{"value":"76616c7565"}`),
+		},
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/keys",
+			Data:   json.RawMessage(`{"pairs":{"6b6579":"76616c7565"}}`),
+		},
+		Fixture{
+			Method: http.MethodPost,
+			Route:  "address/iterate-keys",
+			Data:   json.RawMessage(`{"pairs":{"6b6579":"76616c7565"},"newIteratorState":[]}`),
+		},
+	)
+	storageProxy := proxy.(interface {
+		GetAccountStorageValue(ctx context.Context, address sdkCore.AddressHandler, key []byte, options api.AccountQueryOptions) (*data.AccountStorageValue, error)
+		GetAccountKeys(ctx context.Context, address sdkCore.AddressHandler, options api.AccountQueryOptions) (*data.AccountKeys, error)
+		IterateAccountKeys(ctx context.Context, address sdkCore.AddressHandler, numKeys uint, iteratorState [][]byte, options api.AccountQueryOptions) (*data.AccountKeysPage, error)
+	})
+	address, _ := data.NewAddressFromBech32String(testAddress)
+	expectedPairs := []*data.StorageKeyValuePair{{Key: []byte("key"), Value: []byte("value")}}
+
+	value, err := storageProxy.GetAccountStorageValue(context.Background(), address, []byte("key"), api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "value", value.Value.ToString())
+
+	keys, err := storageProxy.GetAccountKeys(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedPairs, keys.Pairs)
+
+	page, err := storageProxy.IterateAccountKeys(context.Background(), address, 10, nil, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedPairs, page.Pairs)
+	assert.Empty(t, page.IteratorState)
+}
+
+func TestFakeGateway_TokenRoutes(t *testing.T) {
+	t.Parallel()
+
+	_, proxy := createFakeGatewayAndProxy(t,
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/esdt",
+			Data:   json.RawMessage(`{"esdts":{"TKN-123456":{"tokenIdentifier":"TKN-123456","balance":"10"}}}`),
+		},
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/esdts/roles",
+			Data:   json.RawMessage(`{"roles":{"TKN-123456":["ESDTRoleLocalMint"]}}`),
+		},
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/esdts-with-role/{role}",
+			Data:   json.RawMessage(`{"tokens":["TKN-123456"]}`),
+		},
+		Fixture{
+			Method: http.MethodGet,
+			Route:  "address/{address}/registered-nfts",
+			Data:   json.RawMessage(`{"tokens":["NFT-123456"]}`),
+		},
+	)
+	tokensProxy := proxy.(interface {
+		GetAllESDTTokens(ctx context.Context, address sdkCore.AddressHandler, options api.AccountQueryOptions) (map[string]*data.ESDTNFTTokenData, error)
+		GetESDTRoles(ctx context.Context, address sdkCore.AddressHandler, options api.AccountQueryOptions) (*data.ESDTRoles, error)
+		GetESDTsWithRole(ctx context.Context, address sdkCore.AddressHandler, role string, options api.AccountQueryOptions) (*data.ESDTTokensList, error)
+		GetRegisteredNFTs(ctx context.Context, address sdkCore.AddressHandler, options api.AccountQueryOptions) (*data.ESDTTokensList, error)
+	})
+	address, _ := data.NewAddressFromBech32String(testAddress)
+
+	tokens, err := tokensProxy.GetAllESDTTokens(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "10", tokens["TKN-123456"].Balance)
+
+	roles, err := tokensProxy.GetESDTRoles(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, map[string][]string{"TKN-123456": {"ESDTRoleLocalMint"}}, roles.Roles)
+
+	tokensWithRole, err := tokensProxy.GetESDTsWithRole(context.Background(), address, "ESDTRoleLocalMint", api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"TKN-123456"}, tokensWithRole.Tokens)
+
+	registeredNFTs, err := tokensProxy.GetRegisteredNFTs(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"NFT-123456"}, registeredNFTs.Tokens)
+}
+
+func TestFakeGateway_ScriptedResponses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("times should fall through to the next fixture", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, proxy := createFakeGatewayAndProxy(t,
+			Fixture{
+				Method:   http.MethodPost,
+				Route:    "transaction/send",
+				Response: json.RawMessage(`{"data":null,"error":"transaction generation failed: lowerNonceInTransaction","code":"bad_request"}`),
+				Times:    1,
+			},
+			Fixture{
+				Method: http.MethodPost,
+				Route:  "transaction/send",
+				Data:   json.RawMessage(`{"txHash":"aabb"}`),
+			},
+		)
+
+		tx := &transaction.FrontendTransaction{Sender: testAddress, Receiver: testAddress, Value: "0"}
+		_, err := proxy.SendTransaction(context.Background(), tx)
+		assert.Equal(t, "transaction generation failed: lowerNonceInTransaction", err.Error())
+
+		hash, err := proxy.SendTransaction(context.Background(), tx)
+		require.Nil(t, err)
+		assert.Equal(t, "aabb", hash)
+
+		requests := gateway.Requests()
+		require.Len(t, requests, 2)
+		sentTx := &transaction.FrontendTransaction{}
+		require.Nil(t, json.Unmarshal(requests[1].Body, sentTx))
+		assert.Equal(t, tx, sentTx)
+	})
+	t.Run("request body should select the fixture", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, proxy := createFakeGatewayAndProxy(t)
+		for _, funcName := range []string{"getFirst", "getSecond"} {
+			requestBody, _ := json.Marshal(data.VmValueRequestWithOptionalParameters{
+				VmValueRequest: &data.VmValueRequest{Address: testAddress, FuncName: funcName},
+			})
+			err := gateway.AddFixtures(Fixture{
+				Method:      http.MethodPost,
+				Route:       "vm-values/query",
+				RequestBody: requestBody,
+				Data:        json.RawMessage(fmt.Sprintf(`{"data":{"returnData":["%s"],"returnCode":"ok"}}`, "AQ==")),
+			})
+			require.Nil(t, err)
+		}
+		err := gateway.SetData(http.MethodPost, "vm-values/query", data.VmValuesResponseData{
+			Data: &vm.VMOutputApi{ReturnCode: "user error"},
+		})
+		require.Nil(t, err)
+
+		response, err := proxy.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: testAddress, FuncName: "getSecond"})
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{{1}}, response.Data.ReturnData)
+
+		response, err = proxy.ExecuteVMQuery(context.Background(), &data.VmValueRequest{Address: testAddress, FuncName: "getThird"})
+		require.Nil(t, err)
+		assert.Equal(t, "user error", response.Data.ReturnCode)
+	})
+	t.Run("missing fixture should answer with not found", func(t *testing.T) {
+		t.Parallel()
+
+		gateway, proxy := createFakeGatewayAndProxy(t)
+
+		_, err := proxy.GetNetworkConfig(context.Background())
+		assert.NotNil(t, err)
+
+		gateway.Reset()
+		assert.Empty(t, gateway.Requests())
+	})
+}
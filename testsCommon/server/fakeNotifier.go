@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const notifierRoute = "/hub/ws"
+
+type notifierConnection struct {
+	mut           sync.Mutex
+	conn          *websocket.Conn
+	subscribed    bool
+	subscriptions []data.NotifierSubscription
+}
+
+func (connection *notifierConnection) write(message *data.NotifierMessage) error {
+	connection.mut.Lock()
+	defer connection.mut.Unlock()
+
+	return connection.conn.WriteJSON(message)
+}
+
+// filterEvents returns the log events matching the subscriptions of the connection, an empty subscription list
+// matching all of them
+func (connection *notifierConnection) filterEvents(events []data.NotifierEvent) []data.NotifierEvent {
+	if len(connection.subscriptions) == 0 {
+		return events
+	}
+
+	filtered := make([]data.NotifierEvent, 0, len(events))
+	for _, event := range events {
+		for _, subscription := range connection.subscriptions {
+			if isEventSubscription(subscription, event) {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+func (connection *notifierConnection) isSubscribedTo(eventType data.NotifierEventType) bool {
+	for _, subscription := range connection.subscriptions {
+		if subscription.EventType == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isEventSubscription(subscription data.NotifierSubscription, event data.NotifierEvent) bool {
+	if len(subscription.EventType) > 0 && subscription.EventType != data.NotifierAllEvents {
+		return false
+	}
+	if len(subscription.Address) > 0 && subscription.Address != event.Address {
+		return false
+	}
+
+	return len(subscription.Identifier) == 0 || subscription.Identifier == event.Identifier
+}
+
+type fakeNotifier struct {
+	server   *http.Server
+	listener net.Listener
+	upgrader websocket.Upgrader
+
+	mut           sync.Mutex
+	connections   map[*notifierConnection]struct{}
+	subscriptions []data.NotifierSubscribe
+}
+
+// NewFakeNotifier creates and starts a local stand-in of the events notifier websocket stream. As the real notifier,
+// it pushes the values matching the subscriptions of the connected clients, without keeping them for the clients
+// connecting later and without expecting any acknowledgement
+func NewFakeNotifier() (*fakeNotifier, error) {
+	listener, err := net.Listen("tcp", defaultListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier := &fakeNotifier{
+		listener:    listener,
+		connections: make(map[*notifierConnection]struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(notifierRoute, notifier.serveWebsocket)
+	notifier.server = &http.Server{Handler: mux}
+	go func() {
+		errServe := notifier.server.Serve(listener)
+		if errServe != nil && errServe != http.ErrServerClosed {
+			log.Error("fake notifier stopped", "error", errServe)
+		}
+	}()
+
+	return notifier, nil
+}
+
+// URL returns the websocket address of the stream
+func (notifier *fakeNotifier) URL() string {
+	return "ws://" + notifier.listener.Addr().String() + notifierRoute
+}
+
+func (notifier *fakeNotifier) serveWebsocket(writer http.ResponseWriter, request *http.Request) {
+	conn, err := notifier.upgrader.Upgrade(writer, request, nil)
+	if err != nil {
+		log.Debug("fake notifier: upgrade failed", "error", err)
+		return
+	}
+	connection := &notifierConnection{conn: conn}
+	notifier.addConnection(connection)
+	defer notifier.removeConnection(connection)
+
+	for {
+		_, buff, errRead := conn.ReadMessage()
+		if errRead != nil {
+			return
+		}
+
+		subscribe := data.NotifierSubscribe{}
+		err = json.Unmarshal(buff, &subscribe)
+		if err != nil {
+			log.Debug("fake notifier: invalid subscription", "error", err)
+			continue
+		}
+
+		notifier.addSubscription(connection, subscribe)
+	}
+}
+
+func (notifier *fakeNotifier) addConnection(connection *notifierConnection) {
+	notifier.mut.Lock()
+	notifier.connections[connection] = struct{}{}
+	notifier.mut.Unlock()
+}
+
+func (notifier *fakeNotifier) addSubscription(connection *notifierConnection, subscribe data.NotifierSubscribe) {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	notifier.subscriptions = append(notifier.subscriptions, subscribe)
+	connection.subscribed = true
+	connection.subscriptions = append(connection.subscriptions, subscribe.SubscriptionEntries...)
+}
+
+func (notifier *fakeNotifier) removeConnection(connection *notifierConnection) {
+	notifier.mut.Lock()
+	delete(notifier.connections, connection)
+	notifier.mut.Unlock()
+
+	_ = connection.conn.Close()
+}
+
+// PushEvents sends to each connected client the log events matching its subscriptions
+func (notifier *fakeNotifier) PushEvents(events []data.NotifierEvent) error {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	for connection := range notifier.connections {
+		if !connection.subscribed {
+			continue
+		}
+
+		filtered := connection.filterEvents(events)
+		if len(filtered) == 0 {
+			continue
+		}
+
+		err := notifier.push(connection, data.NotifierAllEvents, filtered)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PushBlockEvents sends the block with all its log events to the clients subscribed to the block events
+func (notifier *fakeNotifier) PushBlockEvents(block *data.NotifierBlockWithEvents) error {
+	return notifier.pushToSubscribers(data.NotifierBlockEvents, block)
+}
+
+// PushRevertedBlock sends the reverted block to the clients subscribed to the revert events
+func (notifier *fakeNotifier) PushRevertedBlock(block *data.NotifierRevertedBlock) error {
+	return notifier.pushToSubscribers(data.NotifierRevertEvents, block)
+}
+
+// PushFinalizedBlock sends the finalized block to the clients subscribed to the finalized events
+func (notifier *fakeNotifier) PushFinalizedBlock(block *data.NotifierFinalizedBlock) error {
+	return notifier.pushToSubscribers(data.NotifierFinalizedEvents, block)
+}
+
+func (notifier *fakeNotifier) pushToSubscribers(eventType data.NotifierEventType, payload interface{}) error {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	for connection := range notifier.connections {
+		if !connection.isSubscribedTo(eventType) {
+			continue
+		}
+
+		err := notifier.push(connection, eventType, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (notifier *fakeNotifier) push(connection *notifierConnection, eventType data.NotifierEventType, payload interface{}) error {
+	buff, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	err = connection.write(&data.NotifierMessage{
+		Type: eventType,
+		Data: buff,
+	})
+	if err != nil {
+		log.Debug("fake notifier: push failed", "error", err)
+	}
+
+	return nil
+}
+
+// NumSubscribers returns the number of connected clients that sent a subscription
+func (notifier *fakeNotifier) NumSubscribers() int {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	numSubscribers := 0
+	for connection := range notifier.connections {
+		if connection.subscribed {
+			numSubscribers++
+		}
+	}
+
+	return numSubscribers
+}
+
+// DropConnections closes the connections of all clients, simulating a network failure
+func (notifier *fakeNotifier) DropConnections() {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	for connection := range notifier.connections {
+		_ = connection.conn.Close()
+		delete(notifier.connections, connection)
+	}
+}
+
+// Subscriptions returns the subscriptions received so far
+func (notifier *fakeNotifier) Subscriptions() []data.NotifierSubscribe {
+	notifier.mut.Lock()
+	defer notifier.mut.Unlock()
+
+	return append(make([]data.NotifierSubscribe, 0, len(notifier.subscriptions)), notifier.subscriptions...)
+}
+
+// Close stops the fake notifier
+func (notifier *fakeNotifier) Close() error {
+	notifier.DropConnections()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	return notifier.server.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *fakeNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rawNotifierMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data"`
+}
+
+func readRawNotifierMessage(t *testing.T, conn *websocket.Conn) (string, string) {
+	require.Nil(t, conn.SetReadDeadline(time.Now().Add(time.Second*5)))
+
+	message := rawNotifierMessage{}
+	require.Nil(t, conn.ReadJSON(&message))
+	payload, err := base64.StdEncoding.DecodeString(message.Data)
+	require.Nil(t, err)
+
+	return message.Type, string(payload)
+}
+
+func TestFakeNotifier_ShouldFollowTheEventsNotifierWireFormat(t *testing.T) {
+	t.Parallel()
+
+	notifier, err := NewFakeNotifier()
+	require.Nil(t, err)
+	defer func() {
+		_ = notifier.Close()
+	}()
+
+	conn, _, err := websocket.DefaultDialer.Dial(notifier.URL(), nil)
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	subscription := `{"subscriptionEntries":[{"identifier":"ESDTTransfer"},{"eventType":"finalized_events"}]}`
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(subscription)))
+	require.Eventually(t, func() bool {
+		return notifier.NumSubscribers() == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	expectedSubscription := data.NotifierSubscribe{
+		SubscriptionEntries: []data.NotifierSubscription{
+			{Identifier: "ESDTTransfer"},
+			{EventType: data.NotifierFinalizedEvents},
+		},
+	}
+	assert.Equal(t, []data.NotifierSubscribe{expectedSubscription}, notifier.Subscriptions())
+
+	require.Nil(t, notifier.PushEvents([]data.NotifierEvent{
+		{
+			Address:    testAddress,
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN")},
+			Data:       []byte("data"),
+			TxHash:     "txHash",
+		},
+		{
+			Address:    testAddress,
+			Identifier: "writeLog",
+		},
+	}))
+	messageType, payload := readRawNotifierMessage(t, conn)
+	assert.Equal(t, "all_events", messageType)
+	expectedEvents := `[{"address":"` + testAddress + `","identifier":"ESDTTransfer","topics":["VEtO"],"data":"ZGF0YQ==","txHash":"txHash"}]`
+	assert.JSONEq(t, expectedEvents, payload)
+
+	// not subscribed to the revert and block events, so only the finalized block is pushed
+	require.Nil(t, notifier.PushRevertedBlock(&data.NotifierRevertedBlock{Hash: "reverted", Nonce: 1}))
+	require.Nil(t, notifier.PushBlockEvents(&data.NotifierBlockWithEvents{Hash: "block"}))
+	require.Nil(t, notifier.PushFinalizedBlock(&data.NotifierFinalizedBlock{Hash: "finalized"}))
+	messageType, payload = readRawNotifierMessage(t, conn)
+	assert.Equal(t, "finalized_events", messageType)
+	assert.JSONEq(t, `{"hash":"finalized"}`, payload)
+
+	buff, err := json.Marshal(data.NotifierRevertedBlock{Hash: "reverted", Nonce: 2, Round: 3, Epoch: 4})
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"hash":"reverted","nonce":2,"round":3,"epoch":4}`, string(buff))
+
+	buff, err = json.Marshal(data.NotifierBlockWithEvents{Hash: "block", ShardID: 1, TimeStamp: 2, Events: []data.NotifierEvent{}})
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"hash":"block","shardId":1,"timestamp":2,"events":[]}`, string(buff))
+}
@@ -0,0 +1,30 @@
+package testsCommon
+
+// TrackableAddressesProviderStub -
+type TrackableAddressesProviderStub struct {
+	IsTrackableAddressesCalled      func(addressAsBech32 string) bool
+	PrivateKeyOfBech32AddressCalled func(addressAsBech32 string) []byte
+}
+
+// IsTrackableAddresses -
+func (stub *TrackableAddressesProviderStub) IsTrackableAddresses(addressAsBech32 string) bool {
+	if stub.IsTrackableAddressesCalled != nil {
+		return stub.IsTrackableAddressesCalled(addressAsBech32)
+	}
+
+	return false
+}
+
+// PrivateKeyOfBech32Address -
+func (stub *TrackableAddressesProviderStub) PrivateKeyOfBech32Address(addressAsBech32 string) []byte {
+	if stub.PrivateKeyOfBech32AddressCalled != nil {
+		return stub.PrivateKeyOfBech32AddressCalled(addressAsBech32)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TrackableAddressesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,88 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DepositHandlerArgs is the argument DTO for the NewDepositHandler constructor function
+type DepositHandlerArgs struct {
+	TrackableAddressesProvider TrackableAddressesProvider
+	MinimumBalance             *big.Int
+	// Handler is called for each transaction transferring at least MinimumBalance EGLD to a tracked address
+	Handler func(tx data.TransactionOnNetwork)
+}
+
+// depositHandler is the hyper block indexer plugin detecting the EGLD deposits on the tracked addresses
+type depositHandler struct {
+	trackableAddressesProvider TrackableAddressesProvider
+	minimumBalance             *big.Int
+	handler                    func(tx data.TransactionOnNetwork)
+}
+
+// NewDepositHandler will create a new depositHandler instance
+func NewDepositHandler(args DepositHandlerArgs) (*depositHandler, error) {
+	if check.IfNil(args.TrackableAddressesProvider) {
+		return nil, ErrNilTrackableAddressesProvider
+	}
+	if args.MinimumBalance == nil {
+		return nil, ErrNilMinimumBalance
+	}
+	if args.Handler == nil {
+		return nil, ErrNilHandlerFunction
+	}
+
+	return &depositHandler{
+		trackableAddressesProvider: args.TrackableAddressesProvider,
+		minimumBalance:             args.MinimumBalance,
+		handler:                    args.Handler,
+	}, nil
+}
+
+// ProcessHyperBlock does nothing, the deposits being detected on each transaction
+func (dh *depositHandler) ProcessHyperBlock(_ context.Context, _ *data.HyperBlock) error {
+	return nil
+}
+
+// ProcessTransaction notifies the handler if the transaction is a deposit on a tracked address. The transactions
+// with invalid values are logged and ignored
+func (dh *depositHandler) ProcessTransaction(_ context.Context, _ *data.HyperBlock, tx *data.TransactionOnNetwork) error {
+	err := dh.processTransaction(tx)
+	if err != nil {
+		transactionString, _ := json.Marshal(tx)
+		log.Warn("error processing transaction, ignoring",
+			"transaction", transactionString, "error", err)
+	}
+
+	return nil
+}
+
+func (dh *depositHandler) processTransaction(tx *data.TransactionOnNetwork) error {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return ErrInvalidTransactionValue
+	}
+
+	if !dh.trackableAddressesProvider.IsTrackableAddresses(tx.Receiver) {
+		return nil
+	}
+
+	if value.Cmp(dh.minimumBalance) < 0 {
+		// transaction has a very small value transfer (possible attack vector as someone
+		// can trigger millions of these transactions as to consume the owner's balance through fees)
+		return nil
+	}
+
+	dh.handler(*tx)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dh *depositHandler) IsInterfaceNil() bool {
+	return dh == nil
+}
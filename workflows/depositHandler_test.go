@@ -0,0 +1,134 @@
+package workflows
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	trackedAddress   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	untrackedAddress = "erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8"
+)
+
+func createTrackableAddressesProvider(addresses ...string) *testsCommon.TrackableAddressesProviderStub {
+	return &testsCommon.TrackableAddressesProviderStub{
+		IsTrackableAddressesCalled: func(addressAsBech32 string) bool {
+			for _, address := range addresses {
+				if address == addressAsBech32 {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+}
+
+func createMockDepositHandlerArgs() DepositHandlerArgs {
+	return DepositHandlerArgs{
+		TrackableAddressesProvider: createTrackableAddressesProvider(trackedAddress),
+		MinimumBalance:             big.NewInt(100),
+		Handler:                    func(tx data.TransactionOnNetwork) {},
+	}
+}
+
+func TestNewDepositHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositHandlerArgs()
+		args.TrackableAddressesProvider = nil
+		handler, err := NewDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositHandlerArgs()
+		args.MinimumBalance = nil
+		handler, err := NewDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilMinimumBalance, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDepositHandlerArgs()
+		args.Handler = nil
+		handler, err := NewDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilHandlerFunction, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewDepositHandler(createMockDepositHandlerArgs())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestDepositHandler_ProcessTransaction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		tx               data.TransactionOnNetwork
+		shouldBeReported bool
+	}{
+		{
+			name:             "deposit on a tracked address should be reported",
+			tx:               data.TransactionOnNetwork{Hash: "hash", Receiver: trackedAddress, Value: "150"},
+			shouldBeReported: true,
+		},
+		{
+			name:             "deposit of the minimum balance should be reported",
+			tx:               data.TransactionOnNetwork{Hash: "hash", Receiver: trackedAddress, Value: "100"},
+			shouldBeReported: true,
+		},
+		{
+			name: "deposit below the minimum balance should be ignored",
+			tx:   data.TransactionOnNetwork{Hash: "hash", Receiver: trackedAddress, Value: "99"},
+		},
+		{
+			name: "deposit on an untracked address should be ignored",
+			tx:   data.TransactionOnNetwork{Hash: "hash", Receiver: untrackedAddress, Value: "150"},
+		},
+		{
+			name: "invalid value should be ignored",
+			tx:   data.TransactionOnNetwork{Hash: "hash", Receiver: trackedAddress, Value: "not a number"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reported := make([]data.TransactionOnNetwork, 0)
+			args := createMockDepositHandlerArgs()
+			args.Handler = func(tx data.TransactionOnNetwork) {
+				reported = append(reported, tx)
+			}
+			handler, _ := NewDepositHandler(args)
+
+			block := &data.HyperBlock{Nonce: 1, Transactions: []data.TransactionOnNetwork{testCase.tx}}
+			assert.Nil(t, handler.ProcessHyperBlock(context.Background(), block))
+			assert.Nil(t, handler.ProcessTransaction(context.Background(), block, &block.Transactions[0]))
+			if testCase.shouldBeReported {
+				assert.Equal(t, []data.TransactionOnNetwork{testCase.tx}, reported)
+			} else {
+				assert.Empty(t, reported)
+			}
+		})
+	}
+}
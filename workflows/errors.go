@@ -22,3 +22,18 @@ var ErrNilMinimumBalance = errors.New("nil minimum balance")
 
 // ErrNilTransactionInteractor signals that a nil transaction interactor was provided
 var ErrNilTransactionInteractor = errors.New("nil transaction interactor")
+
+// ErrNilFinalityProvider signals that a nil finality provider was provided
+var ErrNilFinalityProvider = errors.New("nil finality provider")
+
+// ErrNilHyperBlockHandler signals that a nil hyper block handler was provided
+var ErrNilHyperBlockHandler = errors.New("nil hyper block handler")
+
+// ErrNilHyperBlock signals that a nil hyper block was fetched
+var ErrNilHyperBlock = errors.New("nil hyper block")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilHandlerFunction signals that a nil handler function was provided
+var ErrNilHandlerFunction = errors.New("nil handler function")
@@ -0,0 +1,189 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ESDTDepositHandlerArgs is the argument DTO for the NewESDTDepositHandler constructor function
+type ESDTDepositHandlerArgs struct {
+	TrackableAddressesProvider TrackableAddressesProvider
+	// TokenMinimumBalances holds the minimum deposit amount for each token identifier. The identifier of an NFT or SFT
+	// is the one of its collection, without the nonce
+	TokenMinimumBalances map[string]*big.Int
+	// DefaultTokenMinimumBalance is the minimum deposit amount for the tokens missing from TokenMinimumBalances. If
+	// nil, only the tokens in TokenMinimumBalances are tracked
+	DefaultTokenMinimumBalance *big.Int
+	// Handler is called for each token transfer to a tracked address of at least the minimum amount of its token
+	Handler func(deposit data.TokenDeposit)
+}
+
+// esdtDepositHandler is the hyper block indexer plugin detecting the ESDT, SFT and NFT deposits on the tracked
+// addresses. The transfers are searched in the transactions and in the smart contract results, so the tokens sent by
+// contracts are detected too
+type esdtDepositHandler struct {
+	trackableAddressesProvider TrackableAddressesProvider
+	tokenMinimumBalances       map[string]*big.Int
+	defaultTokenMinimumBalance *big.Int
+	handler                    func(deposit data.TokenDeposit)
+}
+
+// NewESDTDepositHandler will create a new esdtDepositHandler instance
+func NewESDTDepositHandler(args ESDTDepositHandlerArgs) (*esdtDepositHandler, error) {
+	if check.IfNil(args.TrackableAddressesProvider) {
+		return nil, ErrNilTrackableAddressesProvider
+	}
+	if args.Handler == nil {
+		return nil, ErrNilHandlerFunction
+	}
+
+	tokenMinimumBalances := make(map[string]*big.Int, len(args.TokenMinimumBalances))
+	for token, minimumBalance := range args.TokenMinimumBalances {
+		if minimumBalance == nil {
+			return nil, fmt.Errorf("%w for token %s", ErrNilMinimumBalance, token)
+		}
+		tokenMinimumBalances[token] = big.NewInt(0).Set(minimumBalance)
+	}
+
+	var defaultTokenMinimumBalance *big.Int
+	if args.DefaultTokenMinimumBalance != nil {
+		defaultTokenMinimumBalance = big.NewInt(0).Set(args.DefaultTokenMinimumBalance)
+	}
+
+	return &esdtDepositHandler{
+		trackableAddressesProvider: args.TrackableAddressesProvider,
+		tokenMinimumBalances:       tokenMinimumBalances,
+		defaultTokenMinimumBalance: defaultTokenMinimumBalance,
+		handler:                    args.Handler,
+	}, nil
+}
+
+// ProcessHyperBlock searches the deposits in the transactions and smart contract results of the hyper block. Only the
+// smart contract results sent by contracts are considered, the ones sent on behalf of users carrying the transfers
+// already detected on the users' transactions. The smart contract results can be provided by the hyper block both as
+// unsigned transactions and inside their original transactions, so they are processed only once
+func (edh *esdtDepositHandler) ProcessHyperBlock(_ context.Context, block *data.HyperBlock) error {
+	processedResults := make(map[string]struct{})
+
+	for idx := range block.Transactions {
+		tx := &block.Transactions[idx]
+		if isFailedTransaction(tx) {
+			continue
+		}
+
+		if len(tx.OriginalTransactionHash) > 0 {
+			if !isContractAddress(tx.Sender) || isResultAlreadyProcessed(processedResults, tx.Hash) {
+				continue
+			}
+			edh.processTransfer(block, tx.Sender, tx.Receiver, tx.Data, tx.Hash, tx.OriginalTransactionHash)
+			continue
+		}
+
+		edh.processTransfer(block, tx.Sender, tx.Receiver, tx.Data, tx.Hash, tx.Hash)
+		for _, scr := range tx.ScResults {
+			if scr == nil || !isContractAddress(scr.SndAddr) || isResultAlreadyProcessed(processedResults, scr.Hash) {
+				continue
+			}
+
+			originalTxHash := scr.OriginalTxHash
+			if len(originalTxHash) == 0 {
+				originalTxHash = tx.Hash
+			}
+			edh.processTransfer(block, scr.SndAddr, scr.RcvAddr, []byte(scr.Data), scr.Hash, originalTxHash)
+		}
+	}
+
+	return nil
+}
+
+// ProcessTransaction does nothing, the deposits being detected on the whole hyper block
+func (edh *esdtDepositHandler) ProcessTransaction(_ context.Context, _ *data.HyperBlock, _ *data.TransactionOnNetwork) error {
+	return nil
+}
+
+func (edh *esdtDepositHandler) processTransfer(
+	block *data.HyperBlock,
+	sender string,
+	receiver string,
+	txData []byte,
+	txHash string,
+	originalTxHash string,
+) {
+	transfer, err := builders.ParseTokenTransfer(sender, receiver, txData)
+	if err != nil {
+		log.Warn("error processing token transfer, ignoring", "hash", txHash, "error", err)
+		return
+	}
+	if transfer == nil || !edh.trackableAddressesProvider.IsTrackableAddresses(transfer.Destination) {
+		return
+	}
+
+	for _, payment := range transfer.Payments {
+		if !edh.isAboveMinimumBalance(payment) {
+			// same as for EGLD, the small transfers are ignored so the deposits can not be used for
+			// consuming the owner's balance through the fees of the collecting transactions
+			continue
+		}
+
+		edh.handler(data.TokenDeposit{
+			Sender:          sender,
+			Receiver:        transfer.Destination,
+			TokenIdentifier: payment.TokenIdentifier,
+			Nonce:           payment.Nonce,
+			Amount:          payment.Amount,
+			TxHash:          txHash,
+			OriginalTxHash:  originalTxHash,
+			HyperBlockNonce: block.Nonce,
+		})
+	}
+}
+
+func (edh *esdtDepositHandler) isAboveMinimumBalance(payment *data.TokenPayment) bool {
+	minimumBalance, found := edh.tokenMinimumBalances[payment.TokenIdentifier]
+	if !found {
+		minimumBalance = edh.defaultTokenMinimumBalance
+	}
+	if minimumBalance == nil {
+		return false
+	}
+
+	return payment.Amount.Cmp(minimumBalance) >= 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (edh *esdtDepositHandler) IsInterfaceNil() bool {
+	return edh == nil
+}
+
+func isFailedTransaction(tx *data.TransactionOnNetwork) bool {
+	status := transaction.TxStatus(tx.Status)
+
+	return status == transaction.TxStatusFail || status == transaction.TxStatusInvalid
+}
+
+func isContractAddress(bech32Address string) bool {
+	address, err := data.NewAddressFromBech32String(bech32Address)
+	if err != nil {
+		return false
+	}
+
+	return core.IsSmartContractAddress(address.AddressBytes())
+}
+
+func isResultAlreadyProcessed(processedResults map[string]struct{}, hash string) bool {
+	if len(hash) == 0 {
+		return false
+	}
+
+	_, found := processedResults[hash]
+	processedResults[hash] = struct{}{}
+
+	return found
+}
@@ -0,0 +1,389 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contractAddressBytes = append(make([]byte, 10), []byte("contract-address-bytes")...)
+	contractAddress      = data.NewAddressFromBytes(contractAddressBytes).AddressAsBech32String()
+)
+
+func createCallData(function string, args ...[]byte) []byte {
+	parts := []string{function}
+	for _, arg := range args {
+		parts = append(parts, hex.EncodeToString(arg))
+	}
+
+	return []byte(strings.Join(parts, "@"))
+}
+
+func addressBytes(bech32Address string) []byte {
+	address, _ := data.NewAddressFromBech32String(bech32Address)
+	return address.AddressBytes()
+}
+
+func bigBytes(value int64) []byte {
+	return big.NewInt(value).Bytes()
+}
+
+func createMockESDTDepositHandlerArgs() ESDTDepositHandlerArgs {
+	return ESDTDepositHandlerArgs{
+		TrackableAddressesProvider: createTrackableAddressesProvider(trackedAddress),
+		TokenMinimumBalances: map[string]*big.Int{
+			"TKN-123456": big.NewInt(100),
+			"NFT-123456": big.NewInt(1),
+		},
+		Handler: func(deposit data.TokenDeposit) {},
+	}
+}
+
+func TestNewESDTDepositHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockESDTDepositHandlerArgs()
+		args.TrackableAddressesProvider = nil
+		handler, err := NewESDTDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockESDTDepositHandlerArgs()
+		args.Handler = nil
+		handler, err := NewESDTDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilHandlerFunction, err)
+	})
+	t.Run("nil token minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockESDTDepositHandlerArgs()
+		args.TokenMinimumBalances["TKN-654321"] = nil
+		handler, err := NewESDTDepositHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrNilMinimumBalance))
+		assert.Contains(t, err.Error(), "TKN-654321")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewESDTDepositHandler(createMockESDTDepositHandlerArgs())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestESDTDepositHandler_ProcessHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	scrFromContract := &transaction.ApiSmartContractResult{
+		Hash:           "scrHash",
+		SndAddr:        contractAddress,
+		RcvAddr:        trackedAddress,
+		Data:           string(createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(300))),
+		OriginalTxHash: "txHash",
+	}
+
+	testCases := []struct {
+		name                       string
+		defaultTokenMinimumBalance *big.Int
+		txs                        []data.TransactionOnNetwork
+		expectedDeposits           []data.TokenDeposit
+	}{
+		{
+			name: "ESDTTransfer above the token minimum balance should be reported",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(100)),
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          untrackedAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "TKN-123456",
+					Amount:          big.NewInt(100),
+					TxHash:          "txHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "ESDTTransfer below the token minimum balance should be ignored",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(99)),
+				},
+			},
+		},
+		{
+			name: "ESDTTransfer of a token without minimum balance should be ignored without a default",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("OTHER-123456"), bigBytes(1000000)),
+				},
+			},
+		},
+		{
+			name:                       "ESDTTransfer of a token without minimum balance should use the default",
+			defaultTokenMinimumBalance: big.NewInt(500),
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash1",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("OTHER-123456"), bigBytes(499)),
+				},
+				{
+					Hash:     "txHash2",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("OTHER-123456"), bigBytes(500)),
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          untrackedAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "OTHER-123456",
+					Amount:          big.NewInt(500),
+					TxHash:          "txHash2",
+					OriginalTxHash:  "txHash2",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "ESDTTransfer to an untracked address should be ignored",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   trackedAddress,
+					Receiver: untrackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(1000)),
+				},
+			},
+		},
+		{
+			name: "ESDTNFTTransfer sent to self should be reported for the destination",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   untrackedAddress,
+					Receiver: untrackedAddress,
+					Data:     createCallData("ESDTNFTTransfer", []byte("NFT-123456"), bigBytes(7), bigBytes(1), addressBytes(trackedAddress)),
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          untrackedAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "NFT-123456",
+					Nonce:           7,
+					Amount:          big.NewInt(1),
+					TxHash:          "txHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "MultiESDTNFTTransfer sent to self should report the payments above their minimum balances",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "txHash",
+					Sender:   untrackedAddress,
+					Receiver: untrackedAddress,
+					Data: createCallData("MultiESDTNFTTransfer", addressBytes(trackedAddress), bigBytes(3),
+						[]byte("TKN-123456"), nil, bigBytes(150),
+						[]byte("NFT-123456"), bigBytes(2), bigBytes(1),
+						[]byte("TKN-123456"), nil, bigBytes(50),
+					),
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          untrackedAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "TKN-123456",
+					Amount:          big.NewInt(150),
+					TxHash:          "txHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+				{
+					Sender:          untrackedAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "NFT-123456",
+					Nonce:           2,
+					Amount:          big.NewInt(1),
+					TxHash:          "txHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "smart contract result sent by a contract should be reported once",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:      "txHash",
+					Sender:    untrackedAddress,
+					Receiver:  contractAddress,
+					Data:      []byte("claim"),
+					ScResults: []*transaction.ApiSmartContractResult{nil, scrFromContract},
+				},
+				{
+					Hash:                    "scrHash",
+					Sender:                  contractAddress,
+					Receiver:                trackedAddress,
+					Data:                    []byte(scrFromContract.Data),
+					OriginalTransactionHash: "txHash",
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          contractAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "TKN-123456",
+					Amount:          big.NewInt(300),
+					TxHash:          "scrHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "smart contract result provided only as unsigned transaction should be reported",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:                    "scrHash",
+					Sender:                  contractAddress,
+					Receiver:                trackedAddress,
+					Data:                    []byte(scrFromContract.Data),
+					OriginalTransactionHash: "txHash",
+				},
+			},
+			expectedDeposits: []data.TokenDeposit{
+				{
+					Sender:          contractAddress,
+					Receiver:        trackedAddress,
+					TokenIdentifier: "TKN-123456",
+					Amount:          big.NewInt(300),
+					TxHash:          "scrHash",
+					OriginalTxHash:  "txHash",
+					HyperBlockNonce: 37,
+				},
+			},
+		},
+		{
+			name: "smart contract result sent on behalf of a user should be ignored",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:                    "scrHash",
+					Sender:                  untrackedAddress,
+					Receiver:                trackedAddress,
+					Data:                    createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(300)),
+					OriginalTransactionHash: "txHash",
+				},
+			},
+		},
+		{
+			name: "failed and invalid transactions should be ignored",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "failedTxHash",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(1000)),
+					Status:   string(transaction.TxStatusFail),
+				},
+				{
+					Hash:     "invalidTxHash",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456"), bigBytes(1000)),
+					Status:   string(transaction.TxStatusInvalid),
+				},
+			},
+		},
+		{
+			name: "malformed transfers should be ignored",
+			txs: []data.TransactionOnNetwork{
+				{
+					Hash:     "plain note",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     []byte("ESDTTransfer@not hex"),
+				},
+				{
+					Hash:     "missing amount",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data:     createCallData("ESDTTransfer", []byte("TKN-123456")),
+				},
+				{
+					Hash:     "overflowing number of transfers",
+					Sender:   untrackedAddress,
+					Receiver: trackedAddress,
+					Data: createCallData("MultiESDTNFTTransfer", []byte{0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x56},
+						[]byte("TKN-123456"), nil, bigBytes(150)),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			deposits := make([]data.TokenDeposit, 0)
+			args := createMockESDTDepositHandlerArgs()
+			args.DefaultTokenMinimumBalance = testCase.defaultTokenMinimumBalance
+			args.Handler = func(deposit data.TokenDeposit) {
+				deposits = append(deposits, deposit)
+			}
+			handler, err := NewESDTDepositHandler(args)
+			require.Nil(t, err)
+
+			block := &data.HyperBlock{Nonce: 37, Transactions: testCase.txs}
+			assert.Nil(t, handler.ProcessHyperBlock(context.Background(), block))
+			for idx := range block.Transactions {
+				assert.Nil(t, handler.ProcessTransaction(context.Background(), block, &block.Transactions[idx]))
+			}
+
+			expectedDeposits := testCase.expectedDeposits
+			if expectedDeposits == nil {
+				expectedDeposits = make([]data.TokenDeposit, 0)
+			}
+			assert.Equal(t, expectedDeposits, deposits)
+		})
+	}
+}
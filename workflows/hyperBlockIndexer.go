@@ -0,0 +1,231 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minFetchConcurrency = 1
+
+// HyperBlockIndexerArgs is the argument DTO for the NewHyperBlockIndexer constructor function
+type HyperBlockIndexerArgs struct {
+	Proxy ProxyHandler
+	// NonceHandler is the cursor of the indexer, holding the last hyper block nonce processed by all handlers
+	NonceHandler     LastProcessedNonceHandler
+	FinalityProvider FinalityProvider
+	// Handlers are called in the provided order for each hyper block
+	Handlers      []HyperBlockHandler
+	CheckInterval time.Duration
+	// FetchConcurrency is the number of hyper blocks fetched in parallel while catching up with the network
+	FetchConcurrency int
+	// ConfirmationBlocks is the number of hyper blocks the indexer stays behind the latest one
+	ConfirmationBlocks uint64
+	// AllowedDeltaToFinal is the maximum nonces delta used when checking the finalization of the shards
+	AllowedDeltaToFinal uint64
+}
+
+// hyperBlockIndexer fetches the hyper blocks in order, starting with the one following the last processed nonce,
+// and passes each of them, along with its transactions, through the handlers chain. The last processed nonce is
+// saved after each hyper block handled without errors
+type hyperBlockIndexer struct {
+	proxy               ProxyHandler
+	nonceHandler        LastProcessedNonceHandler
+	finalityProvider    FinalityProvider
+	handlers            []HyperBlockHandler
+	checkInterval       time.Duration
+	fetchConcurrency    int
+	confirmationBlocks  uint64
+	allowedDeltaToFinal uint64
+	cancelFunc          func()
+}
+
+// NewHyperBlockIndexer will create a new hyperBlockIndexer instance. It automatically starts an inner
+// processLoop go routine that can be stopped by calling the Close method
+func NewHyperBlockIndexer(args HyperBlockIndexerArgs) (*hyperBlockIndexer, error) {
+	err := checkHyperBlockIndexerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	indexer := &hyperBlockIndexer{
+		proxy:               args.Proxy,
+		nonceHandler:        args.NonceHandler,
+		finalityProvider:    args.FinalityProvider,
+		handlers:            append(make([]HyperBlockHandler, 0, len(args.Handlers)), args.Handlers...),
+		checkInterval:       args.CheckInterval,
+		fetchConcurrency:    args.FetchConcurrency,
+		confirmationBlocks:  args.ConfirmationBlocks,
+		allowedDeltaToFinal: args.AllowedDeltaToFinal,
+	}
+
+	var ctx context.Context
+	ctx, indexer.cancelFunc = context.WithCancel(context.Background())
+	go indexer.processLoop(ctx)
+
+	return indexer, nil
+}
+
+func checkHyperBlockIndexerArgs(args HyperBlockIndexerArgs) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.NonceHandler) {
+		return ErrNilLastProcessedNonceHandler
+	}
+	if check.IfNil(args.FinalityProvider) {
+		return ErrNilFinalityProvider
+	}
+	if len(args.Handlers) == 0 {
+		return ErrNilHyperBlockHandler
+	}
+	for idx, handler := range args.Handlers {
+		if check.IfNil(handler) {
+			return fmt.Errorf("%w at index %d", ErrNilHyperBlockHandler, idx)
+		}
+	}
+	if args.FetchConcurrency < minFetchConcurrency {
+		return fmt.Errorf("%w for FetchConcurrency, minimum %d, provided %d", ErrInvalidValue, minFetchConcurrency, args.FetchConcurrency)
+	}
+	if args.AllowedDeltaToFinal < sdkCore.MinAllowedDeltaToFinal {
+		return fmt.Errorf("%w for AllowedDeltaToFinal, minimum %d, provided %d", ErrInvalidValue, sdkCore.MinAllowedDeltaToFinal, args.AllowedDeltaToFinal)
+	}
+
+	return nil
+}
+
+func (indexer *hyperBlockIndexer) processLoop(ctx context.Context) {
+	log.Debug("hyperBlockIndexer.processLoop started")
+
+	timer := time.NewTimer(indexer.checkInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(indexer.checkInterval)
+
+		select {
+		case <-timer.C:
+			err := indexer.processAvailableHyperBlocks(ctx)
+			log.LogIfError(err)
+		case <-ctx.Done():
+			log.Debug("terminating hyperBlockIndexer.processLoop...")
+			return
+		}
+	}
+}
+
+func (indexer *hyperBlockIndexer) processAvailableHyperBlocks(ctx context.Context) error {
+	networkNonce, err := indexer.proxy.GetLatestHyperBlockNonce(ctx)
+	if err != nil {
+		return err
+	}
+	if networkNonce < indexer.confirmationBlocks {
+		return nil
+	}
+	targetNonce := networkNonce - indexer.confirmationBlocks
+
+	for nonce := indexer.nonceHandler.GetLastProcessedNonce() + 1; nonce <= targetNonce; {
+		lastNonce := nonce + uint64(indexer.fetchConcurrency) - 1
+		if lastNonce > targetNonce {
+			lastNonce = targetNonce
+		}
+
+		blocks, errFetch := indexer.fetchHyperBlocks(ctx, nonce, lastNonce)
+		for _, block := range blocks {
+			isFinal, errProcess := indexer.processHyperBlock(ctx, block)
+			if errProcess != nil {
+				return errProcess
+			}
+			if !isFinal {
+				return nil
+			}
+
+			indexer.nonceHandler.ProcessedNonce(block.Nonce)
+		}
+		if errFetch != nil {
+			return errFetch
+		}
+
+		nonce = lastNonce + 1
+	}
+
+	return nil
+}
+
+// fetchHyperBlocks fetches in parallel the hyper blocks in the provided range. On error, the hyper blocks fetched
+// before the failed one are returned along with the error, so they can still be processed
+func (indexer *hyperBlockIndexer) fetchHyperBlocks(ctx context.Context, firstNonce uint64, lastNonce uint64) ([]*data.HyperBlock, error) {
+	blocks := make([]*data.HyperBlock, lastNonce-firstNonce+1)
+	errs := make([]error, len(blocks))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(blocks))
+	for idx := range blocks {
+		go func(idx int) {
+			defer wg.Done()
+
+			nonce := firstNonce + uint64(idx)
+			blocks[idx], errs[idx] = indexer.proxy.GetHyperBlockByNonce(ctx, nonce)
+			if errs[idx] == nil && blocks[idx] == nil {
+				errs[idx] = fmt.Errorf("%w for nonce %d", ErrNilHyperBlock, nonce)
+			}
+		}(idx)
+	}
+	wg.Wait()
+
+	for idx, err := range errs {
+		if err != nil {
+			return blocks[:idx], err
+		}
+	}
+
+	return blocks, nil
+}
+
+// processHyperBlock passes the hyper block through the handlers chain. It returns false, without calling the handlers,
+// if one of the shards notarized in the hyper block is not final yet
+func (indexer *hyperBlockIndexer) processHyperBlock(ctx context.Context, block *data.HyperBlock) (bool, error) {
+	for _, shardBlock := range block.ShardBlocks {
+		err := indexer.finalityProvider.CheckShardFinalization(ctx, shardBlock.Shard, indexer.allowedDeltaToFinal)
+		if err != nil {
+			log.Debug("hyper block is not final yet, will retry", "nonce", block.Nonce, "shard", shardBlock.Shard, "reason", err)
+			return false, nil
+		}
+	}
+
+	for _, handler := range indexer.handlers {
+		err := handler.ProcessHyperBlock(ctx, block)
+		if err != nil {
+			return false, fmt.Errorf("%w while processing hyper block %d", err, block.Nonce)
+		}
+
+		for idx := range block.Transactions {
+			err = handler.ProcessTransaction(ctx, block, &block.Transactions[idx])
+			if err != nil {
+				return false, fmt.Errorf("%w while processing transaction %s from hyper block %d",
+					err, block.Transactions[idx].Hash, block.Nonce)
+			}
+		}
+	}
+
+	log.Debug("processed hyper block", "nonce", block.Nonce, "hash", block.Hash, "num txs", block.NumTxs)
+
+	return true, nil
+}
+
+// Close will close the process loop go routine
+func (indexer *hyperBlockIndexer) Close() error {
+	indexer.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (indexer *hyperBlockIndexer) IsInterfaceNil() bool {
+	return indexer == nil
+}
@@ -0,0 +1,519 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nonceHandlerMock struct {
+	mut               sync.Mutex
+	lastNonce         uint64
+	checkpointsNonces []uint64
+}
+
+func (mock *nonceHandlerMock) ProcessedNonce(nonce uint64) {
+	mock.mut.Lock()
+	mock.lastNonce = nonce
+	mock.checkpointsNonces = append(mock.checkpointsNonces, nonce)
+	mock.mut.Unlock()
+}
+
+func (mock *nonceHandlerMock) GetLastProcessedNonce() uint64 {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	return mock.lastNonce
+}
+
+func (mock *nonceHandlerMock) checkpoints() []uint64 {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	return append(make([]uint64, 0, len(mock.checkpointsNonces)), mock.checkpointsNonces...)
+}
+
+func (mock *nonceHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
+
+// createHyperBlock creates a hyper block notarizing a block of each provided shard, with a transaction for each
+// provided hash
+func createHyperBlock(nonce uint64, shards []uint32, txHashes ...string) *data.HyperBlock {
+	shardBlocks := make([]map[string]interface{}, 0, len(shards))
+	for _, shard := range shards {
+		shardBlocks = append(shardBlocks, map[string]interface{}{"shard": shard})
+	}
+	buff, _ := json.Marshal(map[string]interface{}{
+		"nonce":       nonce,
+		"hash":        fmt.Sprintf("hash%d", nonce),
+		"shardBlocks": shardBlocks,
+	})
+
+	block := &data.HyperBlock{}
+	_ = json.Unmarshal(buff, block)
+	for _, txHash := range txHashes {
+		block.Transactions = append(block.Transactions, data.TransactionOnNetwork{Hash: txHash})
+	}
+
+	return block
+}
+
+func createMockHyperBlockIndexerArgs() HyperBlockIndexerArgs {
+	return HyperBlockIndexerArgs{
+		Proxy:               &testsCommon.ProxyStub{},
+		NonceHandler:        &nonceHandlerMock{},
+		FinalityProvider:    &testsCommon.FinalityProviderStub{},
+		Handlers:            []HyperBlockHandler{&testsCommon.HyperBlockHandlerStub{}},
+		CheckInterval:       time.Hour,
+		FetchConcurrency:    1,
+		AllowedDeltaToFinal: sdkCore.MinAllowedDeltaToFinal,
+	}
+}
+
+// createIndexer creates an indexer whose process loop does not interfere with the test, the hyper blocks being
+// processed by calling processAvailableHyperBlocks
+func createIndexer(t *testing.T, args HyperBlockIndexerArgs) *hyperBlockIndexer {
+	indexer, err := NewHyperBlockIndexer(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = indexer.Close()
+	})
+
+	return indexer
+}
+
+func createProxyWithHyperBlocks(latestNonce uint64, fetchedNonces *[]uint64, mut *sync.Mutex) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return latestNonce, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			mut.Lock()
+			*fetchedNonces = append(*fetchedNonces, nonce)
+			mut.Unlock()
+
+			return createHyperBlock(nonce, []uint32{0}), nil
+		},
+	}
+}
+
+func TestNewHyperBlockIndexer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = nil
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.NonceHandler = nil
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.Equal(t, ErrNilLastProcessedNonceHandler, err)
+	})
+	t.Run("nil finality provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.FinalityProvider = nil
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.Equal(t, ErrNilFinalityProvider, err)
+	})
+	t.Run("no handlers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.Handlers = nil
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.Equal(t, ErrNilHyperBlockHandler, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.Handlers = append(args.Handlers, nil)
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.True(t, errors.Is(err, ErrNilHyperBlockHandler))
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("invalid fetch concurrency should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.FetchConcurrency = 0
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "FetchConcurrency")
+	})
+	t.Run("invalid allowed delta to final should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.AllowedDeltaToFinal = sdkCore.MinAllowedDeltaToFinal - 1
+		indexer, err := NewHyperBlockIndexer(args)
+		assert.True(t, check.IfNil(indexer))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "AllowedDeltaToFinal")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, err := NewHyperBlockIndexer(createMockHyperBlockIndexerArgs())
+		assert.False(t, check.IfNil(indexer))
+		assert.Nil(t, err)
+		assert.Nil(t, indexer.Close())
+	})
+}
+
+func TestHyperBlockIndexer_ProcessAvailableHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("should resume from the last processed nonce", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedNonces := make([]uint64, 0)
+		processedNonces := make([]uint64, 0)
+		nonceHandler := &nonceHandlerMock{lastNonce: 5}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = createProxyWithHyperBlocks(8, &fetchedNonces, mut)
+		args.NonceHandler = nonceHandler
+		args.FetchConcurrency = 2
+		args.Handlers = []HyperBlockHandler{
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					processedNonces = append(processedNonces, block.Nonce)
+					return nil
+				},
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{6, 7, 8}, fetchedNonces)
+		assert.Equal(t, []uint64{6, 7, 8}, processedNonces)
+		assert.Equal(t, []uint64{6, 7, 8}, nonceHandler.checkpoints())
+
+		err = indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(fetchedNonces))
+		assert.Equal(t, []uint64{6, 7, 8}, processedNonces)
+	})
+	t.Run("should stay behind the latest hyper block with the confirmation blocks", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedNonces := make([]uint64, 0)
+		nonceHandler := &nonceHandlerMock{lastNonce: 5}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = createProxyWithHyperBlocks(8, &fetchedNonces, mut)
+		args.NonceHandler = nonceHandler
+		args.ConfirmationBlocks = 2
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{6}, fetchedNonces)
+		assert.Equal(t, []uint64{6}, nonceHandler.checkpoints())
+	})
+	t.Run("should do nothing while the network is below the confirmation blocks", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedNonces := make([]uint64, 0)
+		nonceHandler := &nonceHandlerMock{}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = createProxyWithHyperBlocks(2, &fetchedNonces, mut)
+		args.NonceHandler = nonceHandler
+		args.ConfirmationBlocks = 3
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, fetchedNonces)
+		assert.Empty(t, nonceHandler.checkpoints())
+	})
+	t.Run("should call the handlers in the provided order", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make([]string, 0)
+		createHandler := func(name string) HyperBlockHandler {
+			return &testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					calls = append(calls, fmt.Sprintf("%s block %d", name, block.Nonce))
+					return nil
+				},
+				ProcessTransactionCalled: func(ctx context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error {
+					calls = append(calls, fmt.Sprintf("%s tx %s", name, tx.Hash))
+					return nil
+				},
+			}
+		}
+
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 1, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return createHyperBlock(nonce, []uint32{0, 1}, "a", "b"), nil
+			},
+		}
+		args.Handlers = []HyperBlockHandler{createHandler("first"), createHandler("second")}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		expectedCalls := []string{
+			"first block 1", "first tx a", "first tx b",
+			"second block 1", "second tx a", "second tx b",
+		}
+		assert.Equal(t, expectedCalls, calls)
+	})
+	t.Run("handler error should not save the checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		mut := &sync.Mutex{}
+		fetchedNonces := make([]uint64, 0)
+		nonceHandler := &nonceHandlerMock{}
+		shouldFail := true
+		numSecondHandlerCalls := 0
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = createProxyWithHyperBlocks(3, &fetchedNonces, mut)
+		args.NonceHandler = nonceHandler
+		args.Handlers = []HyperBlockHandler{
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					if block.Nonce == 2 && shouldFail {
+						return expectedErr
+					}
+					return nil
+				},
+			},
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					numSecondHandlerCalls++
+					return nil
+				},
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "hyper block 2")
+		assert.Equal(t, []uint64{1}, nonceHandler.checkpoints())
+		assert.Equal(t, 1, numSecondHandlerCalls)
+
+		shouldFail = false
+		err = indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 2, 3}, fetchedNonces)
+		assert.Equal(t, []uint64{1, 2, 3}, nonceHandler.checkpoints())
+		assert.Equal(t, 3, numSecondHandlerCalls)
+	})
+	t.Run("transaction handler error should not save the checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		nonceHandler := &nonceHandlerMock{}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 1, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return createHyperBlock(nonce, []uint32{0}, "a", "b"), nil
+			},
+		}
+		args.NonceHandler = nonceHandler
+		args.Handlers = []HyperBlockHandler{
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessTransactionCalled: func(ctx context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error {
+					if tx.Hash == "b" {
+						return expectedErr
+					}
+					return nil
+				},
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "transaction b")
+		assert.Empty(t, nonceHandler.checkpoints())
+	})
+	t.Run("fetch error should process in order the hyper blocks fetched before the failed one", func(t *testing.T) {
+		t.Parallel()
+
+		processedNonces := make([]uint64, 0)
+		nonceHandler := &nonceHandlerMock{lastNonce: 10}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 20, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				if nonce == 13 {
+					return nil, expectedErr
+				}
+				if nonce < 13 {
+					// the first hyper blocks are fetched last, still being processed in order
+					time.Sleep(time.Millisecond * time.Duration(13-nonce) * 10)
+				}
+
+				return createHyperBlock(nonce, []uint32{0}), nil
+			},
+		}
+		args.NonceHandler = nonceHandler
+		args.FetchConcurrency = 5
+		args.Handlers = []HyperBlockHandler{
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					processedNonces = append(processedNonces, block.Nonce)
+					return nil
+				},
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, []uint64{11, 12}, processedNonces)
+		assert.Equal(t, []uint64{11, 12}, nonceHandler.checkpoints())
+	})
+	t.Run("nil fetched hyper block should error", func(t *testing.T) {
+		t.Parallel()
+
+		nonceHandler := &nonceHandlerMock{}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 2, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				if nonce == 2 {
+					return nil, nil
+				}
+				return createHyperBlock(nonce, []uint32{0}), nil
+			},
+		}
+		args.NonceHandler = nonceHandler
+		args.FetchConcurrency = 2
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.True(t, errors.Is(err, ErrNilHyperBlock))
+		assert.Equal(t, []uint64{1}, nonceHandler.checkpoints())
+	})
+	t.Run("should stop without saving the checkpoint when a shard is not final", func(t *testing.T) {
+		t.Parallel()
+
+		processedNonces := make([]uint64, 0)
+		checkedShards := make([]uint32, 0)
+		nonceHandler := &nonceHandlerMock{}
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 3, nil
+			},
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				if nonce == 2 {
+					return createHyperBlock(nonce, []uint32{0, 1}), nil
+				}
+				return createHyperBlock(nonce, []uint32{0}), nil
+			},
+		}
+		args.NonceHandler = nonceHandler
+		args.AllowedDeltaToFinal = 4
+		args.FinalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				assert.Equal(t, uint64(4), maxNoncesDelta)
+				checkedShards = append(checkedShards, targetShardID)
+				if targetShardID == 1 {
+					return expectedErr
+				}
+				return nil
+			},
+		}
+		args.Handlers = []HyperBlockHandler{
+			&testsCommon.HyperBlockHandlerStub{
+				ProcessHyperBlockCalled: func(ctx context.Context, block *data.HyperBlock) error {
+					processedNonces = append(processedNonces, block.Nonce)
+					return nil
+				},
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{1}, processedNonces)
+		assert.Equal(t, []uint64{1}, nonceHandler.checkpoints())
+		assert.Equal(t, []uint32{0, 0, 1}, checkedShards)
+	})
+	t.Run("latest nonce error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHyperBlockIndexerArgs()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		indexer := createIndexer(t, args)
+
+		err := indexer.processAvailableHyperBlocks(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestHyperBlockIndexer_ProcessLoopShouldProcessTheHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	nonceHandler := &nonceHandlerMock{}
+	args := createMockHyperBlockIndexerArgs()
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 3, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			return createHyperBlock(nonce, []uint32{0}), nil
+		},
+	}
+	args.NonceHandler = nonceHandler
+	args.CheckInterval = time.Millisecond * 10
+	indexer := createIndexer(t, args)
+
+	require.Eventually(t, func() bool {
+		return nonceHandler.GetLastProcessedNonce() == 3
+	}, time.Second*5, time.Millisecond*10)
+	assert.Nil(t, indexer.Close())
+	assert.Equal(t, []uint64{1, 2, 3}, nonceHandler.checkpoints())
+}
@@ -38,3 +38,18 @@ type TransactionInteractor interface {
 	ApplySignature(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
 	IsInterfaceNil() bool
 }
+
+// FinalityProvider is able to check the shard finalization status
+type FinalityProvider interface {
+	CheckShardFinalization(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error
+	IsInterfaceNil() bool
+}
+
+// HyperBlockHandler defines a step of the hyper block indexer handlers chain. A hyper block is provided first as a
+// whole, then transaction by transaction. The handlers should be idempotent since a hyper block is provided again
+// when one of the handlers returned an error or when the application restarted before its checkpoint
+type HyperBlockHandler interface {
+	ProcessHyperBlock(ctx context.Context, block *data.HyperBlock) error
+	ProcessTransaction(ctx context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,98 @@
+package workflows
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// LogEventsHandlerArgs is the argument DTO for the NewLogEventsHandler constructor function
+type LogEventsHandlerArgs struct {
+	// Identifiers filters the log events, an empty list matching all of them
+	Identifiers []string
+	// Handler is called for each matching log event emitted by a transaction or by one of its smart contract results
+	Handler func(block *data.HyperBlock, tx *data.TransactionOnNetwork, event *transaction.Events) error
+}
+
+// logEventsHandler is the hyper block indexer plugin providing the log events of the transactions
+type logEventsHandler struct {
+	identifiers map[string]struct{}
+	handler     func(block *data.HyperBlock, tx *data.TransactionOnNetwork, event *transaction.Events) error
+}
+
+// NewLogEventsHandler will create a new logEventsHandler instance
+func NewLogEventsHandler(args LogEventsHandlerArgs) (*logEventsHandler, error) {
+	if args.Handler == nil {
+		return nil, ErrNilHandlerFunction
+	}
+
+	identifiers := make(map[string]struct{}, len(args.Identifiers))
+	for _, identifier := range args.Identifiers {
+		identifiers[identifier] = struct{}{}
+	}
+
+	return &logEventsHandler{
+		identifiers: identifiers,
+		handler:     args.Handler,
+	}, nil
+}
+
+// ProcessHyperBlock does nothing, the log events being provided on each transaction
+func (leh *logEventsHandler) ProcessHyperBlock(_ context.Context, _ *data.HyperBlock) error {
+	return nil
+}
+
+// ProcessTransaction calls the handler for the matching log events of the transaction and of its smart contract results
+func (leh *logEventsHandler) ProcessTransaction(_ context.Context, block *data.HyperBlock, tx *data.TransactionOnNetwork) error {
+	err := leh.processLogs(block, tx, tx.Logs)
+	if err != nil {
+		return err
+	}
+
+	for _, scr := range tx.ScResults {
+		if scr == nil {
+			continue
+		}
+
+		err = leh.processLogs(block, tx, scr.Logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (leh *logEventsHandler) processLogs(block *data.HyperBlock, tx *data.TransactionOnNetwork, logs *transaction.ApiLogs) error {
+	if logs == nil {
+		return nil
+	}
+
+	for _, event := range logs.Events {
+		if event == nil || !leh.isMatching(event.Identifier) {
+			continue
+		}
+
+		err := leh.handler(block, tx, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (leh *logEventsHandler) isMatching(identifier string) bool {
+	if len(leh.identifiers) == 0 {
+		return true
+	}
+
+	_, found := leh.identifiers[identifier]
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (leh *logEventsHandler) IsInterfaceNil() bool {
+	return leh == nil
+}
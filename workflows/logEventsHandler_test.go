@@ -0,0 +1,139 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTransactionWithLogs() *data.TransactionOnNetwork {
+	return &data.TransactionOnNetwork{
+		Hash: "txHash",
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{Identifier: "ESDTTransfer", Data: []byte("tx event 1")},
+				nil,
+				{Identifier: "writeLog", Data: []byte("tx event 2")},
+			},
+		},
+		ScResults: []*transaction.ApiSmartContractResult{
+			nil,
+			{
+				Hash: "scr without logs",
+			},
+			{
+				Hash: "scrHash",
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Identifier: "ESDTTransfer", Data: []byte("scr event")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewLogEventsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewLogEventsHandler(LogEventsHandlerArgs{})
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilHandlerFunction, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewLogEventsHandler(LogEventsHandlerArgs{
+			Handler: func(block *data.HyperBlock, tx *data.TransactionOnNetwork, event *transaction.Events) error {
+				return nil
+			},
+		})
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLogEventsHandler_ProcessTransaction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		identifiers    []string
+		expectedEvents []string
+	}{
+		{
+			name:           "no identifiers should provide all the events",
+			expectedEvents: []string{"tx event 1", "tx event 2", "scr event"},
+		},
+		{
+			name:           "should provide the matching events of the transaction and of its results",
+			identifiers:    []string{"ESDTTransfer"},
+			expectedEvents: []string{"tx event 1", "scr event"},
+		},
+		{
+			name:           "should provide nothing if no event is matching",
+			identifiers:    []string{"ESDTNFTTransfer"},
+			expectedEvents: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			block := &data.HyperBlock{Nonce: 7}
+			tx := createTransactionWithLogs()
+			providedEvents := make([]string, 0)
+			handler, _ := NewLogEventsHandler(LogEventsHandlerArgs{
+				Identifiers: testCase.identifiers,
+				Handler: func(providedBlock *data.HyperBlock, providedTx *data.TransactionOnNetwork, event *transaction.Events) error {
+					assert.True(t, block == providedBlock)
+					assert.True(t, tx == providedTx)
+					providedEvents = append(providedEvents, string(event.Data))
+					return nil
+				},
+			})
+
+			assert.Nil(t, handler.ProcessHyperBlock(context.Background(), block))
+			assert.Nil(t, handler.ProcessTransaction(context.Background(), block, tx))
+			assert.Equal(t, testCase.expectedEvents, providedEvents)
+		})
+	}
+}
+
+func TestLogEventsHandler_ProcessTransactionHandlerErrorShouldStop(t *testing.T) {
+	t.Parallel()
+
+	for _, failingEvent := range []string{"tx event 1", "scr event"} {
+		failingEvent := failingEvent
+		t.Run(fmt.Sprintf("failing on %s", failingEvent), func(t *testing.T) {
+			t.Parallel()
+
+			expectedErr := errors.New("expected error")
+			providedEvents := make([]string, 0)
+			handler, _ := NewLogEventsHandler(LogEventsHandlerArgs{
+				Handler: func(block *data.HyperBlock, tx *data.TransactionOnNetwork, event *transaction.Events) error {
+					providedEvents = append(providedEvents, string(event.Data))
+					if string(event.Data) == failingEvent {
+						return expectedErr
+					}
+					return nil
+				},
+			})
+
+			err := handler.ProcessTransaction(context.Background(), &data.HyperBlock{}, createTransactionWithLogs())
+			assert.Equal(t, expectedErr, err)
+			assert.Equal(t, failingEvent, providedEvents[len(providedEvents)-1])
+		})
+	}
+}
@@ -8,11 +8,11 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
 	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
 )
 
 var (
@@ -135,7 +135,12 @@ func (mbh *moveBalanceHandler) generateTransaction(ctx context.Context, address
 	tx.Data = nil
 	tx.Receiver = mbh.receiverAddress
 
-	value := availableBalance.Sub(availableBalance, mbh.computeTxFee(networkConfigs, tx))
+	txFee, err := interactors.ComputeTxFee(networkConfigs, &tx)
+	if err != nil {
+		return err
+	}
+
+	value := availableBalance.Sub(availableBalance, txFee)
 	tx.Value = value.String()
 
 	skBytes := mbh.trackableAddressesProvider.PrivateKeyOfBech32Address(address)
@@ -156,19 +161,6 @@ func (mbh *moveBalanceHandler) generateTransaction(ctx context.Context, address
 	return nil
 }
 
-func (mbh *moveBalanceHandler) computeTxFee(networkConfigs *data.NetworkConfig, tx transaction.FrontendTransaction) *big.Int {
-	// this implementation should change if more complex transactions should be generated
-	// if the transaction is required to do a smart contract call, wrap a transaction using the relay mechanism
-	// or do an ESDT/SFT/NFT operation, then we need to query the proxy's `/transaction/cost` endpoint route
-	// in order to get the correct gas limit
-
-	gasLimit := networkConfigs.MinGasLimit + uint64(len(tx.Data))*networkConfigs.GasPerDataByte
-	result := big.NewInt(int64(tx.GasPrice))
-	result.Mul(result, big.NewInt(int64(gasLimit)))
-
-	return result
-}
-
 // IsInterfaceNil returns true if there is no value under the interface
 func (mbh *moveBalanceHandler) IsInterfaceNil() bool {
 	return mbh == nil
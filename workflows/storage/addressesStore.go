@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/interactors"
+)
+
+const trackedAddressKeyPrefix = "trackedAddress_"
+
+type keyEncryptor interface {
+	GetAddressFromPrivateKey(privateKeyBytes []byte) (sdkCore.AddressHandler, error)
+	EncryptPrivateKey(privateKey []byte, password string) ([]byte, error)
+	DecryptPrivateKey(buff []byte, password string) ([]byte, error)
+}
+
+// ArgsAddressesStore is the DTO used in the tracked addresses store constructor
+type ArgsAddressesStore struct {
+	Store KeyValueStore
+	// Password encrypts the private keys of the tracked addresses, stored in the password encrypted .json format
+	Password string
+}
+
+type addressesStore struct {
+	store    KeyValueStore
+	password string
+	wallet   keyEncryptor
+
+	mut       sync.RWMutex
+	addresses map[string][]byte
+}
+
+// NewAddressesStore creates a TrackableAddressesProvider persisting the tracked addresses and their encrypted private
+// keys in the store. The private keys are decrypted only when requested
+func NewAddressesStore(args ArgsAddressesStore) (*addressesStore, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStore
+	}
+	if len(args.Password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+
+	addresses := make(map[string][]byte)
+	err := args.Store.RangeKeys(func(key []byte, value []byte) bool {
+		if strings.HasPrefix(string(key), trackedAddressKeyPrefix) {
+			addresses[strings.TrimPrefix(string(key), trackedAddressKeyPrefix)] = value
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &addressesStore{
+		store:     args.Store,
+		password:  args.Password,
+		wallet:    interactors.NewWallet(),
+		addresses: addresses,
+	}, nil
+}
+
+// AddPrivateKey encrypts and stores the private key, tracking its address. It returns the tracked address
+func (as *addressesStore) AddPrivateKey(privateKey []byte) (string, error) {
+	address, err := as.wallet.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	bech32Address := address.AddressAsBech32String()
+
+	encryptedKey, err := as.wallet.EncryptPrivateKey(privateKey, as.password)
+	if err != nil {
+		return "", err
+	}
+
+	as.mut.Lock()
+	defer as.mut.Unlock()
+
+	err = as.store.Put([]byte(trackedAddressKeyPrefix+bech32Address), encryptedKey)
+	if err != nil {
+		return "", err
+	}
+	as.addresses[bech32Address] = encryptedKey
+
+	return bech32Address, nil
+}
+
+// RemoveAddress stops tracking the address, removing its private key from the store
+func (as *addressesStore) RemoveAddress(addressAsBech32 string) error {
+	as.mut.Lock()
+	defer as.mut.Unlock()
+
+	err := as.store.Remove([]byte(trackedAddressKeyPrefix + addressAsBech32))
+	if err != nil {
+		return err
+	}
+	delete(as.addresses, addressAsBech32)
+
+	return nil
+}
+
+// IsTrackableAddresses returns true if the address is tracked
+func (as *addressesStore) IsTrackableAddresses(addressAsBech32 string) bool {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	_, found := as.addresses[addressAsBech32]
+
+	return found
+}
+
+// PrivateKeyOfBech32Address returns the decrypted private key of the tracked address, or nil if the address is not
+// tracked or its private key can not be decrypted
+func (as *addressesStore) PrivateKeyOfBech32Address(addressAsBech32 string) []byte {
+	as.mut.RLock()
+	encryptedKey, found := as.addresses[addressAsBech32]
+	as.mut.RUnlock()
+	if !found {
+		return nil
+	}
+
+	privateKey, err := as.wallet.DecryptPrivateKey(encryptedKey, as.password)
+	if err != nil {
+		log.Error("addressesStore.PrivateKeyOfBech32Address: error decrypting the private key",
+			"address", addressAsBech32, "error", err)
+		return nil
+	}
+
+	return privateKey
+}
+
+// AllTrackableAddresses returns the tracked addresses, sorted
+func (as *addressesStore) AllTrackableAddresses() []string {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	addresses := make([]string, 0, len(as.addresses))
+	for address := range as.addresses {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// Export writes the private keys of all the tracked addresses in a JSON file, as a list of password encrypted keys.
+// The keys are encrypted with the password of the store
+func (as *addressesStore) Export(filename string) error {
+	addresses := as.AllTrackableAddresses()
+
+	as.mut.RLock()
+	encryptedKeys := make([]json.RawMessage, 0, len(addresses))
+	for _, address := range addresses {
+		encryptedKey, found := as.addresses[address]
+		if found {
+			encryptedKeys = append(encryptedKeys, encryptedKey)
+		}
+	}
+	as.mut.RUnlock()
+
+	buff, err := json.MarshalIndent(encryptedKeys, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(filename, buff)
+}
+
+// Import reads a file written by Export, decrypting the private keys with the provided password, and tracks their
+// addresses. It returns the imported addresses
+func (as *addressesStore) Import(filename string, password string) ([]string, error) {
+	buff, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedKeys := make([]json.RawMessage, 0)
+	err = json.Unmarshal(buff, &encryptedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([][]byte, 0, len(encryptedKeys))
+	for idx, encryptedKey := range encryptedKeys {
+		privateKey, errDecrypt := as.wallet.DecryptPrivateKey(encryptedKey, password)
+		if errDecrypt != nil {
+			return nil, fmt.Errorf("%w for key at index %d", errDecrypt, idx)
+		}
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	addresses := make([]string, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		address, errAdd := as.AddPrivateKey(privateKey)
+		if errAdd != nil {
+			return addresses, errAdd
+		}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (as *addressesStore) IsInterfaceNil() bool {
+	return as == nil
+}
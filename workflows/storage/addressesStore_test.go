@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ workflows.TrackableAddressesProvider = (*addressesStore)(nil)
+var _ workflows.LastProcessedNonceHandler = (*nonceHandler)(nil)
+
+const (
+	testPassword   = "pAssword1~"
+	testPrivateKey = "15cfe2140ee9821f706423036ba58d1e6ec13dbc4ebf206732ad40b5236af403"
+	testAddress    = "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5"
+)
+
+func createAddressesStore(t *testing.T, store KeyValueStore) *addressesStore {
+	addresses, err := NewAddressesStore(ArgsAddressesStore{
+		Store:    store,
+		Password: testPassword,
+	})
+	require.Nil(t, err)
+
+	return addresses
+}
+
+func TestNewAddressesStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := NewAddressesStore(ArgsAddressesStore{Password: testPassword})
+		assert.True(t, check.IfNil(addresses))
+		assert.Equal(t, ErrNilStore, err)
+	})
+	t.Run("empty password should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := NewAddressesStore(ArgsAddressesStore{Store: createFileStore(t, createTempPath(t, "store.json"))})
+		assert.True(t, check.IfNil(addresses))
+		assert.Equal(t, ErrEmptyPassword, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := NewAddressesStore(ArgsAddressesStore{
+			Store:    createFileStore(t, createTempPath(t, "store.json")),
+			Password: testPassword,
+		})
+		assert.False(t, check.IfNil(addresses))
+		assert.Nil(t, err)
+		assert.Empty(t, addresses.AllTrackableAddresses())
+	})
+}
+
+func TestAddressesStore_ShouldPersistTheEncryptedKeys(t *testing.T) {
+	t.Parallel()
+
+	privateKey, _ := hex.DecodeString(testPrivateKey)
+	for name, factory := range storeFactories {
+		factory := factory
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := createTempPath(t, "store")
+			store := factory(t, path)
+			addresses := createAddressesStore(t, store)
+
+			address, err := addresses.AddPrivateKey(privateKey)
+			require.Nil(t, err)
+			assert.Equal(t, testAddress, address)
+			assert.True(t, addresses.IsTrackableAddresses(testAddress))
+			assert.Equal(t, privateKey, addresses.PrivateKeyOfBech32Address(testAddress))
+
+			encryptedKey, err := store.Get([]byte(trackedAddressKeyPrefix + testAddress))
+			require.Nil(t, err)
+			assert.False(t, strings.Contains(string(encryptedKey), testPrivateKey))
+			require.Nil(t, store.Close())
+
+			reopened := factory(t, path)
+			defer func() {
+				_ = reopened.Close()
+			}()
+			addresses = createAddressesStore(t, reopened)
+			assert.Equal(t, []string{testAddress}, addresses.AllTrackableAddresses())
+			assert.Equal(t, privateKey, addresses.PrivateKeyOfBech32Address(testAddress))
+
+			require.Nil(t, addresses.RemoveAddress(testAddress))
+			assert.False(t, addresses.IsTrackableAddresses(testAddress))
+			assert.Nil(t, addresses.PrivateKeyOfBech32Address(testAddress))
+		})
+	}
+}
+
+func TestAddressesStore_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	privateKey, _ := hex.DecodeString(testPrivateKey)
+	source := createAddressesStore(t, createFileStore(t, createTempPath(t, "source.json")))
+	_, err := source.AddPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	exportFilename := createTempPath(t, "export.json")
+	require.Nil(t, source.Export(exportFilename))
+	buff, err := ioutil.ReadFile(exportFilename)
+	require.Nil(t, err)
+	assert.False(t, strings.Contains(string(buff), testPrivateKey))
+
+	destination, err := NewAddressesStore(ArgsAddressesStore{
+		Store:    createFileStore(t, createTempPath(t, "destination.json")),
+		Password: "another password",
+	})
+	require.Nil(t, err)
+
+	imported, err := destination.Import(exportFilename, "wrong password")
+	assert.NotNil(t, err)
+	assert.Nil(t, imported)
+	assert.Empty(t, destination.AllTrackableAddresses())
+
+	imported, err = destination.Import(exportFilename, testPassword)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{testAddress}, imported)
+	assert.Equal(t, privateKey, destination.PrivateKeyOfBech32Address(testAddress))
+}
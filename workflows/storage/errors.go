@@ -0,0 +1,21 @@
+package storage
+
+import "errors"
+
+// ErrKeyNotFound signals that the key was not found in the store
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrEmptyPath signals that an empty path was provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrNilStore signals that a nil store was provided
+var ErrNilStore = errors.New("nil store")
+
+// ErrEmptyPassword signals that an empty password was provided
+var ErrEmptyPassword = errors.New("empty password")
+
+// ErrStoreClosed signals that the store was closed
+var ErrStoreClosed = errors.New("store closed")
+
+// ErrInvalidValue signals that an invalid value was read from the store
+var ErrInvalidValue = errors.New("invalid value")
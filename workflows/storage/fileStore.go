@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const storeFilePermissions = 0600
+
+type fileStore struct {
+	mut      sync.RWMutex
+	filename string
+	values   map[string][]byte
+	closed   bool
+}
+
+// NewFileStore creates a key-value store held in memory and persisted in a single JSON file, created if missing.
+// Each change rewrites the file atomically, by writing a temporary file in the same directory and renaming it, so the
+// file always holds the result of a complete change. It is suitable for small data sets like a nonce or a few
+// thousand tracked addresses
+func NewFileStore(filename string) (*fileStore, error) {
+	if len(filename) == 0 {
+		return nil, ErrEmptyPath
+	}
+
+	store := &fileStore{
+		filename: filename,
+		values:   make(map[string][]byte),
+	}
+
+	buff, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(buff, &store.values)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Get returns the value stored for the key
+func (store *fileStore) Get(key []byte) ([]byte, error) {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	if store.closed {
+		return nil, ErrStoreClosed
+	}
+
+	value, found := store.values[string(key)]
+	if !found {
+		return nil, ErrKeyNotFound
+	}
+
+	return copyBytes(value), nil
+}
+
+// Put stores the value for the key and persists the file
+func (store *fileStore) Put(key []byte, value []byte) error {
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	if store.closed {
+		return ErrStoreClosed
+	}
+
+	previous, found := store.values[string(key)]
+	store.values[string(key)] = copyBytes(value)
+	err := store.persist()
+	if err != nil {
+		if found {
+			store.values[string(key)] = previous
+		} else {
+			delete(store.values, string(key))
+		}
+	}
+
+	return err
+}
+
+// Remove removes the key and persists the file
+func (store *fileStore) Remove(key []byte) error {
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	if store.closed {
+		return ErrStoreClosed
+	}
+
+	previous, found := store.values[string(key)]
+	if !found {
+		return nil
+	}
+
+	delete(store.values, string(key))
+	err := store.persist()
+	if err != nil {
+		store.values[string(key)] = previous
+	}
+
+	return err
+}
+
+// RangeKeys calls the handler for each key, in ascending order, until the handler returns false
+func (store *fileStore) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	if store.closed {
+		return ErrStoreClosed
+	}
+
+	keys := make([]string, 0, len(store.values))
+	for key := range store.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !handler([]byte(key), copyBytes(store.values[key])) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (store *fileStore) persist() error {
+	buff, err := json.Marshal(store.values)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(store.filename, buff)
+}
+
+// Close closes the store
+func (store *fileStore) Close() error {
+	store.mut.Lock()
+	store.closed = true
+	store.mut.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *fileStore) IsInterfaceNil() bool {
+	return store == nil
+}
+
+// writeFileAtomically writes and syncs a temporary file, then renames it over the destination. The rename being
+// atomic, a crash leaves either the previous or the new content, never a partially written file
+func writeFileAtomically(filename string, buff []byte) error {
+	file, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tempFilename := file.Name()
+	defer func() {
+		_ = os.Remove(tempFilename)
+	}()
+
+	_, err = file.Write(buff)
+	if err == nil {
+		err = file.Sync()
+	}
+	if err == nil {
+		err = file.Chmod(storeFilePermissions)
+	}
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	err = os.Rename(tempFilename, filename)
+	if err != nil {
+		return err
+	}
+
+	return syncDirectory(filepath.Dir(filename))
+}
+
+// syncDirectory persists the rename, not all the platforms allowing it
+func syncDirectory(directory string) error {
+	dir, err := os.Open(directory)
+	if err != nil {
+		return nil
+	}
+	_ = dir.Sync()
+
+	return dir.Close()
+}
+
+func copyBytes(buff []byte) []byte {
+	return append(make([]byte, 0, len(buff)), buff...)
+}
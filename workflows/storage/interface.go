@@ -0,0 +1,12 @@
+package storage
+
+// KeyValueStore defines the durable key-value store backing the persistent workflow components. A successful Put or
+// Remove is persisted, surviving an application crash
+type KeyValueStore interface {
+	Get(key []byte) ([]byte, error)
+	Put(key []byte, value []byte) error
+	Remove(key []byte) error
+	RangeKeys(handler func(key []byte, value []byte) bool) error
+	Close() error
+	IsInterfaceNil() bool
+}
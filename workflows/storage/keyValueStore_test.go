@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type storeFactory func(t *testing.T, path string) KeyValueStore
+
+func createFileStore(t *testing.T, path string) KeyValueStore {
+	store, err := NewFileStore(path)
+	require.Nil(t, err)
+
+	return store
+}
+
+func createLevelDBStore(t *testing.T, path string) KeyValueStore {
+	store, err := NewLevelDBStore(path)
+	require.Nil(t, err)
+
+	return store
+}
+
+var storeFactories = map[string]storeFactory{
+	"file":    createFileStore,
+	"leveldb": createLevelDBStore,
+}
+
+func createTempPath(t *testing.T, name string) string {
+	dir, err := ioutil.TempDir("", "storage-*")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	return filepath.Join(dir, name)
+}
+
+func TestNewFileStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := NewFileStore("")
+		assert.True(t, check.IfNil(store))
+		assert.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("corrupted file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := createTempPath(t, "store.json")
+		require.Nil(t, ioutil.WriteFile(filename, []byte("{"), 0600))
+
+		store, err := NewFileStore(filename)
+		assert.True(t, check.IfNil(store))
+		assert.NotNil(t, err)
+	})
+	t.Run("missing file should work", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := NewFileStore(createTempPath(t, "store.json"))
+		assert.False(t, check.IfNil(store))
+		assert.Nil(t, err)
+	})
+}
+
+func TestNewLevelDBStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := NewLevelDBStore("")
+		assert.True(t, check.IfNil(store))
+		assert.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := NewLevelDBStore(createTempPath(t, "db"))
+		assert.False(t, check.IfNil(store))
+		assert.Nil(t, err)
+		assert.Nil(t, store.Close())
+	})
+}
+
+func TestKeyValueStore_ShouldPersistTheChanges(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range storeFactories {
+		factory := factory
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := createTempPath(t, "store")
+			store := factory(t, path)
+
+			value, err := store.Get([]byte("key1"))
+			assert.Nil(t, value)
+			assert.Equal(t, ErrKeyNotFound, err)
+
+			require.Nil(t, store.Put([]byte("key2"), []byte("value2")))
+			require.Nil(t, store.Put([]byte("key1"), []byte("value1")))
+			require.Nil(t, store.Put([]byte("key3"), []byte("value3")))
+			require.Nil(t, store.Put([]byte("key1"), []byte("value1-updated")))
+			require.Nil(t, store.Remove([]byte("key3")))
+			require.Nil(t, store.Remove([]byte("missing")))
+			require.Nil(t, store.Close())
+
+			_, err = store.Get([]byte("key1"))
+			assert.Equal(t, ErrStoreClosed, err)
+
+			reopened := factory(t, path)
+			defer func() {
+				_ = reopened.Close()
+			}()
+
+			value, err = reopened.Get([]byte("key1"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("value1-updated"), value)
+
+			keys := make([]string, 0)
+			err = reopened.RangeKeys(func(key []byte, value []byte) bool {
+				keys = append(keys, string(key)+"="+string(value))
+				return true
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"key1=value1-updated", "key2=value2"}, keys)
+
+			keys = keys[:0]
+			err = reopened.RangeKeys(func(key []byte, value []byte) bool {
+				keys = append(keys, string(key))
+				return false
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"key1"}, keys)
+		})
+	}
+}
+
+func TestFileStore_FailedWriteShouldKeepThePreviousContent(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempPath(t, "dir")
+	require.Nil(t, os.Mkdir(dir, 0700))
+	filename := filepath.Join(dir, "store.json")
+
+	store, _ := NewFileStore(filename)
+	require.Nil(t, store.Put([]byte("key"), []byte("value")))
+
+	require.Nil(t, os.Chmod(dir, 0500))
+	defer func() {
+		_ = os.Chmod(dir, 0700)
+	}()
+	if os.Getuid() == 0 {
+		t.Skip("the permissions are not enforced for root")
+	}
+
+	err := store.Put([]byte("key"), []byte("new value"))
+	assert.NotNil(t, err)
+	value, _ := store.Get([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+
+	reopened, _ := NewFileStore(filename)
+	value, _ = reopened.Get([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestFileStore_ShouldNotLeaveTemporaryFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempPath(t, "dir")
+	require.Nil(t, os.Mkdir(dir, 0700))
+
+	store, _ := NewFileStore(filepath.Join(dir, "store.json"))
+	require.Nil(t, store.Put([]byte("key1"), []byte("value1")))
+	require.Nil(t, store.Put([]byte("key2"), []byte("value2")))
+
+	files, err := ioutil.ReadDir(dir)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+	assert.Equal(t, "store.json", files[0].Name())
+}
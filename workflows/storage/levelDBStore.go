@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"errors"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+type levelDBStore struct {
+	db           *leveldb.DB
+	writeOptions *opt.WriteOptions
+}
+
+// NewLevelDBStore creates a key-value store backed by an embedded LevelDB database in the provided directory, created
+// if missing. The writes are synced, so a change is persisted when Put or Remove returns
+func NewLevelDBStore(directory string) (*levelDBStore, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyPath
+	}
+
+	db, err := leveldb.OpenFile(directory, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelDBStore{
+		db:           db,
+		writeOptions: &opt.WriteOptions{Sync: true},
+	}, nil
+}
+
+// Get returns the value stored for the key
+func (store *levelDBStore) Get(key []byte) ([]byte, error) {
+	value, err := store.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrKeyNotFound
+	}
+
+	return value, convertClosedError(err)
+}
+
+// Put stores the value for the key
+func (store *levelDBStore) Put(key []byte, value []byte) error {
+	return convertClosedError(store.db.Put(key, value, store.writeOptions))
+}
+
+// Remove removes the key
+func (store *levelDBStore) Remove(key []byte) error {
+	return convertClosedError(store.db.Delete(key, store.writeOptions))
+}
+
+// RangeKeys calls the handler for each key, in ascending order, until the handler returns false
+func (store *levelDBStore) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	iterator := store.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		if !handler(copyBytes(iterator.Key()), copyBytes(iterator.Value())) {
+			break
+		}
+	}
+
+	return convertClosedError(iterator.Error())
+}
+
+// Close closes the database
+func (store *levelDBStore) Close() error {
+	err := store.db.Close()
+	if errors.Is(err, leveldb.ErrClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *levelDBStore) IsInterfaceNil() bool {
+	return store == nil
+}
+
+func convertClosedError(err error) error {
+	if errors.Is(err, leveldb.ErrClosed) {
+		return ErrStoreClosed
+	}
+
+	return err
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("mx-sdk-go/workflows/storage")
+
+const lastProcessedNonceKey = "lastProcessedNonce"
+
+// ArgsNonceHandler is the DTO used in the persistent nonce handler constructor
+type ArgsNonceHandler struct {
+	Store KeyValueStore
+	// InitialNonce is the last processed nonce used when the store does not hold one, so a new deployment can start
+	// with a recent hyper block instead of the genesis one
+	InitialNonce uint64
+}
+
+type nonceHandler struct {
+	store KeyValueStore
+
+	mut   sync.RWMutex
+	nonce uint64
+}
+
+// NewNonceHandler creates a LastProcessedNonceHandler persisting the last processed hyper block nonce in the store,
+// so a restarted application resumes with the hyper block following it, without skipping or reprocessing blocks
+func NewNonceHandler(args ArgsNonceHandler) (*nonceHandler, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStore
+	}
+
+	handler := &nonceHandler{
+		store: args.Store,
+		nonce: args.InitialNonce,
+	}
+
+	buff, err := args.Store.Get([]byte(lastProcessedNonceKey))
+	if errors.Is(err, ErrKeyNotFound) {
+		return handler, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	handler.nonce, err = strconv.ParseUint(string(buff), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the last processed nonce: %s", ErrInvalidValue, err.Error())
+	}
+
+	return handler, nil
+}
+
+// ProcessedNonce persists the nonce of the last processed hyper block. The nonce is kept in memory even if it could
+// not be persisted, the error being logged, so a restart would reprocess the hyper blocks following the persisted one
+func (handler *nonceHandler) ProcessedNonce(nonce uint64) {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	handler.nonce = nonce
+	err := handler.store.Put([]byte(lastProcessedNonceKey), []byte(strconv.FormatUint(nonce, 10)))
+	if err != nil {
+		log.Error("nonceHandler.ProcessedNonce: error persisting the nonce", "nonce", nonce, "error", err)
+	}
+}
+
+// GetLastProcessedNonce returns the nonce of the last processed hyper block
+func (handler *nonceHandler) GetLastProcessedNonce() uint64 {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	return handler.nonce
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *nonceHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
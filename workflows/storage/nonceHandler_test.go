@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNonceHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewNonceHandler(ArgsNonceHandler{})
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilStore, err)
+	})
+	t.Run("invalid stored nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		store := createFileStore(t, createTempPath(t, "store.json"))
+		require.Nil(t, store.Put([]byte(lastProcessedNonceKey), []byte("not a number")))
+
+		handler, err := NewNonceHandler(ArgsNonceHandler{Store: store})
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("empty store should use the initial nonce", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewNonceHandler(ArgsNonceHandler{
+			Store:        createFileStore(t, createTempPath(t, "store.json")),
+			InitialNonce: 37,
+		})
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(37), handler.GetLastProcessedNonce())
+	})
+}
+
+func TestNonceHandler_ShouldResumeAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	for name, factory := range storeFactories {
+		factory := factory
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := createTempPath(t, "store")
+			store := factory(t, path)
+			handler, _ := NewNonceHandler(ArgsNonceHandler{Store: store, InitialNonce: 10})
+			for nonce := uint64(11); nonce <= 15; nonce++ {
+				handler.ProcessedNonce(nonce)
+			}
+			assert.Equal(t, uint64(15), handler.GetLastProcessedNonce())
+			require.Nil(t, store.Close())
+
+			reopened := factory(t, path)
+			defer func() {
+				_ = reopened.Close()
+			}()
+			handler, _ = NewNonceHandler(ArgsNonceHandler{Store: reopened, InitialNonce: 10})
+			assert.Equal(t, uint64(15), handler.GetLastProcessedNonce())
+		})
+	}
+}
@@ -1,14 +1,14 @@
 package workflows
 
 import (
-	"context"
-	"encoding/json"
 	"math/big"
 	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain/finalityProvider"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
 
@@ -21,21 +21,22 @@ type WalletTrackerArgs struct {
 	NonceHandler               LastProcessedNonceHandler
 	CheckInterval              time.Duration
 	MinimumBalance             *big.Int
+	// TokenMinimumBalances and DefaultTokenMinimumBalance configure the ESDT, SFT and NFT deposits tracking, disabled
+	// if both are empty. See ESDTDepositHandlerArgs
+	TokenMinimumBalances       map[string]*big.Int
+	DefaultTokenMinimumBalance *big.Int
 }
 
 // walletTracker is able to track a set of addresses by storing those that received a greater-than-specified
-// amount of EGLD. It does this by parsing hyper block by hyper block and checking each transaction
+// amount of EGLD. It does this by running a hyper block indexer with the deposit handler plugins. The deposits of
+// ESDT, SFT and NFT tokens are reported too, without changing the tracked addresses
 type walletTracker struct {
-	accumulator                *addressesAccumulator
-	trackableAddressesProvider TrackableAddressesProvider
-	proxy                      ProxyHandler
-	nonceHandler               LastProcessedNonceHandler
-	checkInterval              time.Duration
-	cancelFunc                 func()
-	minimumBalance             *big.Int
+	accumulator *addressesAccumulator
+	indexer     *hyperBlockIndexer
 
 	mutHandlers                  sync.RWMutex
 	handlerNewDepositTransaction func(transaction data.TransactionOnNetwork)
+	handlerNewTokenDeposit       func(deposit data.TokenDeposit)
 }
 
 // NewWalletTracker will create a new walletTracker instance. It automatically starts an inner
@@ -55,104 +56,51 @@ func NewWalletTracker(args WalletTrackerArgs) (*walletTracker, error) {
 	}
 
 	wt := &walletTracker{
-		accumulator:                newAddressesAccumulator(),
-		trackableAddressesProvider: args.TrackableAddressesProvider,
-		proxy:                      args.Proxy,
-		nonceHandler:               args.NonceHandler,
-		checkInterval:              args.CheckInterval,
-		minimumBalance:             args.MinimumBalance,
+		accumulator: newAddressesAccumulator(),
 	}
 
-	var ctx context.Context
-	ctx, wt.cancelFunc = context.WithCancel(context.Background())
-	go wt.processLoop(ctx)
-
-	return wt, nil
-}
-
-func (wt *walletTracker) processLoop(ctx context.Context) {
-	log.Debug("walletTracker.processLoop started")
-
-	timer := time.NewTimer(wt.checkInterval)
-	defer timer.Stop()
-
-	for {
-		timer.Reset(wt.checkInterval)
-
-		select {
-		case <-timer.C:
-			err := wt.fetchAndProcessHyperBlocks(ctx)
-			log.LogIfError(err)
-		case <-ctx.Done():
-			log.Debug("terminating walletTracker.processLoop...")
-			return
-		}
-	}
-}
-
-func (wt *walletTracker) fetchAndProcessHyperBlocks(ctx context.Context) error {
-	lastProcessedNonce := wt.nonceHandler.GetLastProcessedNonce()
-	networkNonce, err := wt.proxy.GetLatestHyperBlockNonce(ctx)
+	deposits, err := NewDepositHandler(DepositHandlerArgs{
+		TrackableAddressesProvider: args.TrackableAddressesProvider,
+		MinimumBalance:             args.MinimumBalance,
+		Handler:                    wt.processDepositTransaction,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	for nonce := lastProcessedNonce + 1; nonce <= networkNonce; nonce++ {
-		err = wt.fetchAndProcessHyperBlock(ctx, nonce)
-		if err != nil {
-			return err
+	handlers := []HyperBlockHandler{deposits}
+
+	if len(args.TokenMinimumBalances) > 0 || args.DefaultTokenMinimumBalance != nil {
+		tokenDeposits, errCreate := NewESDTDepositHandler(ESDTDepositHandlerArgs{
+			TrackableAddressesProvider: args.TrackableAddressesProvider,
+			TokenMinimumBalances:       args.TokenMinimumBalances,
+			DefaultTokenMinimumBalance: args.DefaultTokenMinimumBalance,
+			Handler:                    wt.notifyNewTokenDepositFound,
+		})
+		if errCreate != nil {
+			return nil, errCreate
 		}
-
-		wt.nonceHandler.ProcessedNonce(nonce)
+		handlers = append(handlers, tokenDeposits)
 	}
 
-	return nil
-}
-
-func (wt *walletTracker) fetchAndProcessHyperBlock(ctx context.Context, nonce uint64) error {
-	block, err := wt.proxy.GetHyperBlockByNonce(ctx, nonce)
+	wt.indexer, err = NewHyperBlockIndexer(HyperBlockIndexerArgs{
+		Proxy:               args.Proxy,
+		NonceHandler:        args.NonceHandler,
+		FinalityProvider:    finalityProvider.NewDisabledFinalityProvider(),
+		Handlers:            handlers,
+		CheckInterval:       args.CheckInterval,
+		FetchConcurrency:    minFetchConcurrency,
+		AllowedDeltaToFinal: sdkCore.MinAllowedDeltaToFinal,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	wt.processHyperBlock(block)
-
-	log.Debug("processed hyper block", "nonce", nonce, "hash", block.Hash, "num txs", block.NumTxs)
-
-	return nil
-}
-
-func (wt *walletTracker) processHyperBlock(block *data.HyperBlock) {
-	for _, transaction := range block.Transactions {
-		err := wt.processTransaction(transaction)
-		if err != nil {
-			transactionString, _ := json.Marshal(&transaction)
-			log.Warn("error processing transaction, ignoring",
-				"transaction", transactionString, "error", err)
-		}
-	}
+	return wt, nil
 }
 
-func (wt *walletTracker) processTransaction(transaction data.TransactionOnNetwork) error {
-	value, ok := big.NewInt(0).SetString(transaction.Value, 10)
-	if !ok {
-		return ErrInvalidTransactionValue
-	}
-
-	if !wt.trackableAddressesProvider.IsTrackableAddresses(transaction.Receiver) {
-		return nil
-	}
-
-	if value.Cmp(wt.minimumBalance) < 0 {
-		// transaction has a very small value transfer (possible attack vector as someone
-		// can trigger millions of these transactions as to consume the owner's balance through fees)
-		return nil
-	}
-
+func (wt *walletTracker) processDepositTransaction(transaction data.TransactionOnNetwork) {
 	wt.notifyNewDepositTransactionFound(transaction)
 	wt.accumulator.push(transaction.Receiver)
-
-	return nil
 }
 
 func (wt *walletTracker) notifyNewDepositTransactionFound(transaction data.TransactionOnNetwork) {
@@ -176,6 +124,27 @@ func (wt *walletTracker) SetHandlerForNewDepositTransactionFound(handler func(tx
 	wt.mutHandlers.Unlock()
 }
 
+func (wt *walletTracker) notifyNewTokenDepositFound(deposit data.TokenDeposit) {
+	wt.mutHandlers.RLock()
+	defer wt.mutHandlers.RUnlock()
+
+	if wt.handlerNewTokenDeposit != nil {
+		wt.handlerNewTokenDeposit(deposit)
+	}
+}
+
+// SetHandlerForNewTokenDepositFound will set the handler that will get notified each time a new ESDT, SFT or NFT
+// deposit is found on a hyper block
+func (wt *walletTracker) SetHandlerForNewTokenDepositFound(handler func(deposit data.TokenDeposit)) {
+	if handler == nil {
+		return
+	}
+
+	wt.mutHandlers.Lock()
+	wt.handlerNewTokenDeposit = handler
+	wt.mutHandlers.Unlock()
+}
+
 // GetLatestTrackedAddresses returns the accumulated addresses that contained changed balances
 func (wt *walletTracker) GetLatestTrackedAddresses() []string {
 	return wt.accumulator.pop()
@@ -183,9 +152,7 @@ func (wt *walletTracker) GetLatestTrackedAddresses() []string {
 
 // Close will close the process loop go routine
 func (wt *walletTracker) Close() error {
-	wt.cancelFunc()
-
-	return nil
+	return wt.indexer.Close()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
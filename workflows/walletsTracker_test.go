@@ -0,0 +1,158 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockWalletTrackerArgs() WalletTrackerArgs {
+	return WalletTrackerArgs{
+		TrackableAddressesProvider: createTrackableAddressesProvider(trackedAddress),
+		Proxy:                      &testsCommon.ProxyStub{},
+		NonceHandler:               &nonceHandlerMock{},
+		CheckInterval:              time.Millisecond * 10,
+		MinimumBalance:             big.NewInt(100),
+	}
+}
+
+func TestNewWalletTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trackable addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.TrackableAddressesProvider = nil
+		tracker, err := NewWalletTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilTrackableAddressesProvider, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.Proxy = nil
+		tracker, err := NewWalletTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.NonceHandler = nil
+		tracker, err := NewWalletTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilLastProcessedNonceHandler, err)
+	})
+	t.Run("nil minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.MinimumBalance = nil
+		tracker, err := NewWalletTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilMinimumBalance, err)
+	})
+	t.Run("nil token minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWalletTrackerArgs()
+		args.TokenMinimumBalances = map[string]*big.Int{"TKN-123456": nil}
+		tracker, err := NewWalletTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrNilMinimumBalance)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewWalletTracker(createMockWalletTrackerArgs())
+		assert.False(t, check.IfNil(tracker))
+		assert.Nil(t, err)
+		assert.Nil(t, tracker.Close())
+	})
+}
+
+func TestWalletTracker_ShouldReportTheDeposits(t *testing.T) {
+	t.Parallel()
+
+	esdtTransferData := "ESDTTransfer@" + hex.EncodeToString([]byte("TKN-123456")) + "@" + hex.EncodeToString(big.NewInt(500).Bytes())
+	blocks := map[uint64]*data.HyperBlock{
+		1: {
+			Nonce: 1,
+			Transactions: []data.TransactionOnNetwork{
+				{Hash: "egld deposit", Sender: untrackedAddress, Receiver: trackedAddress, Value: "1000"},
+				{Hash: "small egld deposit", Sender: untrackedAddress, Receiver: trackedAddress, Value: "10"},
+			},
+		},
+		2: {
+			Nonce: 2,
+			Transactions: []data.TransactionOnNetwork{
+				{Hash: "untracked egld deposit", Sender: trackedAddress, Receiver: untrackedAddress, Value: "1000"},
+				{Hash: "esdt deposit", Sender: untrackedAddress, Receiver: trackedAddress, Value: "0", Data: []byte(esdtTransferData)},
+			},
+		},
+	}
+
+	nonceHandler := &nonceHandlerMock{}
+	args := createMockWalletTrackerArgs()
+	args.NonceHandler = nonceHandler
+	args.DefaultTokenMinimumBalance = big.NewInt(100)
+	args.Proxy = &testsCommon.ProxyStub{
+		GetLatestHyperBlockNonceCalled: func(ctx context.Context) (uint64, error) {
+			return 2, nil
+		},
+		GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+			return blocks[nonce], nil
+		},
+	}
+
+	mut := sync.Mutex{}
+	depositTxHashes := make([]string, 0)
+	tokenDeposits := make([]data.TokenDeposit, 0)
+	tracker, err := NewWalletTracker(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = tracker.Close()
+	}()
+	tracker.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) {
+		mut.Lock()
+		depositTxHashes = append(depositTxHashes, tx.Hash)
+		mut.Unlock()
+	})
+	tracker.SetHandlerForNewTokenDepositFound(func(deposit data.TokenDeposit) {
+		mut.Lock()
+		tokenDeposits = append(tokenDeposits, deposit)
+		mut.Unlock()
+	})
+
+	require.Eventually(t, func() bool {
+		return nonceHandler.GetLastProcessedNonce() == 2
+	}, time.Second*5, time.Millisecond*10)
+
+	mut.Lock()
+	defer mut.Unlock()
+	assert.Equal(t, []string{"egld deposit"}, depositTxHashes)
+	expectedTokenDeposit := data.TokenDeposit{
+		Sender:          untrackedAddress,
+		Receiver:        trackedAddress,
+		TokenIdentifier: "TKN-123456",
+		Amount:          big.NewInt(500),
+		TxHash:          "esdt deposit",
+		OriginalTxHash:  "esdt deposit",
+		HyperBlockNonce: 2,
+	}
+	assert.Equal(t, []data.TokenDeposit{expectedTokenDeposit}, tokenDeposits)
+	assert.Equal(t, []string{trackedAddress}, tracker.GetLatestTrackedAddresses())
+	assert.Empty(t, tracker.GetLatestTrackedAddresses())
+}